@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// IdentityAPIKeySecretKey is the key in the identity Secret holding the IBM Cloud API key.
+	IdentityAPIKeySecretKey = "apiKey"
+
+	// IdentityTrustedProfileIDSecretKey is the key in the identity Secret holding the ID of the trusted profile to assume.
+	IdentityTrustedProfileIDSecretKey = "trustedProfileID"
+
+	// IdentityTrustedProfileNameSecretKey is the key in the identity Secret holding the name of the trusted profile to assume.
+	// It requires IdentityAccountIDSecretKey to be set as well.
+	IdentityTrustedProfileNameSecretKey = "trustedProfileName"
+
+	// IdentityTrustedProfileCRNSecretKey is the key in the identity Secret holding the CRN of the trusted profile to assume.
+	IdentityTrustedProfileCRNSecretKey = "trustedProfileCRN"
+
+	// IdentityAccountIDSecretKey is the key in the identity Secret holding the account ID of the trusted profile.
+	IdentityAccountIDSecretKey = "accountID"
+
+	// IdentityAuthURLSecretKey is the key in the identity Secret holding the IAM token service URL.
+	IdentityAuthURLSecretKey = "authURL"
+)
+
+// IBMCloudClusterIdentitySpec defines the desired state of IBMCloudClusterIdentity.
+type IBMCloudClusterIdentitySpec struct {
+	// SecretRef is a reference to the Secret holding the IBM Cloud credentials.
+	// The Secret must contain the apiKey key and may contain trustedProfileID, trustedProfileName,
+	// trustedProfileCRN, accountID and authURL keys to authenticate as a trusted profile.
+	SecretRef IBMCloudClusterIdentitySecretReference `json:"secretRef"`
+
+	// AllowedNamespaces is a list of namespaces from which clusters are allowed to use this identity.
+	// If not set, clusters from any namespace are allowed to use it.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// IBMCloudClusterIdentitySecretReference is a reference to a Secret.
+type IBMCloudClusterIdentitySecretReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=ibmcloudclusteridentities,scope=Cluster,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretRef.name",description="Name of the Secret holding the credentials"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of IBMCloudClusterIdentity"
+
+// IBMCloudClusterIdentity is the Schema for the ibmcloudclusteridentities API.
+// It provides the IBM Cloud credentials used to manage the infrastructure of the clusters referencing it.
+type IBMCloudClusterIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IBMCloudClusterIdentitySpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// IBMCloudClusterIdentityList contains a list of IBMCloudClusterIdentity.
+type IBMCloudClusterIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMCloudClusterIdentity `json:"items"`
+}
+
+// IsNamespaceAllowed returns true if clusters in the namespace are allowed to use the identity.
+func (i *IBMCloudClusterIdentity) IsNamespaceAllowed(namespace string) bool {
+	if len(i.Spec.AllowedNamespaces) == 0 {
+		return true
+	}
+	for _, allowed := range i.Spec.AllowedNamespaces {
+		if allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	objectTypes = append(objectTypes, &IBMCloudClusterIdentity{}, &IBMCloudClusterIdentityList{})
+}
@@ -217,6 +217,10 @@ const (
 	// CreateInfrastructureAnnotation is the name of an annotation that indicates if
 	// Power VS infrastructure should be created as a part of cluster creation.
 	CreateInfrastructureAnnotation = "powervs.cluster.x-k8s.io/create-infra"
+
+	// IdentityRefAnnotation is the name of an annotation that holds the name of the IBMCloudClusterIdentity
+	// used by the cluster. It maps to the spec.identityRef field of the v1beta3 API.
+	IdentityRefAnnotation = "powervs.cluster.x-k8s.io/identity-ref"
 )
 
 // IBMPowerVSCluster's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
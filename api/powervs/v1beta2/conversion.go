@@ -472,9 +472,14 @@ func (src *IBMPowerVSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		}
 	}
 
+	if val, exists := src.Annotations[IdentityRefAnnotation]; exists {
+		dst.Spec.IdentityRef.Name = val
+	}
+
 	// Clean up the annotation in v1beta3 so we don't have duplicated sources of truth
 	if dst.Annotations != nil {
 		delete(dst.Annotations, "powervs.cluster.x-k8s.io/create-infra")
+		delete(dst.Annotations, IdentityRefAnnotation)
 		if len(dst.Annotations) == 0 {
 			dst.Annotations = nil
 		}
@@ -503,6 +508,14 @@ func (dst *IBMPowerVSCluster) ConvertFrom(srcRaw conversion.Hub) error {
 		}
 	}
 
+	// Map the v1beta3 IdentityRef back to the v1beta2 annotation.
+	if src.Spec.IdentityRef.Name != "" {
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[IdentityRefAnnotation] = src.Spec.IdentityRef.Name
+	}
+
 	restored := &IBMPowerVSCluster{
 		Spec: IBMPowerVSClusterSpec{
 			VPC:           dst.Spec.VPC,
@@ -566,6 +579,10 @@ func (src *IBMPowerVSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 			}
 		}
 	}
+	if val, exists := src.Annotations[IdentityRefAnnotation]; exists {
+		dst.Spec.Template.Spec.IdentityRef.Name = val
+		delete(dst.Annotations, IdentityRefAnnotation)
+	}
 	if dst.Annotations != nil && len(dst.Annotations) == 0 {
 		dst.Annotations = nil
 	}
@@ -577,6 +594,12 @@ func (dst *IBMPowerVSClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 	if err := Convert_v1beta3_IBMPowerVSClusterTemplate_To_v1beta2_IBMPowerVSClusterTemplate(src, dst, nil); err != nil {
 		return err
 	}
+	if src.Spec.Template.Spec.IdentityRef.Name != "" {
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[IdentityRefAnnotation] = src.Spec.Template.Spec.IdentityRef.Name
+	}
 	restored := &IBMPowerVSClusterTemplate{
 		Spec: IBMPowerVSClusterTemplateSpec{
 			Template: IBMPowerVSClusterTemplateResource{
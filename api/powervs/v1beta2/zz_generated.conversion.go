@@ -666,6 +666,7 @@ func autoConvert_v1beta3_IBMPowerVSClusterSpec_To_v1beta2_IBMPowerVSClusterSpec(
 	}
 	// WARNING: in.COSInstance requires manual conversion: does not exist in peer-type
 	// WARNING: in.Ignition requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3.Ignition vs *sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta2.Ignition)
+	// WARNING: in.IdentityRef requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -158,6 +158,13 @@ const (
 	COSInstanceReadyCondition = "COSInstanceCreated"
 	// COSInstanceReconciliationFailedReason used when an error occurs during COS instance reconciliation.
 	COSInstanceReconciliationFailedReason = "COSInstanceCreationFailed"
+
+	// CredentialsReadyCondition reports on whether the IBM Cloud credentials used for the cluster are able to authenticate.
+	CredentialsReadyCondition = "CredentialsReady"
+	// CredentialsReadyReason surfaces when the IBM Cloud credentials are able to authenticate.
+	CredentialsReadyReason = clusterv1.ReadyReason
+	// AuthenticationFailedReason used when the IBM Cloud credentials fail to authenticate, e.g. after a rotation.
+	AuthenticationFailedReason = "AuthenticationFailed"
 )
 
 // IBMPowerVSCluster's Ready condition and corresponding reasons.
@@ -180,6 +180,11 @@ type IBMPowerVSClusterSpec struct {
 	// ignition defines options related to the bootstrapping systems where Ignition is used.
 	// +optional
 	Ignition Ignition `json:"ignition,omitempty,omitzero"`
+
+	// identityRef is a reference to the IBMCloudClusterIdentity providing the credentials used to manage
+	// the cluster infrastructure. When not set, the credentials configured for the controller are used.
+	// +optional
+	IdentityRef IBMCloudClusterIdentityReference `json:"identityRef,omitempty,omitzero"`
 }
 
 // IBMPowerVSClusterStatus defines the observed state of IBMPowerVSCluster.
@@ -435,6 +440,15 @@ type Ignition struct {
 	Version string `json:"version,omitempty"`
 }
 
+// IBMCloudClusterIdentityReference is a reference to an IBMCloudClusterIdentity.
+type IBMCloudClusterIdentityReference struct {
+	// name of the IBMCloudClusterIdentity.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+}
+
 // IBMPowerVSClusterDeprecatedStatus groups all the status fields that are deprecated and will be removed in a future version.
 // See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
 type IBMPowerVSClusterDeprecatedStatus struct {
@@ -120,6 +120,21 @@ func (in *DHCPServer) DeepCopy() *DHCPServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudClusterIdentityReference) DeepCopyInto(out *IBMCloudClusterIdentityReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudClusterIdentityReference.
+func (in *IBMCloudClusterIdentityReference) DeepCopy() *IBMCloudClusterIdentityReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudClusterIdentityReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSCluster) DeepCopyInto(out *IBMPowerVSCluster) {
 	*out = *in
@@ -249,6 +264,7 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 	}
 	out.COSInstance = in.COSInstance
 	out.Ignition = in.Ignition
+	out.IdentityRef = in.IdentityRef
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSClusterSpec.
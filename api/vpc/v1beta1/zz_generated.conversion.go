@@ -355,6 +355,10 @@ func autoConvert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in *v1be
 	}
 	// WARNING: in.Image requires manual conversion: does not exist in peer-type
 	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
+	// WARNING: in.IdentityRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.FileShares requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -394,6 +398,9 @@ func autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *
 		return err
 	}
 	out.ControlPlaneLoadBalancerState = VPCLoadBalancerState(in.ControlPlaneLoadBalancerState)
+	// WARNING: in.AttachedTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.FileShares requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*corev1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	// WARNING: in.V1Beta2 requires manual conversion: does not exist in peer-type
 	return nil
@@ -504,6 +511,7 @@ func autoConvert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(in *v1be
 	// WARNING: in.CatalogOffering requires manual conversion: does not exist in peer-type
 	// WARNING: in.PlacementTarget requires manual conversion: does not exist in peer-type
 	// WARNING: in.Image requires manual conversion: inconvertible types (*sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2.IBMVPCResourceReference vs string)
+	// WARNING: in.SourceSnapshot requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
 	out.Zone = in.Zone
 	out.Profile = in.Profile
@@ -520,6 +528,17 @@ func autoConvert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(in *v1be
 	if err := Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(&in.PrimaryNetworkInterface, &out.PrimaryNetworkInterface, s); err != nil {
 		return err
 	}
+	// WARNING: in.AdditionalNetworkInterfaces requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkAttachmentMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.VirtualNetworkInterface requires manual conversion: does not exist in peer-type
+	// WARNING: in.FloatingIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.AvailabilityPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.UnhealthyInstancePolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecurityOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.MetadataService requires manual conversion: does not exist in peer-type
+	// WARNING: in.DefaultTrustedProfile requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.FileShareMounts requires manual conversion: does not exist in peer-type
 	if err := Convert_Slice_Pointer_v1beta2_IBMVPCResourceReference_To_Slice_Pointer_string(&in.SSHKeys, &out.SSHKeys, s); err != nil {
 		return err
 	}
@@ -544,10 +563,17 @@ func autoConvert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(in *
 	out.InstanceID = in.InstanceID
 	out.Ready = in.Ready
 	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
+	// WARNING: in.AdditionalNetworkInterfaces requires manual conversion: does not exist in peer-type
+	// WARNING: in.FloatingIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecurityOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrimaryReservedIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.AttachedTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.FileShareMounts requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureReason requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureMessage requires manual conversion: does not exist in peer-type
 	out.InstanceStatus = in.InstanceStatus
+	// WARNING: in.InstanceHealthState requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
 	// WARNING: in.V1Beta2 requires manual conversion: does not exist in peer-type
 	return nil
@@ -686,6 +712,8 @@ func Convert_v1beta1_IBMVPCMachineTemplateStatus_To_v1beta2_IBMVPCMachineTemplat
 
 func autoConvert_v1beta2_IBMVPCMachineTemplateStatus_To_v1beta1_IBMVPCMachineTemplateStatus(in *v1beta2.IBMVPCMachineTemplateStatus, out *IBMVPCMachineTemplateStatus, s conversion.Scope) error {
 	// WARNING: in.Capacity requires manual conversion: does not exist in peer-type
+	// WARNING: in.Reservation requires manual conversion: does not exist in peer-type
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -702,6 +730,7 @@ func Convert_v1beta1_NetworkInterface_To_v1beta2_NetworkInterface(in *NetworkInt
 func autoConvert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(in *v1beta2.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
 	// WARNING: in.SecurityGroups requires manual conversion: does not exist in peer-type
 	out.Subnet = in.Subnet
+	// WARNING: in.ReservedIP requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -52,6 +52,54 @@ const (
 	IBMVPCMachineReadyUnknownV1Beta2Reason = clusterv1beta1.ReadyUnknownV1Beta2Reason
 )
 
+const (
+	// IBMVPCMachinePoolReadyV1Beta2Condition is true if the IBMVPCMachinePool's deletionTimestamp is not set, IBMVPCMachinePool's
+	// IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition is true.
+	IBMVPCMachinePoolReadyV1Beta2Condition = clusterv1beta1.ReadyV1Beta2Condition
+
+	// IBMVPCMachinePoolReadyV1Beta2Reason surfaces when the IBMVPCMachinePool readiness criteria is met.
+	IBMVPCMachinePoolReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// IBMVPCMachinePoolNotReadyV1Beta2Reason surfaces when the IBMVPCMachinePool readiness criteria is not met.
+	IBMVPCMachinePoolNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// IBMVPCMachinePoolReadyUnknownV1Beta2Reason surfaces when at least one IBMVPCMachinePool readiness criteria is unknown.
+	IBMVPCMachinePoolReadyUnknownV1Beta2Reason = clusterv1beta1.ReadyUnknownV1Beta2Reason
+)
+
+// IBMVPCMachinePool's InstanceGroupReady condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition documents the status of the instance group that is controlled
+	// by the IBMVPCMachinePool.
+	IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition = "InstanceGroupReady"
+
+	// IBMVPCMachinePoolInstanceGroupReadyV1Beta2Reason surfaces when the instance group that is controlled
+	// by the IBMVPCMachinePool is ready.
+	IBMVPCMachinePoolInstanceGroupReadyV1Beta2Reason = "InstanceGroupReady"
+
+	// IBMVPCMachinePoolInstanceGroupNotReadyV1Beta2Reason surfaces when the instance group that is controlled
+	// by the IBMVPCMachinePool is not ready.
+	IBMVPCMachinePoolInstanceGroupNotReadyV1Beta2Reason = "InstanceGroupNotReady"
+
+	// IBMVPCMachinePoolInstanceGroupDeletingV1Beta2Reason surfaces when the instance group that is controlled
+	// by the IBMVPCMachinePool is deleting.
+	IBMVPCMachinePoolInstanceGroupDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+)
+
+// IBMVPCMachineTemplate's ReservationReady condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// IBMVPCMachineTemplateReservationReadyV1Beta2Condition documents whether the details of the capacity reservation
+	// used by the machines of the IBMVPCMachineTemplate could be retrieved.
+	IBMVPCMachineTemplateReservationReadyV1Beta2Condition = "ReservationReady"
+
+	// IBMVPCMachineTemplateReservationReadyV1Beta2Reason surfaces when the details of the reservation are retrieved.
+	IBMVPCMachineTemplateReservationReadyV1Beta2Reason = "ReservationReady"
+
+	// IBMVPCMachineTemplateReservationLookupFailedV1Beta2Reason surfaces when the details of the reservation could not
+	// be retrieved.
+	IBMVPCMachineTemplateReservationLookupFailedV1Beta2Reason = "ReservationLookupFailed"
+)
+
 // IBMVPCMachine's InstanceReady condition and corresponding reasons that will be used in v1Beta2 API version.
 const (
 	// IBMVPCMachineInstanceReadyV1Beta2Condition documents the status of the instance that is controlled
@@ -66,6 +114,70 @@ const (
 	// by the IBMVPCMachine is not ready.
 	IBMVPCMachineInstanceNotReadyV1Beta2Reason = "InstanceNotReady"
 )
+
+// IBMVPCMachine's VolumesReady condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// IBMVPCMachineVolumesReadyV1Beta2Condition documents whether the boot and additional volumes of the instance
+	// controlled by the IBMVPCMachine match their size, iops and profile in the spec.
+	IBMVPCMachineVolumesReadyV1Beta2Condition = "VolumesReady"
+
+	// IBMVPCMachineVolumesReadyV1Beta2Reason surfaces when the volumes of the instance match the spec.
+	IBMVPCMachineVolumesReadyV1Beta2Reason = "VolumesReady"
+
+	// IBMVPCMachineVolumesUpdatingV1Beta2Reason surfaces when volumes of the instance are being updated to match
+	// the spec.
+	IBMVPCMachineVolumesUpdatingV1Beta2Reason = "VolumesUpdating"
+
+	// IBMVPCMachineVolumesUpdateFailedV1Beta2Reason surfaces when updating volumes of the instance failed.
+	IBMVPCMachineVolumesUpdateFailedV1Beta2Reason = "VolumesUpdateFailed"
+
+	// IBMVPCMachineVolumesUpdateRefusedV1Beta2Reason surfaces when volumes of the instance cannot be updated as
+	// requested by the spec, which is not retried until the spec changes.
+	IBMVPCMachineVolumesUpdateRefusedV1Beta2Reason = "VolumesUpdateRefused"
+)
+
+// IBMVPCMachine's ProfileReady condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// IBMVPCMachineProfileReadyV1Beta2Condition documents whether the instance controlled by the IBMVPCMachine runs
+	// with the profile in the spec.
+	IBMVPCMachineProfileReadyV1Beta2Condition = "ProfileReady"
+
+	// IBMVPCMachineProfileReadyV1Beta2Reason surfaces when the instance runs with the profile in the spec.
+	IBMVPCMachineProfileReadyV1Beta2Reason = "ProfileReady"
+
+	// IBMVPCMachineProfileResizingV1Beta2Reason surfaces when the instance is being stopped, resized and started
+	// again to match the profile in the spec.
+	IBMVPCMachineProfileResizingV1Beta2Reason = "ProfileResizing"
+
+	// IBMVPCMachineProfileResizeFailedV1Beta2Reason surfaces when resizing the instance failed.
+	IBMVPCMachineProfileResizeFailedV1Beta2Reason = "ProfileResizeFailed"
+
+	// IBMVPCMachineProfileResizeNotAllowedV1Beta2Reason surfaces when the profile in the spec differs from the
+	// profile of the instance, but the in-place resize is not allowed by the InPlaceResizeAnnotation.
+	IBMVPCMachineProfileResizeNotAllowedV1Beta2Reason = "ProfileResizeNotAllowed"
+)
+
+const (
+	// IBMVPCMachineInstanceHealthyV1Beta2Condition documents the health state of the instance controlled by the
+	// IBMVPCMachine, as reported by IBM Cloud.
+	IBMVPCMachineInstanceHealthyV1Beta2Condition = "InstanceHealthy"
+
+	// IBMVPCMachineInstanceHealthyV1Beta2Reason surfaces when the health state of the instance is ok.
+	IBMVPCMachineInstanceHealthyV1Beta2Reason = "InstanceHealthy"
+
+	// IBMVPCMachineInstanceDegradedV1Beta2Reason surfaces when the health state of the instance is degraded.
+	IBMVPCMachineInstanceDegradedV1Beta2Reason = "InstanceDegraded"
+
+	// IBMVPCMachineInstanceFaultedV1Beta2Reason surfaces when the health state of the instance is faulted.
+	IBMVPCMachineInstanceFaultedV1Beta2Reason = "InstanceFaulted"
+
+	// IBMVPCMachineInstanceHealthInapplicableV1Beta2Reason surfaces when the health state of the instance does not
+	// apply, e.g. when the instance is not running.
+	IBMVPCMachineInstanceHealthInapplicableV1Beta2Reason = "InstanceHealthInapplicable"
+
+	// IBMVPCMachineInstanceHealthUnknownV1Beta2Reason surfaces when the health state of the instance is unknown.
+	IBMVPCMachineInstanceHealthUnknownV1Beta2Reason = "InstanceHealthUnknown"
+)
 const (
 	// IBMPowerVSMachineInstanceReadyV1Beta2Condition documents the status of the instance that is controlled
 	// by the IBMPowerVSMachine.
@@ -129,6 +241,12 @@ const (
 
 	// InstanceStateUnknownReason used when the instance is in a unknown state.
 	InstanceStateUnknownReason = "InstanceStateUnknown"
+
+	// InstancePreemptedReason used when the spot instance was stopped by IBM Cloud on preemption.
+	InstancePreemptedReason = "InstancePreempted"
+
+	// InstanceHostFailedReason used when the instance was stopped by IBM Cloud on a failure of its compute host.
+	InstanceHostFailedReason = "InstanceHostFailed"
 )
 
 const (
@@ -136,6 +254,71 @@ const (
 	InstanceReadyCondition clusterv1beta1.ConditionType = "InstanceReady"
 )
 
+const (
+	// VolumesReadyCondition reports on the update of the volumes of the instance. Ready indicates the volumes match
+	// their size, iops and profile in the spec.
+	VolumesReadyCondition clusterv1beta1.ConditionType = "VolumesReady"
+
+	// VolumesUpdatingReason used when volumes of the instance are being updated.
+	VolumesUpdatingReason = "VolumesUpdating"
+
+	// VolumesUpdateFailedReason used when updating volumes of the instance failed.
+	VolumesUpdateFailedReason = "VolumesUpdateFailed"
+
+	// VolumesUpdateRefusedReason used when volumes of the instance cannot be updated as requested by the spec.
+	VolumesUpdateRefusedReason = "VolumesUpdateRefused"
+)
+
+const (
+	// ProfileReadyCondition reports on the in-place resize of the instance. Ready indicates the instance runs with
+	// the profile in the spec.
+	ProfileReadyCondition clusterv1beta1.ConditionType = "ProfileReady"
+
+	// ProfileResizingReason used when the instance is being resized.
+	ProfileResizingReason = "ProfileResizing"
+
+	// ProfileResizeFailedReason used when resizing the instance failed.
+	ProfileResizeFailedReason = "ProfileResizeFailed"
+
+	// ProfileResizeNotAllowedReason used when the profile of the instance differs from the spec, but the in-place
+	// resize is not allowed.
+	ProfileResizeNotAllowedReason = "ProfileResizeNotAllowed"
+)
+
+const (
+	// InstanceHealthyCondition reports on the health state of the instance reported by IBM Cloud. Ready indicates the
+	// health state of the instance is ok.
+	InstanceHealthyCondition clusterv1beta1.ConditionType = "InstanceHealthy"
+
+	// InstanceDegradedReason used when the health state of the instance is degraded.
+	InstanceDegradedReason = "InstanceDegraded"
+
+	// InstanceFaultedReason used when the health state of the instance is faulted.
+	InstanceFaultedReason = "InstanceFaulted"
+
+	// InstanceHealthInapplicableReason used when the health state of the instance does not apply, e.g. when the
+	// instance is not running.
+	InstanceHealthInapplicableReason = "InstanceHealthInapplicable"
+
+	// InstanceHealthUnknownReason used when the health state of the instance is unknown.
+	InstanceHealthUnknownReason = "InstanceHealthUnknown"
+)
+
+const (
+	// InstanceGroupReadyCondition reports on current status of the instance group. Ready indicates the instance group is healthy
+	// and has the desired number of members.
+	InstanceGroupReadyCondition clusterv1beta1.ConditionType = "InstanceGroupReady"
+
+	// InstanceGroupNotReadyReason used when the instance group is not ready.
+	InstanceGroupNotReadyReason = "InstanceGroupNotReady"
+
+	// InstanceGroupProvisionFailedReason used for failures during instance template or instance group provisioning.
+	InstanceGroupProvisionFailedReason = "InstanceGroupProvisionFailed"
+
+	// InstanceGroupDeletingReason is used when the instance group is in deleting state.
+	InstanceGroupDeletingReason = "InstanceGroupDeleting"
+)
+
 const (
 	// WaitingForIBMPowerVSImageReason used when machine is waiting for powervs image to be ready before proceeding.
 	WaitingForIBMPowerVSImageReason = "WaitingForIBMPowerVSImage"
@@ -197,6 +380,11 @@ const (
 	// VPCSubnetReconciliationFailedReason used when an error occurs during VPC subnet reconciliation.
 	VPCSubnetReconciliationFailedReason = "VPCSubnetReconciliationFailed"
 
+	// VPCFileShareReadyCondition reports on the successful reconciliation of the VPC file shares.
+	VPCFileShareReadyCondition clusterv1beta1.ConditionType = "VPCFileShareReady"
+	// VPCFileShareReconciliationFailedReason used when an error occurs during VPC file share reconciliation.
+	VPCFileShareReconciliationFailedReason = "VPCFileShareReconciliationFailed"
+
 	// TransitGatewayReadyCondition reports on the successful reconciliation of a Power VS transit gateway.
 	TransitGatewayReadyCondition clusterv1beta1.ConditionType = "TransitGatewayReady"
 	// TransitGatewayReconciliationFailedReason used when an error occurs during transit gateway reconciliation.
@@ -211,12 +399,23 @@ const (
 	COSInstanceReadyCondition clusterv1beta1.ConditionType = "COSInstanceCreated"
 	// COSInstanceReconciliationFailedReason used when an error occurs during COS instance reconciliation.
 	COSInstanceReconciliationFailedReason = "COSInstanceCreationFailed"
+
+	// CredentialsReadyCondition reports on whether the IBM Cloud credentials used for the cluster are able to authenticate.
+	CredentialsReadyCondition clusterv1beta1.ConditionType = "CredentialsReady"
+	// AuthenticationFailedReason used when the IBM Cloud credentials fail to authenticate, e.g. after a rotation.
+	AuthenticationFailedReason = "AuthenticationFailed"
 )
 
 const (
 	// CreateInfrastructureAnnotation is the name of an annotation that indicates if
 	// Power VS infrastructure should be created as a part of cluster creation.
 	CreateInfrastructureAnnotation = "powervs.cluster.x-k8s.io/create-infra"
+
+	// InPlaceResizeAnnotation is the name of an annotation that allows the instance of an IBMVPCMachine to be
+	// stopped, resized and started again when the profile of the IBMVPCMachine is changed. It must be set to "true".
+	// The instance is only resized to a profile of the same family and vCPU architecture, supporting its security
+	// options.
+	InPlaceResizeAnnotation = "vpc.cluster.x-k8s.io/in-place-resize"
 )
 
 // IBMPowerVSCluster's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
@@ -314,6 +513,15 @@ const (
 	// VPCSecurityGroupDeletingV1Beta2Reason surfaces when the VPC security group is being deleted.
 	VPCSecurityGroupDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
 
+	// VPCFileShareReadyV1Beta2Condition reports on the successful reconciliation of the VPC file shares.
+	VPCFileShareReadyV1Beta2Condition = "VPCFileShareReady"
+
+	// VPCFileShareReadyV1Beta2Reason surfaces when the VPC file shares are ready.
+	VPCFileShareReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// VPCFileShareNotReadyV1Beta2Reason surfaces when the VPC file shares are not ready.
+	VPCFileShareNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
 	// TransitGatewayReadyV1Beta2Condition reports on the successful reconciliation of a transit gateway.
 	TransitGatewayReadyV1Beta2Condition = "TransitGatewayReady"
 
@@ -358,6 +566,15 @@ const (
 
 	// COSInstanceDeletingV1Beta2Reason surfaces when the COS instance is being deleted.
 	COSInstanceDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// CredentialsReadyV1Beta2Condition reports on whether the IBM Cloud credentials used for the cluster are able to authenticate.
+	CredentialsReadyV1Beta2Condition = "CredentialsReady"
+
+	// CredentialsReadyV1Beta2Reason surfaces when the IBM Cloud credentials are able to authenticate.
+	CredentialsReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// CredentialsAuthenticationFailedV1Beta2Reason surfaces when the IBM Cloud credentials fail to authenticate.
+	CredentialsAuthenticationFailedV1Beta2Reason = "AuthenticationFailed"
 )
 
 // IBMPowerVSImage's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
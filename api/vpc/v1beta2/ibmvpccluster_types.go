@@ -28,6 +28,9 @@ const (
 	// ClusterFinalizer allows DockerClusterReconciler to clean up resources associated with DockerCluster before
 	// removing it from the apiserver.
 	ClusterFinalizer = "ibmvpccluster.infrastructure.cluster.x-k8s.io"
+
+	// DefaultIgnitionVersion represents default Ignition version generated for machine userdata.
+	DefaultIgnitionVersion = "2.3"
 )
 
 // IBMVPCClusterSpec defines the desired state of IBMVPCCluster.
@@ -63,6 +66,72 @@ type IBMVPCClusterSpec struct {
 	// network represents the VPC network to use for the cluster.
 	// +optional
 	Network *VPCNetworkSpec `json:"network,omitempty"`
+
+	// ignition defines options related to the bootstrapping systems where Ignition is used.
+	// When set, the bootstrap data is stored in the configured COS bucket and the machines
+	// are created with a small Ignition config that fetches it using a pre-signed URL.
+	// +optional
+	Ignition *VPCIgnition `json:"ignition,omitempty"`
+
+	// identityRef is a reference to the IBMCloudClusterIdentity providing the credentials used to manage
+	// the cluster infrastructure. When not set, the credentials configured for the controller are used.
+	// +optional
+	IdentityRef *IBMCloudClusterIdentityReference `json:"identityRef,omitempty"`
+
+	// additionalTags are user tags, e.g. env:prod or costcenter:1234, attached to the resources created for the
+	// cluster and for its machines and machine pools, in addition to the tag with the name of the cluster. They can
+	// only be set when the network is configured. Tags removed from the list are detached from the resources.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9 _.:-]+$`
+	// +optional
+	AdditionalTags []string `json:"additionalTags,omitempty"`
+
+	// fileShares are the VPC file shares of the cluster, which the machines can mount with their fileShareMounts.
+	// They can only be set when the network is configured, and are reconciled after its subnets and security groups.
+	// Their size, profile, zone and access control mode are immutable. File shares cannot be removed from the list, as
+	// they are not deleted by the controller, neither when removed nor with the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	FileShares []VPCFileShare `json:"fileShares,omitempty"`
+}
+
+// VPCIgnition defines options related to the bootstrapping systems where Ignition is used.
+type VPCIgnition struct {
+	// version defines which version of Ignition will be used to generate bootstrap data.
+	// +kubebuilder:validation:Enum="2.3";"2.4";"3.0";"3.1";"3.2";"3.3";"3.4"
+	// +kubebuilder:default="2.3"
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// cosInstance defines the IBM Cloud COS instance and bucket used to store the Ignition bootstrap data.
+	// +required
+	COSInstance VPCIgnitionCOSInstance `json:"cosInstance"`
+}
+
+// VPCIgnitionCOSInstance defines the COS bucket and HMAC credentials used to store the Ignition bootstrap data.
+type VPCIgnitionCOSInstance struct {
+	// bucketName is the name of an existing COS bucket used to store the bootstrap data.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	BucketName string `json:"bucketName"`
+
+	// bucketRegion is the region of the COS bucket.
+	// If not specified, the cluster region will be used.
+	// +optional
+	BucketRegion string `json:"bucketRegion,omitempty"`
+
+	// hmacSecretName is the name of a Secret in the cluster namespace holding the COS HMAC credentials
+	// under the access_key_id and secret_access_key keys. HMAC credentials are required to generate
+	// the pre-signed URL used by Ignition to fetch the bootstrap data.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	HMACSecretName string `json:"hmacSecretName"`
 }
 
 // VPCLoadBalancerSpec defines the desired state of an VPC load balancer.
@@ -316,6 +385,21 @@ type IBMVPCClusterStatus struct {
 	// +optional
 	ControlPlaneLoadBalancerState VPCLoadBalancerState `json:"controlPlaneLoadBalancerState,omitempty"`
 
+	// attachedTags are the additional tags attached to the resources of the cluster, used to detach the tags
+	// removed from the spec.
+	// +optional
+	AttachedTags []string `json:"attachedTags,omitempty"`
+
+	// fileShares references the VPC file shares of the cluster, keyed by name.
+	// The map simplifies lookups.
+	// +optional
+	FileShares map[string]*VPCFileShareStatus `json:"fileShares,omitempty"`
+
+	// failureDomains are the zones of the cluster's subnets, keyed by zone name, the machines can be spread across.
+	// Only the zones with a control plane subnet are suitable for control plane machines.
+	// +optional
+	FailureDomains clusterv1beta1.FailureDomains `json:"failureDomains,omitempty"`
+
 	// Conditions defines current service state of the load balancer.
 	// +optional
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
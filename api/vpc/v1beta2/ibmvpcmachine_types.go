@@ -49,7 +49,15 @@ type IBMVPCMachineSpec struct {
 
 	// Image is the OS image which would be install on the instance.
 	// ID will take higher precedence over Name if both specified.
-	Image *IBMVPCResourceReference `json:"image"`
+	// One of Image, CatalogOffering or SourceSnapshot is required.
+	// +optional
+	Image *IBMVPCResourceReference `json:"image,omitempty"`
+
+	// sourceSnapshot is the bootable block storage snapshot the boot volume of the instance is created from, instead
+	// of an Image or a CatalogOffering. The boot volume is resized when BootVolume.SizeGiB is larger than the
+	// snapshot.
+	// +optional
+	SourceSnapshot *VPCSnapshotReference `json:"sourceSnapshot,omitempty"`
 
 	// LoadBalancerPoolMembers is the set of IBM Cloud VPC Load Balancer Backend Pools the machine should be added to as a member.
 	// +optional
@@ -60,6 +68,8 @@ type IBMVPCMachineSpec struct {
 	Zone string `json:"zone"`
 
 	// Profile indicates the flavor of instance. Example: bx2-8x32	means 8 vCPUs	32 GB RAM	16 Gbps
+	// The profile of an IBMVPCMachine can only be changed when the vpc.cluster.x-k8s.io/in-place-resize annotation is
+	// set to "true", in which case its instance is stopped, resized and started again.
 	// TODO: add a reference link of profile
 	// +optional
 	Profile string `json:"profile,omitempty"`
@@ -75,6 +85,81 @@ type IBMVPCMachineSpec struct {
 	// PrimaryNetworkInterface is required to specify subnet.
 	PrimaryNetworkInterface NetworkInterface `json:"primaryNetworkInterface,omitempty"`
 
+	// additionalNetworkInterfaces are the secondary network interfaces attached to the instance when it is created,
+	// e.g. on separate subnets for storage or multus-based workloads. The subnet of each interface is required.
+	// The number of network interfaces of an instance is limited by its profile.
+	// +optional
+	// +kubebuilder:validation:MaxItems=14
+	AdditionalNetworkInterfaces []NetworkInterface `json:"additionalNetworkInterfaces,omitempty"`
+
+	// networkAttachmentMode defines how the network interfaces of the instance are attached.
+	// With NetworkInterface, the default, instance network interfaces are created and deleted with the instance.
+	// With VirtualNetworkInterface, virtual network interfaces are attached to the instance with network attachments,
+	// and are deleted or retained on instance deletion according to the virtualNetworkInterface deletion policy.
+	// +kubebuilder:validation:Enum=NetworkInterface;VirtualNetworkInterface
+	// +optional
+	NetworkAttachmentMode VPCNetworkAttachmentMode `json:"networkAttachmentMode,omitempty"`
+
+	// virtualNetworkInterface defines the options of the virtual network interfaces of the instance.
+	// It can only be set when networkAttachmentMode is VirtualNetworkInterface.
+	// +optional
+	VirtualNetworkInterface *VPCVirtualNetworkInterface `json:"virtualNetworkInterface,omitempty"`
+
+	// floatingIP requests a floating IP, a public IP address, bound to the primary network interface of the instance.
+	// It is bound once the instance is running and reported as an ExternalIP address of the machine.
+	// +optional
+	FloatingIP *VPCMachineFloatingIP `json:"floatingIP,omitempty"`
+
+	// availabilityPolicy defines the availability class of the instance, e.g. spot, and what happens to it when its
+	// compute host fails.
+	// +optional
+	AvailabilityPolicy *VPCAvailabilityPolicy `json:"availabilityPolicy,omitempty"`
+
+	// unhealthyInstancePolicy defines what happens to the machine when IBM Cloud reports the health state of its
+	// instance as faulted. With Report, the instance is only reported in the conditions of the machine and with
+	// warning events. With Fail, the machine is also marked as failed, so that it can be remediated by a
+	// MachineHealthCheck.
+	// Defaults to Report.
+	// +kubebuilder:validation:Enum=Report;Fail
+	// +optional
+	UnhealthyInstancePolicy VPCUnhealthyInstancePolicy `json:"unhealthyInstancePolicy,omitempty"`
+
+	// securityOptions defines the secure boot and confidential computing options of the instance, which are validated
+	// against its profile when the instance is created. securityOptions cannot be changed once the machine is created.
+	// +optional
+	SecurityOptions *VPCSecurityOptions `json:"securityOptions,omitempty"`
+
+	// metadataService configures the metadata service endpoint of the instance, from which the workloads of the node
+	// get the instance identity and the tokens of its trusted profile.
+	// +optional
+	MetadataService *VPCMetadataService `json:"metadataService,omitempty"`
+
+	// defaultTrustedProfile is the IAM trusted profile for compute resources of the instance, whose tokens are
+	// served by the metadata service so that the workloads of the node authenticate without an API key.
+	// It requires the metadata service to be enabled.
+	// +optional
+	DefaultTrustedProfile *VPCTrustedProfileReference `json:"defaultTrustedProfile,omitempty"`
+
+	// additionalTags are user tags, e.g. env:prod or costcenter:1234, attached to the instance, its volumes and its
+	// floating IP, in addition to the additional tags of the cluster. Tags removed from the list are detached from
+	// the resources.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9 _.:-]+$`
+	// +optional
+	AdditionalTags []string `json:"additionalTags,omitempty"`
+
+	// fileShareMounts are the file shares of the cluster mounted on the machine. The mount path of each file share is
+	// reported in the status once its mount target is stable. A file share removed from the list gets its mount
+	// target deleted.
+	// +listType=map
+	// +listMapKey=share
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	FileShareMounts []VPCFileShareMount `json:"fileShareMounts,omitempty"`
+
 	// SSHKeys is the SSH pub keys that will be used to access VM.
 	// ID will take higher precedence over Name if both specified.
 	SSHKeys []*IBMVPCResourceReference `json:"sshKeys,omitempty"`
@@ -82,9 +167,11 @@ type IBMVPCMachineSpec struct {
 	// additionalVolumes is the list of additional volumes attached to the instance
 	// There is a hard limit of 12 volume attachments per instance:
 	// https://cloud.ibm.com/docs/vpc?topic=vpc-attaching-block-storage&interface=api#vol-attach-limits
+	// A volume removed from the list is detached from the instance, then deleted if deleteVolumeOnInstanceDelete is set,
+	// or retained otherwise. Volumes are matched to their attachments by name, so that removal requires all the volumes
+	// to be named.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MaxItems=12
-	// +kubebuilder:validation:XValidation:rule="oldSelf.all(x, x in self)",message="Values may only be added"
 	AdditionalVolumes []*VPCVolume `json:"additionalVolumes,omitempty"`
 }
 
@@ -118,6 +205,7 @@ type VPCVolume struct {
 
 	// SizeGiB is the size of the virtual server's disk in GiB.
 	// Default to the size of the image's `minimum_provisioned_size`.
+	// The volume of an IBMVPCMachine is expanded online when the size is increased, it cannot be decreased.
 	// +optional
 	SizeGiB int64 `json:"sizeGiB,omitempty"`
 
@@ -125,13 +213,16 @@ type VPCVolume struct {
 	// for more information.
 	// Default to general-purpose
 	// NOTE: Iops is only configurable for the `custom` and `sdp` profiles
+	// The profile of the volume of an IBMVPCMachine is updated when it is changed, except to or from the `sdp` profile,
+	// which IBM Cloud cannot apply to an existing volume.
 	// +kubebuilder:validation:Enum="general-purpose";"5iops-tier";"10iops-tier";"custom";"sdp"
 	// +kubebuilder:default=general-purpose
 	// +optional
 	Profile string `json:"profile,omitempty"`
 
 	// Iops is the maximum I/O operations per second (IOPS) to use for the volume. Applicable only to volumes using the
-	// `custom` profile or the second-generation `sdp` profile.
+	// `custom` profile or the second-generation `sdp` profile. The iops of the volume of an IBMVPCMachine are updated
+	// when they are changed.
 	// +optional
 	Iops int64 `json:"iops,omitempty"`
 
@@ -166,6 +257,35 @@ type IBMVPCMachineStatus struct {
 	// Addresses contains the IBM Cloud instance associated addresses.
 	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
 
+	// additionalNetworkInterfaces is the status of the instance's additional network interfaces.
+	// Their IP addresses are also reported as internal IP addresses of the machine.
+	// +optional
+	AdditionalNetworkInterfaces []NetworkInterfaceStatus `json:"additionalNetworkInterfaces,omitempty"`
+
+	// floatingIP is the status of the floating IP bound to the instance.
+	// +optional
+	FloatingIP *VPCMachineFloatingIPStatus `json:"floatingIP,omitempty"`
+
+	// securityOptions are the effective security options of the instance.
+	// +optional
+	SecurityOptions *VPCSecurityOptionsStatus `json:"securityOptions,omitempty"`
+
+	// primaryReservedIP is the reserved IP created by the controller for the primary network interface.
+	// It is deleted with the instance.
+	// +optional
+	PrimaryReservedIP *VPCReservedIPStatus `json:"primaryReservedIP,omitempty"`
+
+	// attachedTags are the additional tags attached to the resources of the machine, used to detach the tags
+	// removed from the spec.
+	// +optional
+	AttachedTags []string `json:"attachedTags,omitempty"`
+
+	// fileShareMounts are the statuses of the file shares mounted on the machine.
+	// +listType=map
+	// +listMapKey=share
+	// +optional
+	FileShareMounts []VPCFileShareMountStatus `json:"fileShareMounts,omitempty"`
+
 	// Conditions deefines current service state of the IBMVPCMachine.
 	// +optional
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
@@ -186,6 +306,10 @@ type IBMVPCMachineStatus struct {
 	// +optional
 	InstanceStatus string `json:"instanceState,omitempty"`
 
+	// instanceHealthState is the health state of the IBM Cloud instance for this machine, as reported by IBM Cloud.
+	// +optional
+	InstanceHealthState string `json:"instanceHealthState,omitempty"`
+
 	// LoadBalancerPoolMembers is the status of IBM Cloud VPC Load Balancer Backend Pools the machine is a member.
 	// +optional
 	LoadBalancerPoolMembers []VPCLoadBalancerBackendPoolMember `json:"loadBalancerPoolMembers,omitempty"`
@@ -207,6 +331,9 @@ type IBMVPCMachineV1Beta2Status struct {
 	// AdditionalVolumeIDs is a list of Volume IDs as per IBMCloud
 	// +optional
 	AdditionalVolumeIDs []string `json:"additionalVolumeIDs,omitempty"`
+	// RemovedVolumeIDs is a list of IDs of the additional volumes removed from the spec, which are deleted once detached from the instance.
+	// +optional
+	RemovedVolumeIDs []string `json:"removedVolumeIDs,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+)
+
+const (
+	// MachinePoolFinalizer allows IBMVPCMachinePoolReconciler to clean up resources associated with IBMVPCMachinePool before
+	// removing it from the apiserver.
+	MachinePoolFinalizer = "ibmvpcmachinepool.infrastructure.cluster.x-k8s.io"
+)
+
+// IBMVPCMachinePoolSpec defines the desired state of IBMVPCMachinePool.
+type IBMVPCMachinePoolSpec struct {
+	// ProviderIDList is the list of identification IDs of machine instances managed by this MachinePool.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// Image is the OS image which would be install on the instances.
+	// ID will take higher precedence over Name if both specified.
+	Image *IBMVPCResourceReference `json:"image"`
+
+	// Zone is the zone used for the instance template. Instances are spread across the zones of the Subnets.
+	Zone string `json:"zone"`
+
+	// Profile indicates the flavor of the instances. Example: bx2-8x32	means 8 vCPUs	32 GB RAM	16 Gbps
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// BootVolume contains the instances boot volume configurations like size, iops etc..
+	// +optional
+	BootVolume *VPCVolume `json:"bootVolume,omitempty"`
+
+	// Subnets is the list of subnets the instance group places the instances in.
+	// +kubebuilder:validation:MinItems=1
+	Subnets []VPCResource `json:"subnets"`
+
+	// SecurityGroups defines a set of IBM Cloud VPC Security Groups to attach to the primary network interface of the instances.
+	// +optional
+	SecurityGroups []VPCResource `json:"securityGroups,omitempty"`
+
+	// SSHKeys is the SSH pub keys that will be used to access the instances.
+	// ID will take higher precedence over Name if both specified.
+	// +optional
+	SSHKeys []*IBMVPCResourceReference `json:"sshKeys,omitempty"`
+}
+
+// IBMVPCMachinePoolStatus defines the observed state of IBMVPCMachinePool.
+type IBMVPCMachinePoolStatus struct {
+	// Ready is true when the provider resource is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the most recently observed number of replicas.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// InstanceGroupID is the ID of the IBM Cloud VPC Instance Group backing the MachinePool.
+	// +optional
+	InstanceGroupID string `json:"instanceGroupID,omitempty"`
+
+	// InstanceTemplate is the IBM Cloud VPC Instance Template currently used by the Instance Group.
+	// +optional
+	InstanceTemplate *VPCResource `json:"instanceTemplate,omitempty"`
+
+	// attachedTags are the additional tags of the cluster attached to the instance group, its instance template and
+	// its instances, used to detach the tags removed from the spec of the cluster.
+	// +optional
+	AttachedTags []string `json:"attachedTags,omitempty"`
+
+	// Conditions defines current service state of the IBMVPCMachinePool.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// V1beta2 groups all the fields that will be added or modified in IBMVPCMachinePool's status with the V1Beta2 version.
+	// +optional
+	V1Beta2 *IBMVPCMachinePoolV1Beta2Status `json:"v1beta2,omitempty"`
+}
+
+// IBMVPCMachinePoolV1Beta2Status groups all the fields that will be added or modified in IBMVPCMachinePoolStatus with the V1Beta2 version.
+// See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
+type IBMVPCMachinePoolV1Beta2Status struct {
+	// Conditions represents the observations of a IBMVPCMachinePool's current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=ibmvpcmachinepools,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="MachinePool ready status"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="MachinePool replicas count"
+// +kubebuilder:printcolumn:name="Instance Group",type="string",JSONPath=".status.instanceGroupID",description="IBM Cloud VPC Instance Group ID"
+
+// IBMVPCMachinePool is the Schema for the ibmvpcmachinepools API.
+type IBMVPCMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMVPCMachinePoolSpec   `json:"spec,omitempty"`
+	Status IBMVPCMachinePoolStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the IBMVPCMachinePool resource.
+func (r *IBMVPCMachinePool) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMVPCMachinePool to the predescribed clusterv1beta1.Conditions.
+func (r *IBMVPCMachinePool) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetV1Beta2Conditions returns the set of conditions for IBMVPCMachinePool object.
+func (r *IBMVPCMachinePool) GetV1Beta2Conditions() []metav1.Condition {
+	if r.Status.V1Beta2 == nil {
+		return nil
+	}
+	return r.Status.V1Beta2.Conditions
+}
+
+// SetV1Beta2Conditions sets conditions for IBMVPCMachinePool object.
+func (r *IBMVPCMachinePool) SetV1Beta2Conditions(conditions []metav1.Condition) {
+	if r.Status.V1Beta2 == nil {
+		r.Status.V1Beta2 = &IBMVPCMachinePoolV1Beta2Status{}
+	}
+	r.Status.V1Beta2.Conditions = conditions
+}
+
+//+kubebuilder:object:root=true
+
+// IBMVPCMachinePoolList contains a list of IBMVPCMachinePool.
+type IBMVPCMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMVPCMachinePool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &IBMVPCMachinePool{}, &IBMVPCMachinePoolList{})
+}
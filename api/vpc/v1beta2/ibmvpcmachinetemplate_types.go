@@ -39,6 +39,19 @@ type IBMVPCMachineTemplateStatus struct {
 	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
 	// +optional
 	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// reservation is the observed state of the capacity reservation used by the machines, when one is identified by
+	// id or name, which reports whether the reservation is exhausted.
+	// +optional
+	Reservation *VPCReservationStatus `json:"reservation,omitempty"`
+
+	// conditions represents the observations of the IBMVPCMachineTemplate's current state, e.g. whether the details of
+	// its reservation could be retrieved.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:subresource:status
@@ -55,6 +68,16 @@ type IBMVPCMachineTemplate struct {
 	Status IBMVPCMachineTemplateStatus `json:"status,omitempty"`
 }
 
+// GetV1Beta2Conditions returns the set of conditions for IBMVPCMachineTemplate object.
+func (r *IBMVPCMachineTemplate) GetV1Beta2Conditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetV1Beta2Conditions sets conditions for IBMVPCMachineTemplate object.
+func (r *IBMVPCMachineTemplate) SetV1Beta2Conditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
 //+kubebuilder:object:root=true
 
 // IBMVPCMachineTemplateList contains a list of IBMVPCMachineTemplate.
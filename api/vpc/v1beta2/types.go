@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1beta2
 
-import "github.com/IBM/vpc-go-sdk/vpcv1"
+import (
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
 
 const (
 	// CIDRBlockAny is the CIDRBlock representing any allowable destination/source IP.
@@ -268,7 +272,302 @@ type NetworkInterface struct {
 	SecurityGroups []VPCResource `json:"securityGroups,omitempty"`
 
 	// Subnet ID of the network interface.
+	// When not set, the cluster's subnet in the zone of the Machine's failure domain is used and the instance is
+	// created in that zone.
+	Subnet string `json:"subnet,omitempty"`
+
+	// reservedIP binds a reserved IP of the subnet to the network interface, to give it a predictable address.
+	// It is only supported on the primary network interface.
+	// +optional
+	ReservedIP *VPCReservedIP `json:"reservedIP,omitempty"`
+}
+
+// VPCReservedIP defines the reserved IP of a network interface, either an existing reserved IP of the subnet or
+// one created by the controller for a static address or for an address allocated by a CAPI IPAM provider.
+// +kubebuilder:validation:XValidation:rule="[has(self.id), has(self.address), has(self.poolRef)].filter(x, x).size() == 1",message="exactly one of id, address or poolRef must be specified"
+type VPCReservedIP struct {
+	// id is the ID of an existing reserved IP of the subnet.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// address is the IP address of the subnet to reserve.
+	// +kubebuilder:validation:MinLength=7
+	// +kubebuilder:validation:MaxLength=15
+	// +optional
+	Address *string `json:"address,omitempty"`
+
+	// poolRef references the CAPI IPAM pool to allocate the IP address from. An IPAddressClaim named after the
+	// machine is created in the pool for each machine, so that every replica of an IBMVPCMachineTemplate gets its
+	// own address.
+	// +optional
+	PoolRef *ipamv1.IPPoolReference `json:"poolRef,omitempty"`
+}
+
+// VPCReservedIPStatus defines the status of a reserved IP created by the controller.
+type VPCReservedIPStatus struct {
+	// id is the ID of the reserved IP.
+	// +required
+	ID string `json:"id"`
+
+	// subnetID is the ID of the subnet of the reserved IP.
+	// +required
+	SubnetID string `json:"subnetID"`
+
+	// address is the IP address of the reserved IP.
+	// +optional
+	Address string `json:"address,omitempty"`
+}
+
+// VPCNetworkAttachmentMode defines how the network interfaces of an instance are attached.
+type VPCNetworkAttachmentMode string
+
+const (
+	// VPCNetworkAttachmentModeNetworkInterface attaches instance network interfaces, deleted with the instance.
+	VPCNetworkAttachmentModeNetworkInterface VPCNetworkAttachmentMode = "NetworkInterface"
+
+	// VPCNetworkAttachmentModeVirtualNetworkInterface attaches virtual network interfaces, which can outlive the instance.
+	VPCNetworkAttachmentModeVirtualNetworkInterface VPCNetworkAttachmentMode = "VirtualNetworkInterface"
+)
+
+// VPCVirtualNetworkInterfaceDeletionPolicy defines what happens to the virtual network interfaces of an instance
+// when the instance is deleted.
+type VPCVirtualNetworkInterfaceDeletionPolicy string
+
+const (
+	// VPCVirtualNetworkInterfaceDeletionPolicyDelete deletes the virtual network interfaces with the instance.
+	VPCVirtualNetworkInterfaceDeletionPolicyDelete VPCVirtualNetworkInterfaceDeletionPolicy = "Delete"
+
+	// VPCVirtualNetworkInterfaceDeletionPolicyRetain keeps the virtual network interfaces, and their IP addresses,
+	// when the instance is deleted.
+	VPCVirtualNetworkInterfaceDeletionPolicyRetain VPCVirtualNetworkInterfaceDeletionPolicy = "Retain"
+)
+
+// VPCVirtualNetworkInterface defines the options of the virtual network interfaces of an instance.
+type VPCVirtualNetworkInterface struct {
+	// allowIPSpoofing indicates whether source IP spoofing is allowed on the virtual network interfaces.
+	// +optional
+	AllowIPSpoofing *bool `json:"allowIPSpoofing,omitempty"`
+
+	// enableInfrastructureNAT indicates whether the VPC infrastructure performs any needed NAT operations for the
+	// virtual network interfaces. When false, the packets are passed unchanged to and from the instance.
+	// Defaults to true.
+	// +optional
+	EnableInfrastructureNAT *bool `json:"enableInfrastructureNAT,omitempty"`
+
+	// deletionPolicy defines what happens to the virtual network interfaces when the instance is deleted.
+	// With Retain, the virtual network interfaces are kept once their machine is deleted and reattached to the
+	// instance of a machine with the same retainedNamePrefix, such as the machine replacing it, which keeps their IP
+	// addresses across machine replacement. The retained virtual network interfaces are tagged with the name of the
+	// cluster, and the ones which are not reattached, e.g. after a scale down, are deleted with the subnet of the cluster.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy VPCVirtualNetworkInterfaceDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// retainedNamePrefix is the stable prefix of the names of the retained virtual network interfaces, shared by the
+	// machines reattaching them, e.g. all the machines created from a template. It is required when deletionPolicy is
+	// Retain. A retained virtual network interface is named <retainedNamePrefix>-<index>-<random suffix>, the index
+	// being 0 for the primary network interface and the position in additionalNetworkInterfaces, starting at 1, for
+	// the others. Without retention, the names of the virtual network interfaces are generated by IBM Cloud.
+	// +kubebuilder:validation:MaxLength=48
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	RetainedNamePrefix string `json:"retainedNamePrefix,omitempty"`
+}
+
+// VPCAvailabilityClass defines whether an instance may be preempted.
+type VPCAvailabilityClass string
+
+const (
+	// VPCAvailabilityClassStandard instances are not preempted.
+	VPCAvailabilityClassStandard VPCAvailabilityClass = "Standard"
+
+	// VPCAvailabilityClassSpot instances are cheaper, and may be preempted by IBM Cloud.
+	VPCAvailabilityClassSpot VPCAvailabilityClass = "Spot"
+)
+
+// VPCHostFailurePolicy defines what happens to an instance when its compute host fails.
+type VPCHostFailurePolicy string
+
+const (
+	// VPCHostFailurePolicyRestart restarts the instance on another host.
+	VPCHostFailurePolicyRestart VPCHostFailurePolicy = "Restart"
+
+	// VPCHostFailurePolicyStop leaves the instance stopped.
+	VPCHostFailurePolicyStop VPCHostFailurePolicy = "Stop"
+)
+
+// VPCUnhealthyInstancePolicy defines what happens to a machine when the health state of its instance is faulted.
+type VPCUnhealthyInstancePolicy string
+
+const (
+	// VPCUnhealthyInstancePolicyReport only reports the instance in the conditions of the machine and with warning
+	// events.
+	VPCUnhealthyInstancePolicyReport VPCUnhealthyInstancePolicy = "Report"
+
+	// VPCUnhealthyInstancePolicyFail marks the machine as failed.
+	VPCUnhealthyInstancePolicyFail VPCUnhealthyInstancePolicy = "Fail"
+)
+
+// VPCAvailabilityPolicy defines the availability of an instance.
+type VPCAvailabilityPolicy struct {
+	// class is the availability class of the instance. Spot instances may be preempted, in which case they are
+	// stopped and the machine is marked as failed, so that it can be remediated by a MachineHealthCheck.
+	// Defaults to the availability class of the instance profile.
+	// +kubebuilder:validation:Enum=Standard;Spot
+	// +optional
+	Class VPCAvailabilityClass `json:"class,omitempty"`
+
+	// hostFailure defines what happens to the instance when its compute host fails. With Stop, the machine is marked
+	// as failed once the instance is stopped.
+	// Defaults to Restart.
+	// +kubebuilder:validation:Enum=Restart;Stop
+	// +optional
+	HostFailure VPCHostFailurePolicy `json:"hostFailure,omitempty"`
+}
+
+// VPCConfidentialComputeMode defines the confidential computing technology used by an instance.
+type VPCConfidentialComputeMode string
+
+const (
+	// VPCConfidentialComputeModeDisabled disables confidential computing.
+	VPCConfidentialComputeModeDisabled VPCConfidentialComputeMode = "Disabled"
+
+	// VPCConfidentialComputeModeSGX runs the instance with Intel Software Guard Extensions.
+	VPCConfidentialComputeModeSGX VPCConfidentialComputeMode = "SGX"
+
+	// VPCConfidentialComputeModeTDX runs the instance with Intel Trust Domain Extensions.
+	VPCConfidentialComputeModeTDX VPCConfidentialComputeMode = "TDX"
+)
+
+// VPCSecurityOptions defines the security options of an instance, which must be supported by its profile.
+type VPCSecurityOptions struct {
+	// secureBoot enables the secure boot of the instance, so that it only boots trusted software.
+	// Defaults to the secure boot mode of the instance profile.
+	// +optional
+	SecureBoot *bool `json:"secureBoot,omitempty"`
+
+	// confidentialComputeMode is the confidential computing technology of the instance.
+	// Defaults to the confidential compute mode of the instance profile.
+	// +kubebuilder:validation:Enum=Disabled;SGX;TDX
+	// +optional
+	ConfidentialComputeMode VPCConfidentialComputeMode `json:"confidentialComputeMode,omitempty"`
+}
+
+// VPCSecurityOptionsStatus defines the effective security options of an instance.
+type VPCSecurityOptionsStatus struct {
+	// secureBoot indicates whether the secure boot of the instance is enabled.
+	// +optional
+	SecureBoot bool `json:"secureBoot,omitempty"`
+
+	// confidentialComputeMode is the confidential computing technology of the instance.
+	// +optional
+	ConfidentialComputeMode VPCConfidentialComputeMode `json:"confidentialComputeMode,omitempty"`
+}
+
+// VPCMetadataServiceProtocol defines the protocol of the metadata service endpoint of an instance.
+type VPCMetadataServiceProtocol string
+
+const (
+	// VPCMetadataServiceProtocolHTTP serves the metadata service over HTTP.
+	VPCMetadataServiceProtocolHTTP VPCMetadataServiceProtocol = "HTTP"
+
+	// VPCMetadataServiceProtocolHTTPS serves the metadata service over HTTPS.
+	VPCMetadataServiceProtocolHTTPS VPCMetadataServiceProtocol = "HTTPS"
+)
+
+// VPCMetadataService defines the configuration of the metadata service endpoint of an instance.
+type VPCMetadataService struct {
+	// enabled indicates whether the metadata service endpoint is available to the instance, e.g. to the
+	// cloud-controller-manager or the CSI drivers running on the node.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// protocol is the communication protocol of the metadata service endpoint.
+	// Defaults to HTTP.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +optional
+	Protocol VPCMetadataServiceProtocol `json:"protocol,omitempty"`
+
+	// responseHopLimit is the hop limit (IP time to live) of the IP response packets of the metadata service, which
+	// must be increased for containers not running in the host network to reach it.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	ResponseHopLimit int64 `json:"responseHopLimit,omitempty"`
+}
+
+// VPCTrustedProfileReference identifies an IAM trusted profile for compute resources by ID or CRN.
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.crn)",message="exactly one of id or crn must be specified"
+type VPCTrustedProfileReference struct {
+	// id of the trusted profile, e.g. Profile-9fd84246-7df4-4667-94e4-8ecde51d5ac5.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// crn of the trusted profile.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CRN *string `json:"crn,omitempty"`
+
+	// autoLink links the instance to the trusted profile when it is created, rather than requiring the instance to
+	// be linked with the IAM Identity service. The link is deleted with the instance.
+	// Defaults to true.
+	// +optional
+	AutoLink *bool `json:"autoLink,omitempty"`
+}
+
+// VPCMachineFloatingIP defines the floating IP of a machine, either an existing one or one created by the controller.
+// +kubebuilder:validation:XValidation:rule="!(has(self.id) && has(self.name))",message="only one of id or name may be specified"
+type VPCMachineFloatingIP struct {
+	// id is the ID of an existing floating IP to bind to the machine.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// name is the name of the floating IP. An existing floating IP with the name is bound to the machine,
+	// otherwise a floating IP with the name is created. Defaults to the name of the machine when id is not set.
+	// A floating IP created by the controller is tagged with the name of the cluster, owned by the machine and released
+	// when the machine is deleted or when it is removed or replaced in the spec, while an existing one is only unbound
+	// from the machine.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// VPCMachineFloatingIPStatus defines the status of the floating IP of a machine.
+type VPCMachineFloatingIPStatus struct {
+	// id is the ID of the floating IP.
+	// +required
+	ID string `json:"id"`
+
+	// address is the public IP address of the floating IP.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// controllerCreated indicates whether the floating IP was created by the controller,
+	// in which case it is released when the machine is deleted.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+}
+
+// NetworkInterfaceStatus defines the status of a network interface of an instance.
+type NetworkInterfaceStatus struct {
+	// id of the network interface.
+	// +required
+	ID string `json:"id"`
+
+	// subnet is the ID of the subnet of the network interface.
+	// +optional
 	Subnet string `json:"subnet,omitempty"`
+
+	// ipAddress is the primary IP address of the network interface.
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
 }
 
 // VPCLoadBalancerBackendPoolMember represents a VPC Load Balancer Backend Pool Member.
@@ -291,7 +590,7 @@ type VPCLoadBalancerBackendPoolMember struct {
 }
 
 // VPCMachinePlacementTarget represents a VPC Machine's placement restrictions.
-// +kubebuilder:validation:XValidation:rule="(has(self.dedicatedHost) && !has(self.dedicatedHostGroup) && !has(self.placementGroup)) || (!has(self.dedicatedHost) && has(self.dedicatedHostGroup) && !has(self.placementGroup)) || (!has(self.dedicatedHost) && !has(self.dedicatedHostGroup) && has(self.placementGroup))",message="only one of dedicatedHost, dedicatedHostGroup, or placementGroup must be defined for machine placement"
+// +kubebuilder:validation:XValidation:rule="[has(self.dedicatedHost), has(self.dedicatedHostGroup), has(self.placementGroup), has(self.reservation)].filter(x, x).size() == 1",message="only one of dedicatedHost, dedicatedHostGroup, placementGroup, or reservation must be defined for machine placement"
 type VPCMachinePlacementTarget struct {
 	// DedicatedHost defines the Dedicated Host to place a VPC Machine (Instance) on.
 	// +optional
@@ -304,6 +603,197 @@ type VPCMachinePlacementTarget struct {
 	// PlacementGroup defines the Placement Group to use when placing a VPC Machine (Instance).
 	// +optional
 	PlacementGroup *VPCResource `json:"placementGroup,omitempty"`
+
+	// reservation defines the capacity reservation to use when placing a VPC Machine (Instance).
+	// +optional
+	Reservation *VPCReservationTarget `json:"reservation,omitempty"`
+}
+
+// VPCReservationAffinityPolicy defines which capacity reservations an instance can use.
+type VPCReservationAffinityPolicy string
+
+const (
+	// VPCReservationAffinityPolicyManual uses the reservation identified by ID or name.
+	VPCReservationAffinityPolicyManual VPCReservationAffinityPolicy = "Manual"
+
+	// VPCReservationAffinityPolicyAutomatic uses any reservation with an automatic affinity policy, and the same
+	// profile and zone as the instance.
+	VPCReservationAffinityPolicyAutomatic VPCReservationAffinityPolicy = "Automatic"
+)
+
+// VPCReservationTarget defines the capacity reservation used by an instance, either a reservation identified by ID or
+// name, or any matching reservation with an automatic affinity policy.
+// +kubebuilder:validation:XValidation:rule="!(has(self.id) && has(self.name))",message="only one of id or name may be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.id) || has(self.name)) == (!has(self.affinityPolicy) || self.affinityPolicy == 'Manual')",message="id or name must be specified with the Manual affinity policy, and only with it"
+type VPCReservationTarget struct {
+	// id of the reservation.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// name of the reservation.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// affinityPolicy defines which reservations the instance can use. With Manual, the default, the reservation
+	// identified by id or name is used. With Automatic, any reservation with an automatic affinity policy, and the
+	// same profile and zone as the instance, is used when it has available capacity.
+	// +kubebuilder:validation:Enum=Manual;Automatic
+	// +optional
+	AffinityPolicy VPCReservationAffinityPolicy `json:"affinityPolicy,omitempty"`
+}
+
+// VPCReservationStatus defines the observed state of a capacity reservation.
+type VPCReservationStatus struct {
+	// id of the reservation.
+	// +required
+	ID string `json:"id"`
+
+	// status of the reservation, e.g. active or expired.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// total is the total number of instances the reservation can hold.
+	// +optional
+	Total int64 `json:"total,omitempty"`
+
+	// available is the number of instances that can still be placed in the reservation.
+	// +optional
+	Available int64 `json:"available,omitempty"`
+
+	// exhausted indicates whether no more instance can be placed in the reservation, because it has no available
+	// capacity left or it is not active.
+	// +optional
+	Exhausted bool `json:"exhausted,omitempty"`
+}
+
+// VPCSnapshotReference identifies a block storage snapshot by ID, name or CRN.
+// +kubebuilder:validation:XValidation:rule="[has(self.id), has(self.name), has(self.crn)].filter(x, x).size() == 1",message="exactly one of id, name or crn must be specified"
+type VPCSnapshotReference struct {
+	// id of the snapshot.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// name of the snapshot.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// crn of the snapshot.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CRN *string `json:"crn,omitempty"`
+}
+
+// VPCFileShareAccessControlMode defines how the access to a file share is controlled.
+type VPCFileShareAccessControlMode string
+
+const (
+	// VPCFileShareAccessControlModeSecurityGroup controls the access with the security groups of the virtual network
+	// interfaces of the mount targets, created for each machine mounting the file share.
+	VPCFileShareAccessControlModeSecurityGroup VPCFileShareAccessControlMode = "SecurityGroup"
+
+	// VPCFileShareAccessControlModeVPC allows the access from any instance of the VPC in the zone of the file share,
+	// through a single mount target shared by the machines.
+	VPCFileShareAccessControlModeVPC VPCFileShareAccessControlMode = "VPC"
+)
+
+// VPCFileShare defines a VPC file share which should exist or be created for the cluster.
+type VPCFileShare struct {
+	// name of the file share. An existing file share with the name is used, otherwise it is created.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`
+	// +required
+	Name string `json:"name"`
+
+	// size of the file share in gigabytes.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=32000
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="size is immutable"
+	// +required
+	Size int64 `json:"size"`
+
+	// profile is the name of the file share profile.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="profile is immutable"
+	// +kubebuilder:default=dp2
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// zone of the file share. It can only be mounted by the machines in this zone.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	// +required
+	Zone string `json:"zone"`
+
+	// accessControlMode defines how the access to the file share is controlled. With SecurityGroup, a mount target is
+	// created for each machine mounting the file share, in the subnet and with the security groups of its primary
+	// network interface. With VPC, any instance of the VPC in the zone of the file share can mount it.
+	// +kubebuilder:validation:Enum=SecurityGroup;VPC
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accessControlMode is immutable"
+	// +kubebuilder:default=SecurityGroup
+	// +optional
+	AccessControlMode VPCFileShareAccessControlMode `json:"accessControlMode,omitempty"`
+}
+
+// VPCFileShareStatus defines the observed state of a VPC file share of the cluster.
+type VPCFileShareStatus struct {
+	// id of the file share.
+	// +required
+	ID string `json:"id"`
+
+	// accessControlMode defines how the access to the file share is controlled.
+	// +optional
+	AccessControlMode VPCFileShareAccessControlMode `json:"accessControlMode,omitempty"`
+
+	// mountTargetID is the ID of the mount target of the file share in the VPC, with the VPC access control mode.
+	// +optional
+	MountTargetID string `json:"mountTargetID,omitempty"`
+
+	// mountPath is the NFS mount path of the mount target of the file share in the VPC, with the VPC access control
+	// mode.
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// ready is true when the file share, and its mount target in the VPC if any, are stable.
+	// +optional
+	Ready bool `json:"ready"`
+}
+
+// VPCFileShareMount defines a file share of the cluster mounted on a machine.
+type VPCFileShareMount struct {
+	// share is the name of the file share, from the fileShares of the IBMVPCCluster.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Share string `json:"share"`
+}
+
+// VPCFileShareMountStatus defines the observed state of a file share mounted on a machine.
+type VPCFileShareMountStatus struct {
+	// share is the name of the file share.
+	// +required
+	Share string `json:"share"`
+
+	// shareID is the ID of the file share.
+	// +optional
+	ShareID string `json:"shareID,omitempty"`
+
+	// mountTargetID is the ID of the mount target created for the machine, which is deleted with it. It is not set
+	// for the file shares with the VPC access control mode, mounted through the mount target of the cluster.
+	// +optional
+	MountTargetID string `json:"mountTargetID,omitempty"`
+
+	// mountPath is the NFS mount path of the file share, e.g. to be used by a static PersistentVolume.
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// ready is true when the mount target of the file share is stable.
+	// +optional
+	Ready bool `json:"ready"`
 }
 
 // VPCSecurityGroupPortRange represents a range of ports, minimum to maximum.
@@ -486,3 +976,11 @@ type VPCResource struct {
 	// +optional
 	Name *string `json:"name,omitempty"`
 }
+
+// IBMCloudClusterIdentityReference is a reference to an IBMCloudClusterIdentity.
+type IBMCloudClusterIdentityReference struct {
+	// name of the IBMCloudClusterIdentity.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1"
+	ipamv1beta2 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -83,6 +84,21 @@ func (in *IBMCloudCatalogOffering) DeepCopy() *IBMCloudCatalogOffering {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudClusterIdentityReference) DeepCopyInto(out *IBMCloudClusterIdentityReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudClusterIdentityReference.
+func (in *IBMCloudClusterIdentityReference) DeepCopy() *IBMCloudClusterIdentityReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudClusterIdentityReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMCloudResourceReference) DeepCopyInto(out *IBMCloudResourceReference) {
 	*out = *in
@@ -181,6 +197,26 @@ func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
 		*out = new(VPCNetworkSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(VPCIgnition)
+		**out = **in
+	}
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(IBMCloudClusterIdentityReference)
+		**out = **in
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileShares != nil {
+		in, out := &in.FileShares, &out.FileShares
+		*out = make([]VPCFileShare, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterSpec.
@@ -214,6 +250,34 @@ func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
 	}
 	in.Subnet.DeepCopyInto(&out.Subnet)
 	in.VPCEndpoint.DeepCopyInto(&out.VPCEndpoint)
+	if in.AttachedTags != nil {
+		in, out := &in.AttachedTags, &out.AttachedTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileShares != nil {
+		in, out := &in.FileShares, &out.FileShares
+		*out = make(map[string]*VPCFileShareStatus, len(*in))
+		for key, val := range *in {
+			var outVal *VPCFileShareStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(VPCFileShareStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1beta1.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -410,6 +474,189 @@ func (in *IBMVPCMachineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePool) DeepCopyInto(out *IBMVPCMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePool.
+func (in *IBMVPCMachinePool) DeepCopy() *IBMVPCMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolList) DeepCopyInto(out *IBMVPCMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMVPCMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolList.
+func (in *IBMVPCMachinePoolList) DeepCopy() *IBMVPCMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolSpec) DeepCopyInto(out *IBMVPCMachinePoolSpec) {
+	*out = *in
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(IBMVPCResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootVolume != nil {
+		in, out := &in.BootVolume, &out.BootVolume
+		*out = new(VPCVolume)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]VPCResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]VPCResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = make([]*IBMVPCResourceReference, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IBMVPCResourceReference)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolSpec.
+func (in *IBMVPCMachinePoolSpec) DeepCopy() *IBMVPCMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolStatus) DeepCopyInto(out *IBMVPCMachinePoolStatus) {
+	*out = *in
+	if in.InstanceTemplate != nil {
+		in, out := &in.InstanceTemplate, &out.InstanceTemplate
+		*out = new(VPCResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AttachedTags != nil {
+		in, out := &in.AttachedTags, &out.AttachedTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.V1Beta2 != nil {
+		in, out := &in.V1Beta2, &out.V1Beta2
+		*out = new(IBMVPCMachinePoolV1Beta2Status)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolStatus.
+func (in *IBMVPCMachinePoolStatus) DeepCopy() *IBMVPCMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolV1Beta2Status) DeepCopyInto(out *IBMVPCMachinePoolV1Beta2Status) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolV1Beta2Status.
+func (in *IBMVPCMachinePoolV1Beta2Status) DeepCopy() *IBMVPCMachinePoolV1Beta2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolV1Beta2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 	*out = *in
@@ -428,6 +675,11 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 		*out = new(IBMVPCResourceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SourceSnapshot != nil {
+		in, out := &in.SourceSnapshot, &out.SourceSnapshot
+		*out = new(VPCSnapshotReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LoadBalancerPoolMembers != nil {
 		in, out := &in.LoadBalancerPoolMembers, &out.LoadBalancerPoolMembers
 		*out = make([]VPCLoadBalancerBackendPoolMember, len(*in))
@@ -446,6 +698,53 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 		**out = **in
 	}
 	in.PrimaryNetworkInterface.DeepCopyInto(&out.PrimaryNetworkInterface)
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualNetworkInterface != nil {
+		in, out := &in.VirtualNetworkInterface, &out.VirtualNetworkInterface
+		*out = new(VPCVirtualNetworkInterface)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FloatingIP != nil {
+		in, out := &in.FloatingIP, &out.FloatingIP
+		*out = new(VPCMachineFloatingIP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailabilityPolicy != nil {
+		in, out := &in.AvailabilityPolicy, &out.AvailabilityPolicy
+		*out = new(VPCAvailabilityPolicy)
+		**out = **in
+	}
+	if in.SecurityOptions != nil {
+		in, out := &in.SecurityOptions, &out.SecurityOptions
+		*out = new(VPCSecurityOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(VPCMetadataService)
+		**out = **in
+	}
+	if in.DefaultTrustedProfile != nil {
+		in, out := &in.DefaultTrustedProfile, &out.DefaultTrustedProfile
+		*out = new(VPCTrustedProfileReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileShareMounts != nil {
+		in, out := &in.FileShareMounts, &out.FileShareMounts
+		*out = make([]VPCFileShareMount, len(*in))
+		copy(*out, *in)
+	}
 	if in.SSHKeys != nil {
 		in, out := &in.SSHKeys, &out.SSHKeys
 		*out = make([]*IBMVPCResourceReference, len(*in))
@@ -488,6 +787,36 @@ func (in *IBMVPCMachineStatus) DeepCopyInto(out *IBMVPCMachineStatus) {
 		*out = make([]corev1.NodeAddress, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]NetworkInterfaceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FloatingIP != nil {
+		in, out := &in.FloatingIP, &out.FloatingIP
+		*out = new(VPCMachineFloatingIPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityOptions != nil {
+		in, out := &in.SecurityOptions, &out.SecurityOptions
+		*out = new(VPCSecurityOptionsStatus)
+		**out = **in
+	}
+	if in.PrimaryReservedIP != nil {
+		in, out := &in.PrimaryReservedIP, &out.PrimaryReservedIP
+		*out = new(VPCReservedIPStatus)
+		**out = **in
+	}
+	if in.AttachedTags != nil {
+		in, out := &in.AttachedTags, &out.AttachedTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileShareMounts != nil {
+		in, out := &in.FileShareMounts, &out.FileShareMounts
+		*out = make([]VPCFileShareMountStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -630,6 +959,18 @@ func (in *IBMVPCMachineTemplateStatus) DeepCopyInto(out *IBMVPCMachineTemplateSt
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Reservation != nil {
+		in, out := &in.Reservation, &out.Reservation
+		*out = new(VPCReservationStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateStatus.
@@ -657,6 +998,11 @@ func (in *IBMVPCMachineV1Beta2Status) DeepCopyInto(out *IBMVPCMachineV1Beta2Stat
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemovedVolumeIDs != nil {
+		in, out := &in.RemovedVolumeIDs, &out.RemovedVolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineV1Beta2Status.
@@ -780,6 +1126,11 @@ func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReservedIP != nil {
+		in, out := &in.ReservedIP, &out.ReservedIP
+		*out = new(VPCReservedIP)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
@@ -792,6 +1143,21 @@ func (in *NetworkInterface) DeepCopy() *NetworkInterface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
+func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
@@ -863,12 +1229,27 @@ func (in *VPC) DeepCopy() *VPC {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+func (in *VPCAvailabilityPolicy) DeepCopyInto(out *VPCAvailabilityPolicy) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAvailabilityPolicy.
+func (in *VPCAvailabilityPolicy) DeepCopy() *VPCAvailabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCAvailabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
 	}
 	if in.FIPID != nil {
 		in, out := &in.FIPID, &out.FIPID
@@ -892,6 +1273,97 @@ func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFileShare) DeepCopyInto(out *VPCFileShare) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFileShare.
+func (in *VPCFileShare) DeepCopy() *VPCFileShare {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFileShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFileShareMount) DeepCopyInto(out *VPCFileShareMount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFileShareMount.
+func (in *VPCFileShareMount) DeepCopy() *VPCFileShareMount {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFileShareMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFileShareMountStatus) DeepCopyInto(out *VPCFileShareMountStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFileShareMountStatus.
+func (in *VPCFileShareMountStatus) DeepCopy() *VPCFileShareMountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFileShareMountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFileShareStatus) DeepCopyInto(out *VPCFileShareStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFileShareStatus.
+func (in *VPCFileShareStatus) DeepCopy() *VPCFileShareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFileShareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIgnition) DeepCopyInto(out *VPCIgnition) {
+	*out = *in
+	out.COSInstance = in.COSInstance
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIgnition.
+func (in *VPCIgnition) DeepCopy() *VPCIgnition {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIgnition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIgnitionCOSInstance) DeepCopyInto(out *VPCIgnitionCOSInstance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIgnitionCOSInstance.
+func (in *VPCIgnitionCOSInstance) DeepCopy() *VPCIgnitionCOSInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIgnitionCOSInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLoadBalancerBackendPoolMember) DeepCopyInto(out *VPCLoadBalancerBackendPoolMember) {
 	*out = *in
@@ -1022,6 +1494,51 @@ func (in *VPCLoadBalancerStatus) DeepCopy() *VPCLoadBalancerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCMachineFloatingIP) DeepCopyInto(out *VPCMachineFloatingIP) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCMachineFloatingIP.
+func (in *VPCMachineFloatingIP) DeepCopy() *VPCMachineFloatingIP {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCMachineFloatingIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCMachineFloatingIPStatus) DeepCopyInto(out *VPCMachineFloatingIPStatus) {
+	*out = *in
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCMachineFloatingIPStatus.
+func (in *VPCMachineFloatingIPStatus) DeepCopy() *VPCMachineFloatingIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCMachineFloatingIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCMachinePlacementTarget) DeepCopyInto(out *VPCMachinePlacementTarget) {
 	*out = *in
@@ -1040,6 +1557,11 @@ func (in *VPCMachinePlacementTarget) DeepCopyInto(out *VPCMachinePlacementTarget
 		*out = new(VPCResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Reservation != nil {
+		in, out := &in.Reservation, &out.Reservation
+		*out = new(VPCReservationTarget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCMachinePlacementTarget.
@@ -1052,6 +1574,21 @@ func (in *VPCMachinePlacementTarget) DeepCopy() *VPCMachinePlacementTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCMetadataService) DeepCopyInto(out *VPCMetadataService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCMetadataService.
+func (in *VPCMetadataService) DeepCopy() *VPCMetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCMetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCNetworkSpec) DeepCopyInto(out *VPCNetworkSpec) {
 	*out = *in
@@ -1210,6 +1747,91 @@ func (in *VPCNetworkStatus) DeepCopy() *VPCNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCReservationStatus) DeepCopyInto(out *VPCReservationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCReservationStatus.
+func (in *VPCReservationStatus) DeepCopy() *VPCReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCReservationTarget) DeepCopyInto(out *VPCReservationTarget) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCReservationTarget.
+func (in *VPCReservationTarget) DeepCopy() *VPCReservationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCReservationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCReservedIP) DeepCopyInto(out *VPCReservedIP) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(ipamv1beta2.IPPoolReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCReservedIP.
+func (in *VPCReservedIP) DeepCopy() *VPCReservedIP {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCReservedIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCReservedIPStatus) DeepCopyInto(out *VPCReservedIPStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCReservedIPStatus.
+func (in *VPCReservedIPStatus) DeepCopy() *VPCReservedIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCReservedIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCResource) DeepCopyInto(out *VPCResource) {
 	*out = *in
@@ -1430,6 +2052,126 @@ func (in *VPCSecurityGroupStatus) DeepCopy() *VPCSecurityGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCSecurityOptions) DeepCopyInto(out *VPCSecurityOptions) {
+	*out = *in
+	if in.SecureBoot != nil {
+		in, out := &in.SecureBoot, &out.SecureBoot
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSecurityOptions.
+func (in *VPCSecurityOptions) DeepCopy() *VPCSecurityOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCSecurityOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCSecurityOptionsStatus) DeepCopyInto(out *VPCSecurityOptionsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSecurityOptionsStatus.
+func (in *VPCSecurityOptionsStatus) DeepCopy() *VPCSecurityOptionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCSecurityOptionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCSnapshotReference) DeepCopyInto(out *VPCSnapshotReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.CRN != nil {
+		in, out := &in.CRN, &out.CRN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSnapshotReference.
+func (in *VPCSnapshotReference) DeepCopy() *VPCSnapshotReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCSnapshotReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCTrustedProfileReference) DeepCopyInto(out *VPCTrustedProfileReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CRN != nil {
+		in, out := &in.CRN, &out.CRN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoLink != nil {
+		in, out := &in.AutoLink, &out.AutoLink
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCTrustedProfileReference.
+func (in *VPCTrustedProfileReference) DeepCopy() *VPCTrustedProfileReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCTrustedProfileReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCVirtualNetworkInterface) DeepCopyInto(out *VPCVirtualNetworkInterface) {
+	*out = *in
+	if in.AllowIPSpoofing != nil {
+		in, out := &in.AllowIPSpoofing, &out.AllowIPSpoofing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableInfrastructureNAT != nil {
+		in, out := &in.EnableInfrastructureNAT, &out.EnableInfrastructureNAT
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCVirtualNetworkInterface.
+func (in *VPCVirtualNetworkInterface) DeepCopy() *VPCVirtualNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCVirtualNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCVolume) DeepCopyInto(out *VPCVolume) {
 	*out = *in
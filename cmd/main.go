@@ -23,6 +23,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	// +kubebuilder:scaffold:imports
@@ -44,9 +45,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/crdmigrator"
 	"sigs.k8s.io/cluster-api/util/flags"
 
+	identityv1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/identity/v1beta2"
 	powervsinfrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta2"
 	powervsinfrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
 	vpcinfrav1beta1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta1"
@@ -56,6 +59,14 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/webhooks/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/options"
+	fakescope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/fake"
+	powervsscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/powervs"
+	vpcscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	fakeservices "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/fake"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -78,6 +89,13 @@ var (
 	watchFilterValue       string
 	disableHTTP2           bool
 	skipCRDMigrationPhases []string
+
+	credentialsReloadInterval time.Duration
+	tracingOptions            tracing.Options
+	apiRetryOptions           = retry.DefaultOptions()
+	apiServiceQPS             map[string]string
+	fakeBackend               bool
+	fakeBackendSeedFile       string
 )
 
 func init() {
@@ -88,7 +106,9 @@ func init() {
 	utilruntime.Must(powervsinfrav1.AddToScheme(scheme))
 	utilruntime.Must(vpcinfrav1beta1.AddToScheme(scheme))
 	utilruntime.Must(vpcinfrav1.AddToScheme(scheme))
+	utilruntime.Must(identityv1.AddToScheme(scheme))
 	utilruntime.Must(clusterv1.AddToScheme(scheme))
+	utilruntime.Must(ipamv1.AddToScheme(scheme))
 	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
@@ -164,6 +184,66 @@ func initFlags(fs *pflag.FlagSet) {
 		[]string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
 
+	fs.DurationVar(&credentialsReloadInterval,
+		"credentials-reload-interval",
+		30*time.Second,
+		"The interval at which the IBM Cloud credentials file is checked for changes to reload the credentials without restarting the controller. Set to 0 to disable the reload.")
+
+	fs.StringVar(&tracingOptions.Endpoint,
+		"tracing-otlp-endpoint",
+		"",
+		"The host:port of the OpenTelemetry collector the reconcile and IBM Cloud API call traces are exported to over OTLP gRPC. Tracing is disabled if unspecified.")
+
+	fs.BoolVar(&tracingOptions.Insecure,
+		"tracing-otlp-insecure",
+		false,
+		"Disable the transport security of the connection to the OpenTelemetry collector.")
+
+	fs.Float64Var(&tracingOptions.SamplingRatio,
+		"tracing-sampling-ratio",
+		1,
+		"The ratio of the reconciliations that are traced, between 0 and 1.")
+
+	fs.Float64Var(&apiRetryOptions.QPS,
+		"ibmcloud-api-qps",
+		apiRetryOptions.QPS,
+		"The number of IBM Cloud API calls per second allowed to each IBM Cloud service. Set to 0 to disable the rate limiting.")
+
+	fs.IntVar(&apiRetryOptions.Burst,
+		"ibmcloud-api-burst",
+		apiRetryOptions.Burst,
+		"The number of IBM Cloud API calls allowed to each IBM Cloud service in a burst above ibmcloud-api-qps.")
+
+	fs.StringToStringVar(&apiServiceQPS,
+		"ibmcloud-api-service-qps",
+		map[string]string{},
+		"Overrides of ibmcloud-api-qps for IBM Cloud services, e.g. vpc=10,powervs=5. Valid services are vpc, powervs, transitgateway, cos, resourcecontroller, globaltagging and resourcemanager.")
+
+	fs.IntVar(&apiRetryOptions.MaxRetries,
+		"ibmcloud-api-max-retries",
+		apiRetryOptions.MaxRetries,
+		"The maximum number of retries of an IBM Cloud API call which is throttled or, for idempotent calls, which fails with a server or network error. Set to 0 to disable the retries.")
+
+	fs.DurationVar(&apiRetryOptions.InitialBackoff,
+		"ibmcloud-api-retry-initial-backoff",
+		apiRetryOptions.InitialBackoff,
+		"The delay before the first retry of an IBM Cloud API call, doubled for each following retry.")
+
+	fs.DurationVar(&apiRetryOptions.MaxBackoff,
+		"ibmcloud-api-retry-max-backoff",
+		apiRetryOptions.MaxBackoff,
+		"The maximum delay between two retries of an IBM Cloud API call. Throttled calls with a longer Retry-After delay are not retried.")
+
+	fs.BoolVar(&fakeBackend,
+		"fake-ibmcloud-backend",
+		false,
+		"Run the controllers against an in-memory fake IBM Cloud backend instead of IBM Cloud, e.g. to run the e2e tests locally without an IBM Cloud account. Not meant for production use.")
+
+	fs.StringVar(&fakeBackendSeedFile,
+		"fake-ibmcloud-backend-seed",
+		"",
+		"The YAML file of the resources which exist in the fake IBM Cloud backend at startup, e.g. the images and SSH keys referenced by the machines. Only used with fake-ibmcloud-backend.")
+
 	flags.AddManagerOptions(fs, &managerOptions)
 }
 
@@ -179,6 +259,18 @@ func validateFlags() error {
 		return err
 	}
 
+	apiRetryOptions.ServiceQPS = make(map[string]float64, len(apiServiceQPS))
+	for service, value := range apiServiceQPS {
+		qps, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for flag ibmcloud-api-service-qps: %s=%s: %w", service, value, err)
+		}
+		apiRetryOptions.ServiceQPS[service] = qps
+	}
+	if err := retry.Configure(apiRetryOptions); err != nil {
+		return fmt.Errorf("invalid IBM Cloud API rate limiting and retry flags: %w", err)
+	}
+
 	return nil
 }
 
@@ -279,9 +371,15 @@ func main() {
 	// Setup the context that's going to be used in controllers and for the manager.
 	ctx := ctrl.SetupSignalHandler()
 
-	setupReconcilers(ctx, mgr, serviceEndpoint)
+	shutdownTracing := setupTracing(ctx)
+
+	vpcClientBuilder, powerVSClientBuilder := setupClientBuilders()
+	setupReconcilers(ctx, mgr, serviceEndpoint, vpcClientBuilder, powerVSClientBuilder)
 	setupWebhooks(mgr)
 	setupChecks(mgr)
+	if !fakeBackend {
+		setupCredentialsWatcher(mgr)
+	}
 
 	// +kubebuilder:scaffold:builder
 	setupLog.Info("starting manager")
@@ -289,9 +387,63 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+	shutdownTracing()
 }
 
-func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []endpoints.ServiceEndpoint) {
+func setupTracing(ctx context.Context) func() {
+	shutdown, err := tracing.Setup(ctx, tracingOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to setup tracing")
+		os.Exit(1)
+	}
+	if tracingOptions.Endpoint != "" {
+		setupLog.Info("Exporting traces to OpenTelemetry collector", "endpoint", tracingOptions.Endpoint, "samplingRatio", tracingOptions.SamplingRatio)
+	}
+
+	return func() {
+		// The manager context is already cancelled, flush the pending spans with a new one.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(shutdownCtx); err != nil {
+			setupLog.Error(err, "unable to flush traces")
+		}
+	}
+}
+
+func setupCredentialsWatcher(mgr ctrl.Manager) {
+	if credentialsReloadInterval <= 0 {
+		return
+	}
+	if err := mgr.Add(&authenticator.CredentialsWatcher{Interval: credentialsReloadInterval}); err != nil {
+		setupLog.Error(err, "unable to create credentials watcher")
+		os.Exit(1)
+	}
+}
+
+// setupClientBuilders returns the builders of the IBM Cloud clients of the VPC and PowerVS controllers,
+// which return the clients of an in-memory backend if the fake backend is enabled.
+func setupClientBuilders() (vpcscope.ClientBuilder, powervsscope.ClientBuilder) {
+	if !fakeBackend {
+		return vpcscope.ProdClientBuilder{}, powervsscope.ProdClientBuilder{}
+	}
+
+	setupLog.Info("WARNING: Using the in-memory fake IBM Cloud backend, no IBM Cloud resources are created")
+	backend := fakeservices.NewBackend()
+	if fakeBackendSeedFile != "" {
+		seed, err := fakeservices.LoadSeedFile(fakeBackendSeedFile)
+		if err != nil {
+			setupLog.Error(err, "unable to load fake IBM Cloud backend seed")
+			os.Exit(1)
+		}
+		backend.Load(seed)
+	}
+	accounts.GetAccountIDFunc = func() (string, error) {
+		return fakeservices.AccountID, nil
+	}
+	return fakescope.VPCClientBuilder{Backend: backend}, fakescope.PowerVSClientBuilder{Backend: backend}
+}
+
+func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []endpoints.ServiceEndpoint, vpcClientBuilder vpcscope.ClientBuilder, powerVSClientBuilder powervsscope.ClientBuilder) {
 	// Note: The kubebuilder RBAC markers above has to be kept in sync
 	// with the CRDs that should be migrated by this provider.
 	crdMigratorConfig := map[client.Object]crdmigrator.ByObjectConfig{
@@ -324,6 +476,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmvpccluster-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		ClientBuilder:   vpcClientBuilder,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCCluster")
 		os.Exit(1)
@@ -335,15 +488,29 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmvpcmachine-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		ClientBuilder:   vpcClientBuilder,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCMachine")
 		os.Exit(1)
 	}
 
+	if err := (&controllers.IBMVPCMachinePoolReconciler{
+		Client:          mgr.GetClient(),
+		Log:             ctrl.Log.WithName("controllers").WithName("IBMVPCMachinePool"),
+		Recorder:        mgr.GetEventRecorderFor("ibmvpcmachinepool-controller"),
+		ServiceEndpoint: serviceEndpoint,
+		Scheme:          mgr.GetScheme(),
+		ClientBuilder:   vpcClientBuilder,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCMachinePool")
+		os.Exit(1)
+	}
+
 	if err := (&controllers.IBMVPCMachineTemplateReconciler{
 		Client:          mgr.GetClient(),
 		Scheme:          mgr.GetScheme(),
 		ServiceEndpoint: serviceEndpoint,
+		ClientBuilder:   vpcClientBuilder,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ibmvpcmachinetemplate")
 		os.Exit(1)
@@ -355,6 +522,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		ServiceEndpoint:  serviceEndpoint,
 		Scheme:           mgr.GetScheme(),
 		WatchFilterValue: watchFilterValue,
+		ClientBuilder:    powerVSClientBuilder,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSCluster")
 		os.Exit(1)
@@ -366,6 +534,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		ServiceEndpoint:  serviceEndpoint,
 		Scheme:           mgr.GetScheme(),
 		WatchFilterValue: watchFilterValue,
+		ClientBuilder:    powerVSClientBuilder,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSMachine")
 		os.Exit(1)
@@ -384,6 +553,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmpowervsimage-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		ClientBuilder:   powerVSClientBuilder,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSImage")
 		os.Exit(1)
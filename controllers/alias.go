@@ -30,6 +30,8 @@ import (
 	powervscontroller "sigs.k8s.io/cluster-api-provider-ibmcloud/internal/controllers/powervs"
 	vpccontroller "sigs.k8s.io/cluster-api-provider-ibmcloud/internal/controllers/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
+	powervsscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/powervs"
+	vpcscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
 )
 
 // IBMVPCClusterReconciler reonciles a IBMVPCCluster object.
@@ -39,6 +41,9 @@ type IBMVPCClusterReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder vpcscope.ClientBuilder
 }
 
 func (r *IBMVPCClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -48,6 +53,7 @@ func (r *IBMVPCClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 		Recorder:        r.Recorder,
 		ServiceEndpoint: r.ServiceEndpoint,
 		Scheme:          r.Scheme,
+		ClientBuilder:   r.ClientBuilder,
 	}).SetupWithManager(ctx, mgr)
 }
 
@@ -58,6 +64,9 @@ type IBMVPCMachineReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder vpcscope.ClientBuilder
 }
 
 func (r *IBMVPCMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -67,6 +76,30 @@ func (r *IBMVPCMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 		Recorder:        r.Recorder,
 		ServiceEndpoint: r.ServiceEndpoint,
 		Scheme:          r.Scheme,
+		ClientBuilder:   r.ClientBuilder,
+	}).SetupWithManager(ctx, mgr)
+}
+
+// IBMVPCMachinePoolReconciler reconciles a IBMVPCMachinePool object.
+type IBMVPCMachinePoolReconciler struct {
+	client.Client
+	Log             logr.Logger
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder vpcscope.ClientBuilder
+}
+
+func (r *IBMVPCMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	return (&vpccontroller.IBMVPCMachinePoolReconciler{
+		Client:          r.Client,
+		Log:             r.Log,
+		Recorder:        r.Recorder,
+		ServiceEndpoint: r.ServiceEndpoint,
+		Scheme:          r.Scheme,
+		ClientBuilder:   r.ClientBuilder,
 	}).SetupWithManager(ctx, mgr)
 }
 
@@ -75,6 +108,9 @@ type IBMVPCMachineTemplateReconciler struct {
 	client.Client
 	Scheme          *runtime.Scheme
 	ServiceEndpoint []endpoints.ServiceEndpoint
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder vpcscope.ClientBuilder
 }
 
 func (r *IBMVPCMachineTemplateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -82,6 +118,7 @@ func (r *IBMVPCMachineTemplateReconciler) SetupWithManager(ctx context.Context,
 		Client:          r.Client,
 		Scheme:          r.Scheme,
 		ServiceEndpoint: r.ServiceEndpoint,
+		ClientBuilder:   r.ClientBuilder,
 	}).SetupWithManager(ctx, mgr)
 }
 
@@ -94,6 +131,9 @@ type IBMPowerVSClusterReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder powervsscope.ClientBuilder
 }
 
 func (r *IBMPowerVSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -103,6 +143,7 @@ func (r *IBMPowerVSClusterReconciler) SetupWithManager(ctx context.Context, mgr
 		ServiceEndpoint:  r.ServiceEndpoint,
 		Scheme:           r.Scheme,
 		WatchFilterValue: r.WatchFilterValue,
+		ClientBuilder:    r.ClientBuilder,
 	}).SetupWithManager(ctx, mgr)
 }
 
@@ -115,6 +156,9 @@ type IBMPowerVSMachineReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder powervsscope.ClientBuilder
 }
 
 func (r *IBMPowerVSMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -124,6 +168,7 @@ func (r *IBMPowerVSMachineReconciler) SetupWithManager(ctx context.Context, mgr
 		ServiceEndpoint:  r.ServiceEndpoint,
 		Scheme:           r.Scheme,
 		WatchFilterValue: r.WatchFilterValue,
+		ClientBuilder:    r.ClientBuilder,
 	}).SetupWithManager(ctx, mgr)
 }
 
@@ -146,6 +191,9 @@ type IBMPowerVSImageReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	// ClientBuilder constructs the IBM Cloud clients, the production clients are used if not set.
+	ClientBuilder powervsscope.ClientBuilder
 }
 
 func (r *IBMPowerVSImageReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -154,5 +202,6 @@ func (r *IBMPowerVSImageReconciler) SetupWithManager(ctx context.Context, mgr ct
 		Recorder:        r.Recorder,
 		ServiceEndpoint: r.ServiceEndpoint,
 		Scheme:          r.Scheme,
+		ClientBuilder:   r.ClientBuilder,
 	}).SetupWithManager(ctx, mgr)
 }
@@ -23,6 +23,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
+
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -52,6 +54,9 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
 	powervsscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 )
 
 const (
@@ -85,11 +90,15 @@ type componentResult struct {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=create;delete;get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmcloudclusteridentities,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMPowerVSCluster.
 func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
 
+	ctx, span := tracing.StartSpan(ctx, "IBMPowerVSCluster.Reconcile", tracing.NamespaceKey.String(req.Namespace), tracing.NameKey.String(req.Name))
+	defer func() { tracing.EndSpan(span, reterr) }()
+
 	log.Info("Reconciling IBMPowerVSCluster")
 	defer log.Info("Finished reconciling IBMPowerVSCluster")
 
@@ -120,6 +129,7 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	log = log.WithValues("Cluster", klog.KObj(cluster))
 	ctx = ctrl.LoggerInto(ctx, log)
+	span.SetAttributes(tracing.ClusterNameKey.String(cluster.Name))
 
 	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmPowerVSCluster); err != nil || isPaused || requeue {
 		return ctrl.Result{}, err
@@ -151,6 +161,10 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}()
 
+	if err := reconcileCredentials(ibmPowerVSCluster, clusterScope.Authenticator); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Handle deleted clusters.
 	if !ibmPowerVSCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, clusterScope)
@@ -176,13 +190,15 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 
 	// validate PER availability for the PowerVS zone, proceed further only if PowerVS zone support PER.
 	// more information about PER can be found here: https://cloud.ibm.com/docs/power-iaas?topic=power-iaas-per
-	if err := clusterScope.ValidateZoneSupportsPER(ctx); err != nil {
+	if _, err := tracing.TracePhase(ctx, "ValidateZoneSupportsPER", tracing.NoRequeue(clusterScope.ValidateZoneSupportsPER), nil); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to validate PER capability for PowerVS zone: %w", err)
 	}
 
 	// reconcile resource group
 	log.Info("Reconciling resource group")
-	if err := clusterScope.ReconcileResourceGroup(ctx); err != nil {
+	if _, err := tracing.TracePhase(ctx, "ReconcileResourceGroup", tracing.NoRequeue(clusterScope.ReconcileResourceGroup), func() string {
+		return clusterScope.IBMPowerVSCluster.Status.ResourceGroup.ID
+	}); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile resource group: %w", err)
 	}
 
@@ -190,10 +206,12 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 	ch := make(chan componentResult, 2)
 
 	wg.Go(func() {
+		ctx, clusterScope := clusterScope.Fork(ctx)
 		ch <- r.reconcilePowerVSResources(ctx, clusterScope)
 	})
 
 	wg.Go(func() {
+		ctx, clusterScope := clusterScope.Fork(ctx)
 		ch <- r.reconcileVPCResources(ctx, clusterScope)
 	})
 
@@ -226,7 +244,9 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 	}
 
 	log.Info("Reconciling transit gateway")
-	if requeue, err := clusterScope.ReconcileTransitGateway(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileTransitGateway", clusterScope.ReconcileTransitGateway, func() string {
+		return clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID
+	}); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.TransitGatewayReadyCondition, infrav1.TransitGatewayReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.TransitGatewayNotReadyReason, infrav1.TransitGatewayReconciliationFailedV1Beta2Reason, err.Error())
 		conditions.Set(clusterScope.IBMPowerVSCluster, condition)
 		deprecatedv1beta1conditions.Set(clusterScope.IBMPowerVSCluster, legacyCondition)
@@ -242,7 +262,9 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 
 	if clusterScope.IBMPowerVSCluster.Spec.COSInstance.Type != "" {
 		log.Info("Reconciling COS service instance")
-		if err := clusterScope.ReconcileCOSInstance(ctx); err != nil {
+		if _, err := tracing.TracePhase(ctx, "ReconcileCOSInstance", tracing.NoRequeue(clusterScope.ReconcileCOSInstance), func() string {
+			return clusterScope.IBMPowerVSCluster.Status.COSInstance.ID
+		}); err != nil {
 			condition, legacyCondition := r.buildConditions(infrav1.COSInstanceReadyCondition, infrav1.COSInstanceReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.COSInstanceNotReadyReason, infrav1.COSInstanceReconciliationFailedV1Beta2Reason, err.Error())
 			conditions.Set(clusterScope.IBMPowerVSCluster, condition)
 			deprecatedv1beta1conditions.Set(clusterScope.IBMPowerVSCluster, legacyCondition)
@@ -284,7 +306,9 @@ func (r *IBMPowerVSClusterReconciler) reconcilePowerVSResources(ctx context.Cont
 	defer log.Info("Finished Reconciling PowerVS resources")
 
 	log.Info("Reconciling PowerVS workspace")
-	if requeue, err := clusterScope.ReconcileWorkspace(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileWorkspace", clusterScope.ReconcileWorkspace, func() string {
+		return clusterScope.IBMPowerVSCluster.Status.Workspace.ID
+	}); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.WorkspaceReadyCondition, infrav1.ServiceInstanceReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.WorkspaceNotReadyReason, infrav1.ServiceInstanceReconciliationFailedV1Beta2Reason, err.Error())
 		res.conditions = append(res.conditions, condition)
 		res.legacy = append(res.legacy, legacyCondition)
@@ -300,7 +324,9 @@ func (r *IBMPowerVSClusterReconciler) reconcilePowerVSResources(ctx context.Cont
 	res.legacy = append(res.legacy, legacyCondition)
 
 	log.Info("Reconciling network")
-	if requeue, err := clusterScope.ReconcileNetwork(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileNetwork", clusterScope.ReconcileNetwork, func() string {
+		return clusterScope.IBMPowerVSCluster.Status.Network.ID
+	}); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.NetworkReadyCondition, infrav1.NetworkReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.NetworkNotReadyReason, infrav1.NetworkReconciliationFailedV1Beta2Reason, err.Error())
 		res.conditions = append(res.conditions, condition)
 		res.legacy = append(res.legacy, legacyCondition)
@@ -322,7 +348,9 @@ func (r *IBMPowerVSClusterReconciler) reconcileVPCResources(ctx context.Context,
 	res := componentResult{}
 
 	log.Info("Reconciling VPC")
-	if requeue, err := clusterScope.ReconcileVPC(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileVPC", clusterScope.ReconcileVPC, func() string {
+		return clusterScope.IBMPowerVSCluster.Status.VPC.ID
+	}); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.VPCReadyCondition, infrav1.VPCReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.VPCNotReadyReason, infrav1.VPCReconciliationFailedV1Beta2Reason, err.Error())
 		res.conditions = append(res.conditions, condition)
 		res.legacy = append(res.legacy, legacyCondition)
@@ -339,7 +367,7 @@ func (r *IBMPowerVSClusterReconciler) reconcileVPCResources(ctx context.Context,
 
 	// reconcile VPC Subnet
 	log.Info("Reconciling VPC subnets")
-	if requeue, err := clusterScope.ReconcileVPCSubnets(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileVPCSubnets", clusterScope.ReconcileVPCSubnets, nil); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.VPCSubnetReadyCondition, infrav1.VPCSubnetReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.VPCSubnetNotReadyReason, infrav1.VPCSubnetReconciliationFailedV1Beta2Reason, err.Error())
 		res.conditions = append(res.conditions, condition)
 		res.legacy = append(res.legacy, legacyCondition)
@@ -356,7 +384,7 @@ func (r *IBMPowerVSClusterReconciler) reconcileVPCResources(ctx context.Context,
 
 	// reconcile VPC security group
 	log.Info("Reconciling VPC security group")
-	if err := clusterScope.ReconcileVPCSecurityGroups(ctx); err != nil {
+	if _, err := tracing.TracePhase(ctx, "ReconcileVPCSecurityGroups", tracing.NoRequeue(clusterScope.ReconcileVPCSecurityGroups), nil); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.VPCSecurityGroupReadyCondition, infrav1.VPCSecurityGroupReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.VPCSecurityGroupReconciliationFailedReason, infrav1.VPCSecurityGroupReconciliationFailedV1Beta2Reason, err.Error())
 		res.conditions = append(res.conditions, condition)
 		res.legacy = append(res.legacy, legacyCondition)
@@ -369,13 +397,17 @@ func (r *IBMPowerVSClusterReconciler) reconcileVPCResources(ctx context.Context,
 
 	// reconcile LoadBalancer
 	log.Info("Reconciling VPC load balancers")
-	if loadBalancerReady, err := clusterScope.ReconcileLoadBalancers(ctx); err != nil {
+	loadBalancersPending := func(ctx context.Context) (bool, error) {
+		loadBalancerReady, err := clusterScope.ReconcileLoadBalancers(ctx)
+		return !loadBalancerReady, err
+	}
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileLoadBalancers", loadBalancersPending, nil); err != nil {
 		condition, legacyCondition := r.buildConditions(infrav1.VPCLoadBalancerReadyCondition, infrav1.LoadBalancerReadyV1Beta2Condition, metav1.ConditionFalse, infrav1.VPCLoadBalancerNotReadyReason, infrav1.LoadBalancerReconciliationFailedV1Beta2Reason, err.Error())
 		res.conditions = append(res.conditions, condition)
 		res.legacy = append(res.legacy, legacyCondition)
 		res.err = fmt.Errorf("failed to reconcile VPC load balancers: %w", err)
 		return res
-	} else if !loadBalancerReady {
+	} else if requeue {
 		log.Info("VPC load balancer creation is pending")
 		// Not blocking here.
 	} else {
@@ -660,6 +692,30 @@ func (r *IBMPowerVSClusterReconciler) buildConditions(condType string, legacyTyp
 	return cond, legacy
 }
 
+// reconcileCredentials verifies that the IBM Cloud credentials used for the cluster are able to authenticate, so that
+// an authentication failure, e.g. after the credentials were rotated, is surfaced on the IBMPowerVSCluster.
+func reconcileCredentials(ibmPowerVSCluster *infrav1.IBMPowerVSCluster, auth core.Authenticator) error {
+	if auth == nil {
+		return nil
+	}
+	if err := authenticator.Verify(auth); err != nil {
+		capibmrecord.Warnf(ibmPowerVSCluster, infrav1.AuthenticationFailedReason, "Failed to authenticate with IBM Cloud - %s", err.Error())
+		conditions.Set(ibmPowerVSCluster, metav1.Condition{
+			Type:    infrav1.CredentialsReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.AuthenticationFailedReason,
+			Message: err.Error(),
+		})
+		return fmt.Errorf("failed to authenticate with IBM Cloud: %w", err)
+	}
+	conditions.Set(ibmPowerVSCluster, metav1.Condition{
+		Type:   infrav1.CredentialsReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.CredentialsReadyReason,
+	})
+	return nil
+}
+
 // patchIBMPowerVSCluster updates the IBMPowerVSCluster and its status on the API server.
 func patchIBMPowerVSCluster(ctx context.Context, patchHelper *patch.Helper, ibmPowerVSCluster *infrav1.IBMPowerVSCluster) error {
 	// We don't need to set VPC/LoadBalancer conditions for an IBMPowerVSCluster
@@ -681,9 +737,11 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *patch.Helper, ibmP
 			infrav1.VPCLoadBalancerReadyCondition,
 			infrav1.TransitGatewayReadyCondition,
 			infrav1.COSInstanceReadyCondition,
+			infrav1.CredentialsReadyCondition,
 		},
 		conditions.IgnoreTypesIfMissing{
 			infrav1.COSInstanceReadyCondition,
+			infrav1.CredentialsReadyCondition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge.
 		conditions.CustomMergeStrategy{
@@ -711,6 +769,7 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *patch.Helper, ibmP
 			infrav1.VPCSecurityGroupReadyCondition,
 			infrav1.TransitGatewayReadyCondition,
 			infrav1.COSInstanceReadyCondition,
+			infrav1.CredentialsReadyCondition,
 		}}, patch.Clusterv1ConditionsFieldPath{statusField, deprecatedStatus, v1beta2Version, deprecatedConditionsField},
 	)
 }
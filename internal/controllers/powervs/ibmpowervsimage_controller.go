@@ -103,6 +103,7 @@ func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, err
 		}
 		scopeParams.Zone = cluster.Spec.Zone
+		scopeParams.IdentityRef = cluster.Spec.IdentityRef
 	}
 
 	// Initialize the patch helper
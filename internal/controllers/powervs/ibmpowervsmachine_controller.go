@@ -52,6 +52,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
 	powervsscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
 	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 )
 
@@ -82,6 +83,9 @@ func init() {
 func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) { //nolint:gocyclo
 	log := ctrl.LoggerFrom(ctx)
 
+	ctx, span := tracing.StartSpan(ctx, "IBMPowerVSMachine.Reconcile", tracing.NamespaceKey.String(req.Namespace), tracing.NameKey.String(req.Name))
+	defer func() { tracing.EndSpan(span, reterr) }()
+
 	log.Info("Reconciling IBMPowerVSMachine")
 	defer log.Info("Finished reconciling IBMPowerVSMachine")
 
@@ -132,6 +136,7 @@ func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	log = log.WithValues("Cluster", klog.KObj(cluster))
 	ctx = ctrl.LoggerInto(ctx, log)
+	span.SetAttributes(tracing.ClusterNameKey.String(cluster.Name))
 
 	// 5. Ensure Infrastructure is defined.
 	if !cluster.Spec.InfrastructureRef.IsDefined() {
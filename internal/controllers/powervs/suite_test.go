@@ -46,7 +46,7 @@ import (
 // that inject mocks directly into scope fields.
 type stubClientBuilder struct{}
 
-func (s stubClientBuilder) GetAuthenticator(_ context.Context) (core.Authenticator, error) {
+func (s stubClientBuilder) GetAuthenticator(_ context.Context, _ powervsscope.AuthenticatorOptions) (core.Authenticator, error) {
 	return nil, nil
 }
 func (s stubClientBuilder) GetPowerVSClient(_ context.Context, _ powervsscope.ClientOptions) (powervssvc.PowerVS, error) {
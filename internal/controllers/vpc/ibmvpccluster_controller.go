@@ -23,8 +23,11 @@ import (
 
 	"github.com/go-logr/logr"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	"go.opentelemetry.io/otel/trace"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -49,6 +52,9 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
 	vpcscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 )
 
 // IBMVPCClusterReconciler reconciles a IBMVPCCluster object.
@@ -58,16 +64,22 @@ type IBMVPCClusterReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	ClientBuilder vpcscope.ClientBuilder
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmcloudclusteridentities,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCCluster.
 func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
 
+	ctx, span := tracing.StartSpan(ctx, "IBMVPCCluster.Reconcile", tracing.NamespaceKey.String(req.Namespace), tracing.NameKey.String(req.Name))
+	defer func() { tracing.EndSpan(span, reterr) }()
+
 	log.Info("Reconciling IBMVPCCluster")
 	defer log.Info("Finished reconciling IBMVPCCluster")
 
@@ -104,16 +116,18 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	log = log.WithValues("Cluster", klog.KObj(cluster))
 	ctx = ctrl.LoggerInto(ctx, log)
+	span.SetAttributes(tracing.ClusterNameKey.String(cluster.Name))
 
 	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmVPCCluster); err != nil || isPaused || requeue {
 		return ctrl.Result{}, err
 	}
 
-	clusterScope, err := vpcscope.NewClusterScope(vpcscope.ClusterScopeParams{
+	clusterScope, err := vpcscope.NewClusterScope(ctx, vpcscope.ClusterScopeParams{
 		Client:          r.Client,
 		Cluster:         cluster,
 		IBMVPCCluster:   ibmVPCCluster,
 		ServiceEndpoint: r.ServiceEndpoint,
+		ClientBuilder:   r.ClientBuilder,
 	})
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
@@ -132,6 +146,10 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}()
 
+	if err := reconcileCredentials(ibmVPCCluster, clusterScope.Authenticator); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Handle deleted clusters.
 	if !ibmVPCCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, clusterScope)
@@ -167,17 +185,19 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 		log.Info("Cluster Controller has not yet set OwnerRef")
 		return ctrl.Result{}, nil
 	}
+	trace.SpanFromContext(ctx).SetAttributes(tracing.ClusterNameKey.String(cluster.Name))
 
 	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmVPCCluster); err != nil || isPaused || requeue {
 		return ctrl.Result{}, err
 	}
 
-	clusterScope, err := vpcscope.NewClusterScopeV2(vpcscope.ClusterScopeParamsV2{
+	clusterScope, err := vpcscope.NewClusterScopeV2(ctx, vpcscope.ClusterScopeParamsV2{
 		Client:          r.Client,
 		Logger:          log,
 		Cluster:         cluster,
 		IBMVPCCluster:   ibmVPCCluster,
 		ServiceEndpoint: r.ServiceEndpoint,
+		ClientBuilder:   r.ClientBuilder,
 	})
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
@@ -196,6 +216,10 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 		}
 	}()
 
+	if err := reconcileCredentials(ibmVPCCluster, clusterScope.Authenticator); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Handle deleted clusters.
 	if !ibmVPCCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDeleteV2(clusterScope)
@@ -279,6 +303,13 @@ func (r *IBMVPCClusterReconciler) reconcile(ctx context.Context, clusterScope *v
 		}
 	}
 
+	// All the machines share the cluster's subnet, publish its zone as the only failure domain.
+	if zone := clusterScope.IBMVPCCluster.Status.Subnet.Zone; zone != nil {
+		clusterScope.IBMVPCCluster.Status.FailureDomains = clusterv1beta1.FailureDomains{
+			*zone: clusterv1beta1.FailureDomainSpec{ControlPlane: true},
+		}
+	}
+
 	if clusterScope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer != nil && clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host == "" {
 		log.Info("Reconciling Load Balancers")
 		loadBalancer, err := r.getOrCreate(clusterScope)
@@ -310,7 +341,12 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 
 	// Reconcile the cluster's VPC.
 	log.Info("Reconciling VPC")
-	if requeue, err := clusterScope.ReconcileVPC(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileVPC", clusterScope.ReconcileVPC, func() string {
+		if status := clusterScope.NetworkStatus(); status != nil && status.VPC != nil {
+			return status.VPC.ID
+		}
+		return ""
+	}); err != nil {
 		log.Error(err, "failed to reconcile VPC")
 		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCReadyCondition, infrav1.VPCReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
@@ -334,7 +370,12 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 
 	// Reconcile the cluster's VPC Custom Image.
 	log.Info("Reconciling VPC Custom Image")
-	if requeue, err := clusterScope.ReconcileVPCCustomImage(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileVPCCustomImage", clusterScope.ReconcileVPCCustomImage, func() string {
+		if clusterScope.IBMVPCCluster.Status.Image != nil {
+			return clusterScope.IBMVPCCluster.Status.Image.ID
+		}
+		return ""
+	}); err != nil {
 		log.Error(err, "failed to reconcile VPC Custom Image")
 		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.ImageReadyCondition, infrav1.ImageReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
@@ -358,7 +399,7 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 
 	// Reconcile the cluster's VPC Subnets.
 	log.Info("Reconciling VPC Subnets")
-	if requeue, err := clusterScope.ReconcileSubnets(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileSubnets", clusterScope.ReconcileSubnets, nil); err != nil {
 		log.Error(err, "failed to reconcile VPC Subnets")
 		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCSubnetReadyCondition, infrav1.VPCSubnetReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
@@ -382,7 +423,7 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 
 	// Reconcile the cluster's Security Groups (and Security Group Rules)
 	log.Info("Reconciling Security Groups")
-	if requeue, err := clusterScope.ReconcileSecurityGroups(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileSecurityGroups", clusterScope.ReconcileSecurityGroups, nil); err != nil {
 		log.Error(err, "failed to reconcile Security Groups")
 		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCSecurityGroupReadyCondition, infrav1.VPCSecurityGroupReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
@@ -406,7 +447,7 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 
 	// Reconcile the cluster's Load Balancers
 	log.Info("Reconciling Load Balancers")
-	if requeue, err := clusterScope.ReconcileLoadBalancers(ctx); err != nil {
+	if requeue, err := tracing.TracePhase(ctx, "ReconcileLoadBalancers", clusterScope.ReconcileLoadBalancers, nil); err != nil {
 		log.Error(err, "failed to reconcile Load Balancers")
 		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
@@ -428,6 +469,38 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		Reason: infrav1.VPCLoadBalancerReadyV1Beta2Reason,
 	})
 
+	// Reconcile the cluster's File Shares
+	if len(clusterScope.IBMVPCCluster.Spec.FileShares) > 0 {
+		log.Info("Reconciling File Shares")
+		if requeue, err := tracing.TracePhase(ctx, "ReconcileFileShares", clusterScope.ReconcileFileShares, nil); err != nil {
+			log.Error(err, "failed to reconcile File Shares")
+			v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCFileShareReadyCondition, infrav1.VPCFileShareReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.VPCFileShareReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.VPCFileShareNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		} else if requeue {
+			log.Info("File Shares creation is pending, requeueing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		log.Info("Reconciliation of File Shares complete")
+		v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.VPCFileShareReadyCondition)
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.VPCFileShareReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.VPCFileShareReadyV1Beta2Reason,
+		})
+	}
+
+	// Reconcile the additional tags of the cluster's resources.
+	if err := clusterScope.ReconcileAdditionalTags(ctx); err != nil {
+		log.Error(err, "failed to reconcile additional tags")
+		return reconcile.Result{}, fmt.Errorf("error reconciling additional tags: %w", err)
+	}
+
 	// Collect cluster's Load Balancer hostname for spec.
 	hostName, err := clusterScope.GetLoadBalancerHostName()
 	if err != nil {
@@ -503,6 +576,14 @@ func (r *IBMVPCClusterReconciler) reconcileDelete(ctx context.Context, clusterSc
 		Status: metav1.ConditionFalse,
 		Reason: infrav1.VPCSubnetDeletingV1Beta2Reason,
 	})
+	deleting, err := clusterScope.DeleteRetainedVirtualNetworkInterfaces(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete retained virtual network interfaces: %w", err)
+	}
+	// Skip deleting the subnet if still have retained virtual network interfaces in it.
+	if deleting {
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
 	if err := clusterScope.DeleteSubnet(ctx); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to delete subnet: %w", err)
 	}
@@ -585,12 +666,41 @@ func (r *IBMVPCClusterReconciler) reconcileLBState(ctx context.Context, clusterS
 
 // SetupWithManager creates a new IBMVPCCluster controller for a manager.
 func (r *IBMVPCClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if r.ClientBuilder == nil {
+		r.ClientBuilder = vpcscope.ProdClientBuilder{}
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.IBMVPCCluster{}).
 		WithEventFilter(predicates.ResourceIsNotExternallyManaged(r.Scheme, ctrl.LoggerFrom(ctx))).
 		Complete(r)
 }
 
+// reconcileCredentials verifies that the IBM Cloud credentials used for the cluster are able to authenticate, so that
+// an authentication failure, e.g. after the credentials were rotated, is surfaced on the IBMVPCCluster.
+func reconcileCredentials(ibmVPCCluster *infrav1.IBMVPCCluster, auth core.Authenticator) error {
+	if auth == nil {
+		return nil
+	}
+	if err := authenticator.Verify(auth); err != nil {
+		capibmrecord.Warnf(ibmVPCCluster, infrav1.AuthenticationFailedReason, "Failed to authenticate with IBM Cloud - %s", err.Error())
+		v1beta1conditions.MarkFalse(ibmVPCCluster, infrav1.CredentialsReadyCondition, infrav1.AuthenticationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		v1beta2conditions.Set(ibmVPCCluster, metav1.Condition{
+			Type:    infrav1.CredentialsReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.CredentialsAuthenticationFailedV1Beta2Reason,
+			Message: err.Error(),
+		})
+		return fmt.Errorf("failed to authenticate with IBM Cloud for IBMVPCCluster %s: %w", klog.KObj(ibmVPCCluster), err)
+	}
+	v1beta1conditions.MarkTrue(ibmVPCCluster, infrav1.CredentialsReadyCondition)
+	v1beta2conditions.Set(ibmVPCCluster, metav1.Condition{
+		Type:   infrav1.CredentialsReadyV1Beta2Condition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.CredentialsReadyV1Beta2Reason,
+	})
+	return nil
+}
+
 // patchIBMVPCCluster updates the IBMVPCCluster and its status on the API server.
 func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, ibmVPCCluster *infrav1.IBMVPCCluster) error {
 	if err := v1beta2conditions.SetSummaryCondition(ibmVPCCluster, ibmVPCCluster, infrav1.IBMVPCClusterReadyV1Beta2Condition,
@@ -602,6 +712,8 @@ func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, i
 		v1beta2conditions.IgnoreTypesIfMissing{
 			infrav1.VPCSecurityGroupReadyV1Beta2Condition,
 			infrav1.VPCImageReadyV1Beta2Condition,
+			infrav1.VPCFileShareReadyV1Beta2Condition,
+			infrav1.CredentialsReadyV1Beta2Condition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge.
 		v1beta2conditions.CustomMergeStrategy{
@@ -627,5 +739,7 @@ func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, i
 		infrav1.VPCSecurityGroupReadyV1Beta2Condition,
 		infrav1.VPCLoadBalancerReadyV1Beta2Condition,
 		infrav1.VPCImageReadyV1Beta2Condition,
+		infrav1.VPCFileShareReadyV1Beta2Condition,
+		infrav1.CredentialsReadyV1Beta2Condition,
 	}})
 }
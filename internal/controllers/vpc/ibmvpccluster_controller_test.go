@@ -34,10 +34,12 @@ import (
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
 	. "github.com/onsi/gomega"
@@ -505,11 +507,30 @@ func TestIBMVPCClusterReconciler_delete(t *testing.T) {
 		deleteSubnetOptions := &vpcv1.DeleteSubnetOptions{ID: ptr.To("capi-subnet-id")}
 		deletePGWOptions := &vpcv1.DeletePublicGatewayOptions{ID: pgw.ID}
 		instancelist.TotalCount = ptr.To(int64(0))
+		t.Run("Should skip deleting the subnet while deleting its retained virtual network interfaces", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockgt := gtmock.NewMockGlobalTagging(mockCtrl)
+			clusterScope.GlobalTaggingClient = mockgt
+			clusterScope.IBMVPCCluster.Name = "capi-cluster"
+			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(instancelist, response, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("capi-subnet-id").Return([]vpcv1.VirtualNetworkInterface{
+				{ID: ptr.To("vni-id"), CRN: ptr.To("vni-crn"), AutoDelete: ptr.To(false)},
+			}, nil)
+			mockgt.EXPECT().GetAttachedTags("vni-crn").Return([]string{"capi-cluster"}, nil)
+			mockvpc.EXPECT().DeleteVirtualNetworkInterfaces(&vpcv1.DeleteVirtualNetworkInterfacesOptions{ID: ptr.To("vni-id")}).Return(&vpcv1.VirtualNetworkInterface{}, response, nil)
+			result, err := reconciler.reconcileDelete(ctx, clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1.ClusterFinalizer))
+		})
 		t.Run("Should fail deleting the subnet", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
 			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(instancelist, response, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("capi-subnet-id").Return(nil, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnet, response, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(getPGWOptions).Return(pgw, response, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(unsetPGWOptions).Return(response, nil)
@@ -525,6 +546,7 @@ func TestIBMVPCClusterReconciler_delete(t *testing.T) {
 			setup(t)
 			t.Cleanup(teardown)
 			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(instancelist, response, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("capi-subnet-id").Return(nil, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnet, response, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(getPGWOptions).Return(pgw, response, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(unsetPGWOptions).Return(response, nil)
@@ -540,6 +562,7 @@ func TestIBMVPCClusterReconciler_delete(t *testing.T) {
 			setup(t)
 			t.Cleanup(teardown)
 			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(instancelist, response, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("capi-subnet-id").Return(nil, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnet, response, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(getPGWOptions).Return(pgw, response, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(unsetPGWOptions).Return(response, nil)
@@ -640,6 +663,25 @@ func TestIBMVPCClusterLBReconciler_delete(t *testing.T) {
 	})
 }
 
+func TestReconcileCredentials(t *testing.T) {
+	t.Run("Should set CredentialsReady condition to true when the credentials authenticate", func(t *testing.T) {
+		g := NewWithT(t)
+		ibmVPCCluster := &infrav1.IBMVPCCluster{}
+		err := reconcileCredentials(ibmVPCCluster, &core.NoAuthAuthenticator{})
+		g.Expect(err).To(BeNil())
+		g.Expect(v1beta1conditions.IsTrue(ibmVPCCluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+	})
+	t.Run("Should set CredentialsReady condition to false when the credentials fail to authenticate", func(t *testing.T) {
+		g := NewWithT(t)
+		ibmVPCCluster := &infrav1.IBMVPCCluster{}
+		auth := &core.IamAuthenticator{ApiKey: "invalid-key", URL: "http://127.0.0.1:0"}
+		err := reconcileCredentials(ibmVPCCluster, auth)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(v1beta1conditions.IsFalse(ibmVPCCluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+		g.Expect(v1beta1conditions.GetReason(ibmVPCCluster, infrav1.CredentialsReadyCondition)).To(Equal(infrav1.AuthenticationFailedReason))
+	})
+}
+
 func createVPCCluster(g *WithT, vpcCluster *infrav1.IBMVPCCluster, namespace string) {
 	if vpcCluster != nil {
 		vpcCluster.Namespace = namespace
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -29,11 +30,11 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,6 +42,7 @@ import (
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"         //nolint:staticcheck
 	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
@@ -51,9 +53,13 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
 	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 )
 
+// instanceResyncPeriod is the period at which a running machine is reconciled again.
+const instanceResyncPeriod = 5 * time.Minute
+
 // IBMVPCMachineReconciler reconciles a IBMVPCMachine object.
 type IBMVPCMachineReconciler struct {
 	client.Client
@@ -61,18 +67,25 @@ type IBMVPCMachineReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	ClientBuilder vpc.ClientBuilder
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCMachine.
 func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
 
+	ctx, span := tracing.StartSpan(ctx, "IBMVPCMachine.Reconcile", tracing.NamespaceKey.String(req.Namespace), tracing.NameKey.String(req.Name))
+	defer func() { tracing.EndSpan(span, reterr) }()
+
 	log.Info("Reconciling IBMVPCMachine")
 	defer log.Info("Finished reconciling IBMVPCMachine")
 
@@ -119,6 +132,7 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	log = log.WithValues("Cluster", klog.KObj(cluster))
 	ctx = ctrl.LoggerInto(ctx, log)
+	span.SetAttributes(tracing.ClusterNameKey.String(cluster.Name))
 
 	// Initialize the patch helper.
 	patchHelper, err := v1beta1patch.NewHelper(ibmVPCMachine, r.Client)
@@ -138,13 +152,14 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 
 	// Create the machine scope.
-	machineScope, err := vpc.NewMachineScope(vpc.MachineScopeParams{
+	machineScope, err := vpc.NewMachineScope(ctx, vpc.MachineScopeParams{
 		Client:          r.Client,
 		Cluster:         cluster,
 		IBMVPCCluster:   ibmVPCCluster,
 		Machine:         machine,
 		IBMVPCMachine:   ibmVPCMachine,
 		ServiceEndpoint: r.ServiceEndpoint,
+		ClientBuilder:   r.ClientBuilder,
 	})
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
@@ -164,8 +179,13 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 // SetupWithManager creates a new IBMVPCMachine controller for a manager.
 func (r *IBMVPCMachineReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	if r.ClientBuilder == nil {
+		r.ClientBuilder = vpc.ProdClientBuilder{}
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.IBMVPCMachine{}).
+		// Reconcile the machine as soon as the address of its IPAddressClaim is allocated.
+		Owns(&ipamv1.IPAddressClaim{}).
 		Complete(r)
 }
 
@@ -183,7 +203,21 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 
 	if machineScope.IBMVPCCluster.Status.Subnet.ID != nil {
 		machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
-			Subnet: *machineScope.IBMVPCCluster.Status.Subnet.ID,
+			Subnet:     *machineScope.IBMVPCCluster.Status.Subnet.ID,
+			ReservedIP: machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.ReservedIP,
+		}
+	}
+
+	// Make sure the address of the primary network interface is allocated before creating the instance.
+	if machineScope.GetInstanceID() == "" {
+		allocated, err := machineScope.ReconcileIPAddressClaim(ctx)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile IPAddressClaim for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+		}
+		if !allocated {
+			// The machine is reconciled again once the IPAddressClaim it owns is updated.
+			log.Info("IP address is not yet allocated to the IPAddressClaim")
+			return ctrl.Result{}, nil
 		}
 	}
 
@@ -198,6 +232,9 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		if err := machineScope.TagResource(machineScope.IBMVPCCluster.Name, *instance.CRN); err != nil {
 			return ctrl.Result{}, fmt.Errorf("error failed to tag machine: %w", err)
 		}
+		if err := machineScope.ReconcileAdditionalTags(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error failed to reconcile machine's additional tags: %w", err)
+		}
 
 		// Set available status' for Machine.
 		machineScope.SetInstanceID(*instance.ID)
@@ -206,6 +243,18 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		}
 		machineScope.SetAddresses(instance)
 		machineScope.SetInstanceStatus(*instance.Status)
+		machineScope.SetSecurityOptions(instance)
+
+		// Report the health of the instance, and mark the machine as failed when its faulted instance must be remediated.
+		if r.reconcileInstanceHealth(machineScope, instance) {
+			machineScope.SetNotReady()
+			return ctrl.Result{}, nil
+		}
+
+		// Resize the instance in place when its profile was changed and the resize is allowed.
+		if result, err := r.reconcileInstanceProfile(ctx, machineScope, instance); err != nil || !result.IsZero() {
+			return result, err
+		}
 
 		// Depending on the state of the Machine, update status, conditions, etc.
 		switch machineScope.GetInstanceStatus() {
@@ -227,6 +276,20 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 			})
 		case vpcv1.InstanceStatusStoppedConst:
 			machineScope.SetNotReady()
+			// An instance stopped by IBM Cloud is not started again, mark the machine as failed so that it is remediated.
+			if reason, msg := instanceStoppedByProviderReason(instance); reason != "" {
+				machineScope.SetFailureReason(infrav1.UpdateMachineError)
+				machineScope.SetFailureMessage(msg)
+				v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceReadyCondition, reason, clusterv1beta1.ConditionSeverityError, "%s", msg)
+				v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+					Type:    infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition,
+					Status:  metav1.ConditionFalse,
+					Reason:  reason,
+					Message: msg,
+				})
+				capibmrecord.Warnf(machineScope.IBMVPCMachine, reason, "Instance was stopped by IBM Cloud - %s", msg)
+				return ctrl.Result{}, nil
+			}
 			v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedReason, clusterv1beta1.ConditionSeverityError, "")
 			v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
 				Type:   infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition,
@@ -287,6 +350,11 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
 
+	// Bind the requested floating IP to the running machine.
+	if err := machineScope.ReconcileFloatingIP(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error failed to reconcile machine's floating IP: %w", err)
+	}
+
 	// Rely on defined VPC Load Balancer Pool Members first before falling back to hardcoded defaults.
 	if len(machineScope.IBMVPCMachine.Spec.LoadBalancerPoolMembers) > 0 {
 		needsRequeue := false
@@ -332,6 +400,57 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		return ctrl.Result{}, fmt.Errorf("error reconciling additional volumes: %w", err)
 	}
 
+	// Expand the boot and additional volumes, or update their iops and profile, when they drifted from the spec. An
+	// update refused for the current spec is not retried until the spec changes.
+	if !machineScope.VolumeUpdateRefused() {
+		updating, err := machineScope.ReconcileVolumeUpdates(instance)
+		switch {
+		case errors.Is(err, vpc.ErrVolumeUpdateRefused):
+			v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.VolumesReadyCondition, infrav1.VolumesUpdateRefusedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+				Type:    infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.IBMVPCMachineVolumesUpdateRefusedV1Beta2Reason,
+				Message: err.Error(),
+			})
+		case err != nil:
+			v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.VolumesReadyCondition, infrav1.VolumesUpdateFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+				Type:    infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.IBMVPCMachineVolumesUpdateFailedV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return ctrl.Result{}, fmt.Errorf("error reconciling volume updates: %w", err)
+		case updating:
+			v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.VolumesReadyCondition, infrav1.VolumesUpdatingReason, clusterv1beta1.ConditionSeverityInfo, "")
+			v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+				Type:   infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.IBMVPCMachineVolumesUpdatingV1Beta2Reason,
+			})
+			// Requeue to follow the update of the volumes.
+			if result.RequeueAfter == 0 {
+				result = ctrl.Result{RequeueAfter: 10 * time.Second}
+			}
+		default:
+			v1beta1conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1.VolumesReadyCondition)
+			v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+				Type:   infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition,
+				Status: metav1.ConditionTrue,
+				Reason: infrav1.IBMVPCMachineVolumesReadyV1Beta2Reason,
+			})
+		}
+	}
+
+	// Create the mount targets of the file shares mounted on the machine, and delete those of the removed ones.
+	if pending, err := machineScope.ReconcileFileShareMounts(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error reconciling file share mounts: %w", err)
+	} else if pending && result.RequeueAfter == 0 {
+		// Requeue to report the mount paths once the mount targets are stable.
+		result = ctrl.Result{RequeueAfter: 10 * time.Second}
+	}
+
 	// With a running machine and all Load Balancer Pool Members reconciled, mark machine as ready.
 	machineScope.SetReady()
 	v1beta1conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1.InstanceReadyCondition)
@@ -340,10 +459,162 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		Status: metav1.ConditionTrue,
 		Reason: infrav1.IBMVPCMachineInstanceReadyV1Beta2Reason,
 	})
+	// Requeue the running machine periodically to follow the status and the health of its instance, which are only
+	// reported by IBM Cloud.
+	if result.IsZero() {
+		result = ctrl.Result{RequeueAfter: instanceResyncPeriod}
+	}
 	log.Info("Reconcile complete", "result", result)
 	return result, nil
 }
 
+// reconcileInstanceProfile resizes the instance when its profile drifted from the spec and reports the resize through
+// the ProfileReady condition. It returns a non-zero result while the instance is being resized.
+func (r *IBMVPCMachineReconciler) reconcileInstanceProfile(ctx context.Context, machineScope *vpc.MachineScope, instance *vpcv1.Instance) (ctrl.Result, error) {
+	resizing, err := machineScope.ReconcileInstanceProfile(ctx, instance)
+	if err != nil {
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.ProfileReadyCondition, infrav1.ProfileResizeFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineProfileReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMVPCMachineProfileResizeFailedV1Beta2Reason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, fmt.Errorf("error resizing instance: %w", err)
+	}
+
+	switch {
+	case resizing:
+		machineScope.SetNotReady()
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.ProfileReadyCondition, infrav1.ProfileResizingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:   infrav1.IBMVPCMachineProfileReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.IBMVPCMachineProfileResizingV1Beta2Reason,
+		})
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotReadyReason, clusterv1beta1.ConditionSeverityInfo, "instance is being resized")
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMVPCMachineInstanceNotReadyV1Beta2Reason,
+			Message: "instance is being resized",
+		})
+		// Requeue to follow the stop, the resize and the start of the instance.
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	case machineScope.InstanceProfileDrifted(instance):
+		msg := fmt.Sprintf("instance profile %s differs from %s, set the %s annotation to resize it", ptr.Deref(instance.Profile.Name, ""), machineScope.IBMVPCMachine.Spec.Profile, infrav1.InPlaceResizeAnnotation)
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.ProfileReadyCondition, infrav1.ProfileResizeNotAllowedReason, clusterv1beta1.ConditionSeverityWarning, "%s", msg)
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineProfileReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMVPCMachineProfileResizeNotAllowedV1Beta2Reason,
+			Message: msg,
+		})
+	default:
+		v1beta1conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1.ProfileReadyCondition)
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:   infrav1.IBMVPCMachineProfileReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.IBMVPCMachineProfileReadyV1Beta2Reason,
+		})
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileInstanceHealth reports the health state of the instance through the InstanceHealthy condition, and emits a
+// warning event when the instance becomes degraded or faulted. It returns true when the machine was marked as failed,
+// as the instance is faulted and the unhealthy instance policy of the machine is Fail.
+func (r *IBMVPCMachineReconciler) reconcileInstanceHealth(machineScope *vpc.MachineScope, instance *vpcv1.Instance) bool {
+	healthState := ptr.Deref(instance.HealthState, "")
+	healthStateChanged := healthState != machineScope.GetInstanceHealthState()
+	machineScope.SetInstanceHealthState(healthState)
+	msg := instanceHealthMessage(instance)
+
+	switch healthState {
+	case vpcv1.InstanceHealthStateOkConst:
+		v1beta1conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1.InstanceHealthyCondition)
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:   infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.IBMVPCMachineInstanceHealthyV1Beta2Reason,
+		})
+	case vpcv1.InstanceHealthStateInapplicableConst:
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceHealthInapplicableReason, clusterv1beta1.ConditionSeverityInfo, "%s", msg)
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMVPCMachineInstanceHealthInapplicableV1Beta2Reason,
+			Message: msg,
+		})
+	case vpcv1.InstanceHealthStateDegradedConst:
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceDegradedReason, clusterv1beta1.ConditionSeverityWarning, "%s", msg)
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMVPCMachineInstanceDegradedV1Beta2Reason,
+			Message: msg,
+		})
+		if healthStateChanged {
+			capibmrecord.Warnf(machineScope.IBMVPCMachine, infrav1.InstanceDegradedReason, "Instance health is degraded - %s", msg)
+		}
+	case vpcv1.InstanceHealthStateFaultedConst:
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceFaultedReason, clusterv1beta1.ConditionSeverityError, "%s", msg)
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMVPCMachineInstanceFaultedV1Beta2Reason,
+			Message: msg,
+		})
+		if healthStateChanged {
+			capibmrecord.Warnf(machineScope.IBMVPCMachine, infrav1.InstanceFaultedReason, "Instance health is faulted - %s", msg)
+		}
+		if machineScope.FailUnhealthyInstance() {
+			machineScope.SetFailureReason(infrav1.UpdateMachineError)
+			machineScope.SetFailureMessage(fmt.Sprintf("instance health is faulted: %s", msg))
+			return true
+		}
+	default:
+		v1beta1conditions.MarkUnknown(machineScope.IBMVPCMachine, infrav1.InstanceHealthyCondition, infrav1.InstanceHealthUnknownReason, "")
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:   infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition,
+			Status: metav1.ConditionUnknown,
+			Reason: infrav1.IBMVPCMachineInstanceHealthUnknownV1Beta2Reason,
+		})
+	}
+	return false
+}
+
+// instanceHealthMessage returns the health reasons of the instance, followed by its status reasons, e.g. why it
+// cannot start, as a message of the InstanceHealthy condition.
+func instanceHealthMessage(instance *vpcv1.Instance) string {
+	var reasons []string
+	for _, healthReason := range instance.HealthReasons {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", ptr.Deref(healthReason.Code, ""), ptr.Deref(healthReason.Message, "")))
+	}
+	for _, statusReason := range instance.StatusReasons {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", ptr.Deref(statusReason.Code, ""), ptr.Deref(statusReason.Message, "")))
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// instanceStoppedByProviderReason returns the condition reason and the message when the instance was stopped by
+// IBM Cloud, on preemption or on a failure of its compute host, or an empty reason otherwise.
+func instanceStoppedByProviderReason(instance *vpcv1.Instance) (string, string) {
+	for _, statusReason := range instance.StatusReasons {
+		var reason string
+		switch ptr.Deref(statusReason.Code, "") {
+		case vpcv1.InstanceStatusReasonCodeStoppedByPreemptionConst:
+			reason = infrav1.InstancePreemptedReason
+		case vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst:
+			reason = infrav1.InstanceHostFailedReason
+		default:
+			continue
+		}
+		return reason, fmt.Sprintf("%s: %s", *statusReason.Code, ptr.Deref(statusReason.Message, ""))
+	}
+	return "", ""
+}
+
 func (r *IBMVPCMachineReconciler) getOrCreate(ctx context.Context, scope *vpc.MachineScope) (*vpcv1.Instance, error) {
 	instance, err := scope.CreateMachine(ctx)
 	return instance, err
@@ -359,11 +630,30 @@ func (r *IBMVPCMachineReconciler) reconcileDelete(ctx context.Context, scope *vp
 		}
 	}
 
+	if err := scope.DeleteFloatingIP(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete floating IP: %w", err)
+	}
+
+	if err := scope.DeleteFileShareMounts(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete file share mount targets: %w", err)
+	}
+
+	// Delete the volumes removed from the spec, which are no longer deleted with the instance.
+	if pending, err := scope.DeleteRemovedVolumes(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete removed volumes: %w", err)
+	} else if pending {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
 	if err := scope.DeleteMachine(); err != nil {
 		log.Info("Error deleting IBMVPCMachine")
 		return ctrl.Result{}, fmt.Errorf("error deleting IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Spec.Name, err)
 	}
 
+	if err := scope.DeleteMachineIgnition(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMVPCMachine ignition data %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Spec.Name, err)
+	}
+
 	defer func() {
 		if reterr == nil {
 			// VSI is deleted so remove the finalizer.
@@ -423,32 +713,43 @@ func patchIBMVPCMachine(ctx context.Context, patchHelper *v1beta1patch.Helper, i
 	return patchHelper.Patch(ctx, ibmVPCMachine, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
 		infrav1.IBMVPCMachineReadyV1Beta2Condition,
 		infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition,
+		infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition,
+		infrav1.IBMVPCMachineProfileReadyV1Beta2Condition,
+		infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition,
 		clusterv1beta1.PausedV1Beta2Condition,
 	}})
 }
 func (r *IBMVPCMachineReconciler) reconcileAdditionalVolumes(ctx context.Context, machineScope *vpc.MachineScope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
-	// Return immediately if no additional volumes exist
-	if len(machineScope.IBMVPCMachine.Spec.AdditionalVolumes) == 0 {
+	// Return immediately if no additional volumes exist, nor remain to be removed
+	if len(machineScope.IBMVPCMachine.Spec.AdditionalVolumes) == 0 && (machineScope.IBMVPCMachine.Status.V1Beta2 == nil ||
+		len(machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs) == 0 && len(machineScope.IBMVPCMachine.Status.V1Beta2.RemovedVolumeIDs) == 0) {
 		return ctrl.Result{}, nil
 	}
-	err := r.Get(ctx, types.NamespacedName{
-		Namespace: machineScope.IBMVPCMachine.Namespace,
-		Name:      machineScope.IBMVPCMachine.Name,
-	}, machineScope.IBMVPCMachine)
-	if err != nil {
-		log.Error(err, "Could not fetch machine status")
-		return ctrl.Result{}, err
+	if machineScope.IBMVPCMachine.Status.V1Beta2 == nil {
+		machineScope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{}
 	}
 	machineVolumes := machineScope.IBMVPCMachine.Spec.AdditionalVolumes
 	result := ctrl.Result{}
-	if machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs == nil {
-		machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs = make([]string, len(machineScope.IBMVPCMachine.Spec.AdditionalVolumes))
+	if missing := len(machineVolumes) - len(machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs); missing > 0 {
+		machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs = append(machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs, make([]string, missing)...)
 	}
 	volumeAttachmentList, err := machineScope.GetVolumeAttachments()
 	if err != nil {
 		return result, err
 	}
+	// Detach the volumes removed from the spec, and delete them once detached if requested
+	detached, err := machineScope.DetachRemovedVolumes(volumeAttachmentList)
+	if err != nil {
+		return result, err
+	}
+	pending, err := machineScope.DeleteRemovedVolumes()
+	if err != nil {
+		return result, err
+	}
+	if detached || pending {
+		result = ctrl.Result{RequeueAfter: 10 * time.Second}
+	}
 	volumeAttachmentNames := sets.New[string]()
 	for i := range volumeAttachmentList {
 		sets.Insert(volumeAttachmentNames, *volumeAttachmentList[i].Name)
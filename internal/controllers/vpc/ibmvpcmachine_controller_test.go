@@ -30,6 +30,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"go.uber.org/mock/gomock"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -42,7 +43,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	fakescope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/fake"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	fakeservices "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/fake"
 	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	vpcmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
@@ -455,8 +458,10 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(loadBalancerPoolMember, &core.DetailedResponse{}, nil)
 
-			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To(BeNil())
+			// The running machine is reconciled again to follow the health of its instance.
+			g.Expect(result.RequeueAfter).To(Equal(instanceResyncPeriod))
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
 			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(true))
 		})
@@ -552,6 +557,39 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 				g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(false))
 			})
 
+			t.Run("When VPC spot instance is preempted", func(_ *testing.T) {
+				customInstancelist := &vpcv1.InstanceCollection{
+					Instances: []vpcv1.Instance{
+						{
+							Name: ptr.To("capi-machine"),
+							ID:   ptr.To("capi-machine-id"),
+							CRN:  ptr.To("capi-machine-crn"),
+							PrimaryNetworkInterface: &vpcv1.NetworkInterfaceInstanceContextReference{
+								PrimaryIP: &vpcv1.ReservedIPReference{
+									Address: ptr.To("10.0.0.0"),
+								},
+								ID: ptr.To("capi-net"),
+							},
+							Status: ptr.To(vpcv1.InstanceStatusStoppedConst),
+							StatusReasons: []vpcv1.InstanceStatusReason{
+								{
+									Code:    ptr.To(vpcv1.InstanceStatusReasonCodeStoppedByPreemptionConst),
+									Message: ptr.To("The instance was preempted"),
+								},
+							},
+						},
+					},
+				}
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(customInstancelist, &core.DetailedResponse{}, nil)
+
+				result, err := reconciler.reconcileNormal(ctx, machineScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(BeZero())
+				g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(false))
+				g.Expect(machineScope.IBMVPCMachine.Status.FailureReason).To(Equal(ptr.To(infrav1.UpdateMachineError)))
+				g.Expect(v1beta2conditions.Get(machineScope.IBMVPCMachine, infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition).Reason).To(Equal(infrav1.InstancePreemptedReason))
+			})
+
 			t.Run("When VPC instance is failed", func(_ *testing.T) {
 				customInstancelist := &vpcv1.InstanceCollection{
 					Instances: []vpcv1.Instance{
@@ -841,6 +879,155 @@ func TestIBMVPCMachine_Reconcile_Conditions(t *testing.T) {
 		})
 	}
 }
+
+func TestIBMVPCMachineReconciler_Lifecycle(t *testing.T) {
+	g := NewWithT(t)
+	options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+	reconciler := &IBMVPCMachineReconciler{
+		Client:        testEnv.Client,
+		Log:           klog.Background(),
+		ClientBuilder: fakescope.VPCClientBuilder{Backend: testEnv.Backend},
+	}
+	ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("namespace-%s", util.RandomString(5)))
+	g.Expect(err).To(BeNil())
+	defer func() {
+		g.Expect(testEnv.Cleanup(ctx, ns)).To(Succeed())
+	}()
+
+	// Create the network of the cluster and the image of the machine in the fake IBM Cloud backend.
+	vpcClient := testEnv.Backend.VPCClient()
+	vpcNetwork, _, err := vpcClient.CreateVPC(&vpcv1.CreateVPCOptions{
+		Name: ptr.To(fmt.Sprintf("%s-vpc", ns.Name)),
+	})
+	g.Expect(err).To(BeNil())
+	subnet, _, err := vpcClient.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototypeSubnetByTotalCount{
+			Name:                  ptr.To(fmt.Sprintf("%s-subnet", ns.Name)),
+			VPC:                   &vpcv1.VPCIdentityByID{ID: vpcNetwork.ID},
+			Zone:                  &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+			TotalIpv4AddressCount: ptr.To(int64(256)),
+		},
+	})
+	g.Expect(err).To(BeNil())
+	imageID := testEnv.Backend.AddVPCImage(fmt.Sprintf("%s-image", ns.Name))
+
+	ownerCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "capi-cluster"},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: infrav1.GroupVersion.Group,
+				Kind:     "IBMVPCCluster",
+				Name:     "capi-cluster"}}}
+	createObject(g, ownerCluster, ns.Name)
+	defer cleanupObject(g, ownerCluster)
+
+	vpcCluster := &infrav1.IBMVPCCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "capi-cluster"},
+		Spec: infrav1.IBMVPCClusterSpec{
+			Region: "us-south",
+			ControlPlaneEndpoint: v1beta1.APIEndpoint{
+				Host: "cluster-host",
+			},
+		},
+	}
+	createObject(g, vpcCluster, ns.Name)
+	defer cleanupObject(g, vpcCluster)
+	vpcCluster.Status = infrav1.IBMVPCClusterStatus{
+		Subnet: infrav1.Subnet{
+			ID:   subnet.ID,
+			Zone: ptr.To("us-south-1"),
+		},
+	}
+	g.Expect(testEnv.Status().Update(ctx, vpcCluster)).To(Succeed())
+
+	bootstrapSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "capi-test-machine-bootstrap"},
+		Data: map[string][]byte{
+			"value": []byte("user data"),
+		},
+	}
+	createObject(g, bootstrapSecret, ns.Name)
+	defer cleanupObject(g, bootstrapSecret)
+
+	ownerMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "capi-test-machine"},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: "capi-cluster",
+			Bootstrap: clusterv1.Bootstrap{
+				DataSecretName: ptr.To(bootstrapSecret.Name),
+			},
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: infrav1.GroupVersion.Group,
+				Kind:     "IBMVPCMachine",
+				Name:     "vpc-machine"}}}
+	createObject(g, ownerMachine, ns.Name)
+	defer cleanupObject(g, ownerMachine)
+
+	vpcMachine := &infrav1.IBMVPCMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "vpc-machine", Labels: map[string]string{
+				clusterv1.ClusterNameLabel: "capi-cluster"},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Machine",
+					Name:       "capi-test-machine",
+					UID:        "1",
+				},
+			},
+		},
+		Spec: infrav1.IBMVPCMachineSpec{
+			Zone:    "us-south-1",
+			Profile: "bx2-2x8",
+			Image: &infrav1.IBMVPCResourceReference{
+				ID: ptr.To(imageID),
+			},
+		},
+	}
+	createObject(g, vpcMachine, ns.Name)
+	defer cleanupObject(g, vpcMachine)
+	waitTillMachineAvailable(ctx, g, vpcMachine.Name, ns.Name)
+
+	key := client.ObjectKey{
+		Name:      vpcMachine.Name,
+		Namespace: ns.Name,
+	}
+	machine := &infrav1.IBMVPCMachine{}
+
+	// Reconcile the machine until its instance is created and running in the backend.
+	g.Eventually(func() bool {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		g.Expect(err).To(BeNil())
+		g.Expect(testEnv.Get(ctx, key, machine)).To(Succeed())
+		return machine.Status.Ready
+	}, 30*time.Second, time.Second).Should(BeTrue())
+
+	instance, _, err := vpcClient.GetInstance(&vpcv1.GetInstanceOptions{ID: ptr.To(machine.Status.InstanceID)})
+	g.Expect(err).To(BeNil())
+	g.Expect(*instance.Name).To(Equal(vpcMachine.Name))
+	g.Expect(*instance.Status).To(Equal(vpcv1.InstanceStatusRunningConst))
+	g.Expect(*instance.PrimaryNetworkInterface.Subnet.ID).To(Equal(*subnet.ID))
+	g.Expect(machine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+	g.Expect(machine.Spec.ProviderID).ToNot(BeNil())
+	g.Expect(*machine.Spec.ProviderID).To(HaveSuffix(fmt.Sprintf("/capi-cluster/%s", *instance.ID)))
+	g.Expect(v1beta2conditions.IsTrue(machine, infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition)).To(BeTrue())
+
+	// Delete the machine, its instance is deleted and its finalizer removed.
+	g.Expect(testEnv.Delete(ctx, machine)).To(Succeed())
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	g.Expect(err).To(BeNil())
+	checkMachineDeleted(ctx, g, vpcMachine.Name, ns.Name)
+
+	g.Eventually(func() bool {
+		_, _, err := vpcClient.GetInstance(&vpcv1.GetInstanceOptions{ID: instance.ID})
+		var notFound *fakeservices.NotFoundError
+		return errors.As(err, &notFound)
+	}, 10*time.Second, time.Second).Should(BeTrue())
+}
 func TestReconcileAdditionalVolumes(t *testing.T) {
 	volumeName := "foo-volume"
 	volumeID := "foo-volume-id"
@@ -925,13 +1112,43 @@ func TestReconcileAdditionalVolumes(t *testing.T) {
 		defer checkMachineDeleted(ctx, g, machineScope.IBMVPCMachine.Name, machineScope.IBMVPCMachine.Namespace)
 		defer cleanupObject(g, machineScope.IBMVPCMachine)
 		waitTillMachineAvailable(ctx, g, machineScope.IBMVPCMachine.Name, machineScope.IBMVPCMachine.Namespace)
-		setMachineStatus(ctx, g, machineScope.IBMVPCMachine, testMachineStatus)
+		setMachineStatus(ctx, g, machineScope.IBMVPCMachine, infrav1.IBMVPCMachineStatus{V1Beta2: &infrav1.IBMVPCMachineV1Beta2Status{}})
 		waitTillMachineStatusUpdated(ctx, g, machineScope.IBMVPCMachine.Name, machineScope.IBMVPCMachine.Namespace)
 		result, err := reconciler.reconcileAdditionalVolumes(ctx, machineScope)
 		g.Expect(err).Should(BeNil())
 		g.Expect(result).To(Equal(ctrl.Result{}))
 	})
 
+	t.Run("Should detach the volume removed from the spec and delete it once detached", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, machineScope, reconciler := setup(t)
+		t.Cleanup(mockController.Finish)
+		createObject(g, machineScope.IBMVPCMachine, machineScope.IBMVPCMachine.Namespace)
+		defer checkMachineDeleted(ctx, g, machineScope.IBMVPCMachine.Name, machineScope.IBMVPCMachine.Namespace)
+		defer cleanupObject(g, machineScope.IBMVPCMachine)
+		waitTillMachineAvailable(ctx, g, machineScope.IBMVPCMachine.Name, machineScope.IBMVPCMachine.Namespace)
+		setMachineStatus(ctx, g, machineScope.IBMVPCMachine, testMachineStatus)
+		waitTillMachineStatusUpdated(ctx, g, machineScope.IBMVPCMachine.Name, machineScope.IBMVPCMachine.Namespace)
+		mockvpc.EXPECT().GetVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{
+			VolumeAttachments: []vpcv1.VolumeAttachment{{
+				ID:                           ptr.To("foo-attachment-id"),
+				Name:                         &volumeName,
+				DeleteVolumeOnInstanceDelete: ptr.To(true),
+				Volume:                       &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: &volumeID},
+			}},
+		}, nil, nil)
+		mockvpc.EXPECT().DetachVolumeFromInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceVolumeAttachmentOptions{})).Return(nil, nil)
+		mockvpc.EXPECT().GetVolume(gomock.AssignableToTypeOf(&vpcv1.GetVolumeOptions{})).Return(&vpcv1.Volume{
+			ID:              &volumeID,
+			AttachmentState: ptr.To(vpcv1.VolumeAttachmentStateAttachedConst),
+		}, nil, nil)
+		result, err := reconciler.reconcileAdditionalVolumes(ctx, machineScope)
+		g.Expect(err).Should(BeNil())
+		g.Expect(result.RequeueAfter).ToNot(BeZero())
+		g.Expect(machineScope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs).To(BeEmpty())
+		g.Expect(machineScope.IBMVPCMachine.Status.V1Beta2.RemovedVolumeIDs).To(Equal([]string{volumeID}))
+	})
+
 	t.Run("Should successfully attach volume when volume id is defined and volume is in available state", func(t *testing.T) {
 		g := NewWithT(t)
 		mockController, mockvpc, machineScope, reconciler := setup(t)
@@ -1108,3 +1325,105 @@ func checkMachineDeleted(ctx context.Context, g *WithT, machineName, machineName
 		return apierrors.IsNotFound(err)
 	}, 10*time.Second).Should(Equal(true))
 }
+
+func TestReconcileInstanceHealth(t *testing.T) {
+	setup := func(unhealthyInstancePolicy infrav1.VPCUnhealthyInstancePolicy) (*vpc.MachineScope, IBMVPCMachineReconciler) {
+		machineScope := &vpc.MachineScope{
+			IBMVPCMachine: &infrav1.IBMVPCMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-machine",
+					Namespace: "default",
+				},
+				Spec: infrav1.IBMVPCMachineSpec{
+					UnhealthyInstancePolicy: unhealthyInstancePolicy,
+				},
+			},
+		}
+		return machineScope, IBMVPCMachineReconciler{Log: klog.Background()}
+	}
+
+	faultedInstance := &vpcv1.Instance{
+		HealthState: ptr.To(vpcv1.InstanceHealthStateFaultedConst),
+		HealthReasons: []vpcv1.InstanceHealthReason{
+			{
+				Code:    ptr.To(vpcv1.InstanceHealthReasonCodeReservationFailedConst),
+				Message: ptr.To("The reservation failed"),
+			},
+		},
+	}
+
+	t.Run("Should report a healthy instance", func(t *testing.T) {
+		g := NewWithT(t)
+		machineScope, reconciler := setup("")
+
+		failed := reconciler.reconcileInstanceHealth(machineScope, &vpcv1.Instance{HealthState: ptr.To(vpcv1.InstanceHealthStateOkConst)})
+		g.Expect(failed).To(BeFalse())
+		g.Expect(machineScope.IBMVPCMachine.Status.InstanceHealthState).To(Equal(vpcv1.InstanceHealthStateOkConst))
+		g.Expect(v1beta2conditions.IsTrue(machineScope.IBMVPCMachine, infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition)).To(BeTrue())
+	})
+
+	t.Run("Should report a degraded instance with its health and status reasons", func(t *testing.T) {
+		g := NewWithT(t)
+		machineScope, reconciler := setup(infrav1.VPCUnhealthyInstancePolicyFail)
+
+		failed := reconciler.reconcileInstanceHealth(machineScope, &vpcv1.Instance{
+			HealthState: ptr.To(vpcv1.InstanceHealthStateDegradedConst),
+			HealthReasons: []vpcv1.InstanceHealthReason{
+				{
+					Code:    ptr.To(vpcv1.InstanceHealthReasonCodeReservationCapacityUnavailableConst),
+					Message: ptr.To("The reservation has no available capacity"),
+				},
+			},
+			StatusReasons: []vpcv1.InstanceStatusReason{
+				{
+					Code:    ptr.To(vpcv1.InstanceStatusReasonCodeCannotStartCapacityConst),
+					Message: ptr.To("Insufficient capacity"),
+				},
+			},
+		})
+		g.Expect(failed).To(BeFalse())
+		g.Expect(machineScope.IBMVPCMachine.Status.FailureReason).To(BeNil())
+		condition := v1beta2conditions.Get(machineScope.IBMVPCMachine, infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition)
+		g.Expect(condition.Reason).To(Equal(infrav1.IBMVPCMachineInstanceDegradedV1Beta2Reason))
+		g.Expect(condition.Message).To(Equal("reservation_capacity_unavailable: The reservation has no available capacity; cannot_start_capacity: Insufficient capacity"))
+	})
+
+	t.Run("Should only report a faulted instance by default", func(t *testing.T) {
+		g := NewWithT(t)
+		machineScope, reconciler := setup("")
+
+		failed := reconciler.reconcileInstanceHealth(machineScope, faultedInstance)
+		g.Expect(failed).To(BeFalse())
+		g.Expect(machineScope.IBMVPCMachine.Status.FailureReason).To(BeNil())
+		g.Expect(v1beta2conditions.Get(machineScope.IBMVPCMachine, infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition).Reason).To(Equal(infrav1.IBMVPCMachineInstanceFaultedV1Beta2Reason))
+	})
+
+	t.Run("Should only report a faulted instance with the Report policy", func(t *testing.T) {
+		g := NewWithT(t)
+		machineScope, reconciler := setup(infrav1.VPCUnhealthyInstancePolicyReport)
+
+		failed := reconciler.reconcileInstanceHealth(machineScope, faultedInstance)
+		g.Expect(failed).To(BeFalse())
+		g.Expect(machineScope.IBMVPCMachine.Status.FailureReason).To(BeNil())
+		g.Expect(v1beta2conditions.Get(machineScope.IBMVPCMachine, infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition).Reason).To(Equal(infrav1.IBMVPCMachineInstanceFaultedV1Beta2Reason))
+	})
+
+	t.Run("Should mark the machine as failed when the instance is faulted with the Fail policy", func(t *testing.T) {
+		g := NewWithT(t)
+		machineScope, reconciler := setup(infrav1.VPCUnhealthyInstancePolicyFail)
+
+		failed := reconciler.reconcileInstanceHealth(machineScope, faultedInstance)
+		g.Expect(failed).To(BeTrue())
+		g.Expect(machineScope.IBMVPCMachine.Status.FailureReason).To(Equal(ptr.To(infrav1.UpdateMachineError)))
+		g.Expect(machineScope.IBMVPCMachine.Status.FailureMessage).To(Equal(ptr.To("instance health is faulted: reservation_failed: The reservation failed")))
+	})
+
+	t.Run("Should report an unknown health state when it is not reported", func(t *testing.T) {
+		g := NewWithT(t)
+		machineScope, reconciler := setup("")
+
+		failed := reconciler.reconcileInstanceHealth(machineScope, &vpcv1.Instance{})
+		g.Expect(failed).To(BeFalse())
+		g.Expect(v1beta2conditions.Get(machineScope.IBMVPCMachine, infrav1.IBMVPCMachineInstanceHealthyV1Beta2Condition).Reason).To(Equal(infrav1.IBMVPCMachineInstanceHealthUnknownV1Beta2Reason))
+	})
+}
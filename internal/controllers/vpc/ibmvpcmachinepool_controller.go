@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"         //nolint:staticcheck
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"                   //nolint:staticcheck
+	"sigs.k8s.io/cluster-api/util/deprecated/v1beta1/paused"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
+)
+
+// instanceGroupResyncPeriod is the period at which a ready instance group is reconciled again.
+const instanceGroupResyncPeriod = 5 * time.Minute
+
+// IBMVPCMachinePoolReconciler reconciles a IBMVPCMachinePool object.
+type IBMVPCMachinePoolReconciler struct {
+	client.Client
+	Log             logr.Logger
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+
+	ClientBuilder vpc.ClientBuilder
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+// Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCMachinePool.
+func (r *IBMVPCMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Reconciling IBMVPCMachinePool")
+	defer log.Info("Finished reconciling IBMVPCMachinePool")
+
+	// Fetch the IBMVPCMachinePool instance.
+	ibmVPCMachinePool := &infrav1.IBMVPCMachinePool{}
+	err := r.Get(ctx, req.NamespacedName, ibmVPCMachinePool)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Fetch the MachinePool.
+	machinePool, err := util.GetOwnerMachinePool(ctx, r.Client, ibmVPCMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machinePool == nil {
+		log.Info("MachinePool Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("MachinePool is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	ibmVPCCluster := &infrav1.IBMVPCCluster{}
+	ibmVPCClusterName := client.ObjectKey{
+		Namespace: ibmVPCMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, ibmVPCClusterName, ibmVPCCluster); err != nil {
+		log.Info("IBMVPCCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, ibmVPCMachinePool, infrav1.MachinePoolFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	log = log.WithValues("Cluster", klog.KObj(cluster))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Initialize the patch helper.
+	patchHelper, err := v1beta1patch.NewHelper(ibmVPCMachinePool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to initialize patch helper: %w", err)
+	}
+
+	// Always attempt to Patch the IBMVPCMachinePool object and status after each reconciliation.
+	defer func() {
+		if err := patchIBMVPCMachinePool(ctx, patchHelper, ibmVPCMachinePool); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmVPCMachinePool); err != nil || isPaused || requeue {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine pool scope.
+	machinePoolScope, err := vpc.NewMachinePoolScope(ctx, vpc.MachinePoolScopeParams{
+		Client:            r.Client,
+		Cluster:           cluster,
+		IBMVPCCluster:     ibmVPCCluster,
+		MachinePool:       machinePool,
+		IBMVPCMachinePool: ibmVPCMachinePool,
+		ServiceEndpoint:   r.ServiceEndpoint,
+		ClientBuilder:     r.ClientBuilder,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	log = log.WithValues("IBMVPCMachinePool", klog.KObj(ibmVPCMachinePool))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Handle deleted machine pools.
+	if !ibmVPCMachinePool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, machinePoolScope)
+	}
+
+	// Handle non-deleted machine pools.
+	return r.reconcileNormal(ctx, machinePoolScope)
+}
+
+// SetupWithManager creates a new IBMVPCMachinePool controller for a manager.
+func (r *IBMVPCMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if r.ClientBuilder == nil {
+		r.ClientBuilder = vpc.ProdClientBuilder{}
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.IBMVPCMachinePool{}).
+		// Reconcile the instance group when the MachinePool, e.g. its replicas, changes.
+		Watches(
+			&clusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(util.MachinePoolToInfrastructureMapFunc(ctx, infrav1.GroupVersion.WithKind("IBMVPCMachinePool"))),
+		).
+		Complete(r)
+}
+
+func (r *IBMVPCMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *vpc.MachinePoolScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !ptr.Deref(machinePoolScope.Cluster.Status.Initialization.InfrastructureProvisioned, false) {
+		log.Info("Cluster infrastructure is not ready yet")
+		v1beta1conditions.MarkFalse(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1beta1.ConditionSeverityInfo, "")
+		v1beta2conditions.Set(machinePoolScope.IBMVPCMachinePool, metav1.Condition{
+			Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.WaitingForClusterInfrastructureReason,
+		})
+		return ctrl.Result{}, nil
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		log.Info("Bootstrap data secret reference is not yet available")
+		v1beta1conditions.MarkFalse(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1beta1.ConditionSeverityInfo, "")
+		v1beta2conditions.Set(machinePoolScope.IBMVPCMachinePool, metav1.Condition{
+			Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.WaitingForBootstrapDataReason,
+		})
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	if err := machinePoolScope.ReconcileInstanceTemplate(ctx); err != nil {
+		markInstanceGroupProvisionFailed(machinePoolScope.IBMVPCMachinePool, err)
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instance template for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+
+	instanceGroup, err := machinePoolScope.ReconcileInstanceGroup(ctx)
+	if err != nil {
+		markInstanceGroupProvisionFailed(machinePoolScope.IBMVPCMachinePool, err)
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instance group for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+
+	// Tag the instance group and its instances before the new members are recorded in the providerIDList.
+	if err := machinePoolScope.ReconcileAdditionalTags(ctx, instanceGroup); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile additional tags for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+
+	upToDate, err := machinePoolScope.ReconcileMemberships(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instance group memberships for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+
+	if ptr.Deref(instanceGroup.Status, "") != vpcv1.InstanceGroupStatusHealthyConst || !upToDate {
+		log.Info("Instance group is not yet ready", "status", ptr.Deref(instanceGroup.Status, ""), "replicas", machinePoolScope.IBMVPCMachinePool.Status.Replicas)
+		machinePoolScope.IBMVPCMachinePool.Status.Ready = false
+		v1beta1conditions.MarkFalse(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition, infrav1.InstanceGroupNotReadyReason, clusterv1beta1.ConditionSeverityWarning, "")
+		v1beta2conditions.Set(machinePoolScope.IBMVPCMachinePool, metav1.Condition{
+			Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.IBMVPCMachinePoolInstanceGroupNotReadyV1Beta2Reason,
+		})
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	// Now that all the members use the current instance template, remove the previous ones.
+	if err := machinePoolScope.DeleteOutdatedInstanceTemplates(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete outdated instance templates for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+
+	machinePoolScope.IBMVPCMachinePool.Status.Ready = true
+	v1beta1conditions.MarkTrue(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition)
+	v1beta2conditions.Set(machinePoolScope.IBMVPCMachinePool, metav1.Condition{
+		Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Reason,
+	})
+	// Requeue to refresh the health of the members of the active instance group.
+	return ctrl.Result{RequeueAfter: instanceGroupResyncPeriod}, nil
+}
+
+func (r *IBMVPCMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *vpc.MachinePoolScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Handling deleted IBMVPCMachinePool")
+
+	v1beta1conditions.MarkFalse(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition, infrav1.InstanceGroupDeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+	v1beta2conditions.Set(machinePoolScope.IBMVPCMachinePool, metav1.Condition{
+		Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+		Status: metav1.ConditionFalse,
+		Reason: infrav1.IBMVPCMachinePoolInstanceGroupDeletingV1Beta2Reason,
+	})
+
+	deleted, err := machinePoolScope.DeleteInstanceGroup(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting instance group for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+	if !deleted {
+		log.Info("Waiting for instance group to be deleted")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	// Once the instance group is gone, none of the instance templates are in use anymore.
+	machinePoolScope.IBMVPCMachinePool.Status.InstanceTemplate = nil
+	if err := machinePoolScope.DeleteOutdatedInstanceTemplates(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting instance templates for IBMVPCMachinePool %s/%s: %w", machinePoolScope.IBMVPCMachinePool.Namespace, machinePoolScope.IBMVPCMachinePool.Name, err)
+	}
+
+	controllerutil.RemoveFinalizer(machinePoolScope.IBMVPCMachinePool, infrav1.MachinePoolFinalizer)
+	return ctrl.Result{}, nil
+}
+
+func markInstanceGroupProvisionFailed(ibmVPCMachinePool *infrav1.IBMVPCMachinePool, err error) {
+	v1beta1conditions.MarkFalse(ibmVPCMachinePool, infrav1.InstanceGroupReadyCondition, infrav1.InstanceGroupProvisionFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+	v1beta2conditions.Set(ibmVPCMachinePool, metav1.Condition{
+		Type:    infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.IBMVPCMachinePoolInstanceGroupNotReadyV1Beta2Reason,
+		Message: err.Error(),
+	})
+}
+
+func patchIBMVPCMachinePool(ctx context.Context, patchHelper *v1beta1patch.Helper, ibmVPCMachinePool *infrav1.IBMVPCMachinePool) error {
+	// Before computing ready condition, make sure that InstanceGroupReady is always set.
+	// NOTE: This is required because v1beta2 conditions comply to guideline requiring conditions to be set at the
+	// first reconcile.
+	if c := v1beta2conditions.Get(ibmVPCMachinePool, infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition); c == nil {
+		if ibmVPCMachinePool.Status.Ready {
+			v1beta2conditions.Set(ibmVPCMachinePool, metav1.Condition{
+				Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+				Status: metav1.ConditionTrue,
+				Reason: infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Reason,
+			})
+		} else {
+			v1beta2conditions.Set(ibmVPCMachinePool, metav1.Condition{
+				Type:   infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.IBMVPCMachinePoolInstanceGroupNotReadyV1Beta2Reason,
+			})
+		}
+	}
+
+	v1beta1conditions.SetSummary(ibmVPCMachinePool,
+		v1beta1conditions.WithConditions(
+			infrav1.InstanceGroupReadyCondition,
+		),
+	)
+
+	if err := v1beta2conditions.SetSummaryCondition(ibmVPCMachinePool, ibmVPCMachinePool, infrav1.IBMVPCMachinePoolReadyV1Beta2Condition,
+		v1beta2conditions.ForConditionTypes{
+			infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+		},
+		// Using a custom merge strategy to override reasons applied during merge.
+		v1beta2conditions.CustomMergeStrategy{
+			MergeStrategy: v1beta2conditions.DefaultMergeStrategy(
+				// Use custom reasons.
+				v1beta2conditions.ComputeReasonFunc(v1beta2conditions.GetDefaultComputeMergeReasonFunc(
+					infrav1.IBMVPCMachinePoolNotReadyV1Beta2Reason,
+					infrav1.IBMVPCMachinePoolReadyUnknownV1Beta2Reason,
+					infrav1.IBMVPCMachinePoolReadyV1Beta2Reason,
+				)),
+			),
+		},
+	); err != nil {
+		return fmt.Errorf("failed to set %s condition: %w", infrav1.IBMVPCMachinePoolReadyV1Beta2Condition, err)
+	}
+
+	// Patch the IBMVPCMachinePool resource.
+	return patchHelper.Patch(ctx, ibmVPCMachinePool, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
+		infrav1.IBMVPCMachinePoolReadyV1Beta2Condition,
+		infrav1.IBMVPCMachinePoolInstanceGroupReadyV1Beta2Condition,
+		clusterv1beta1.PausedV1Beta2Condition,
+	}})
+}
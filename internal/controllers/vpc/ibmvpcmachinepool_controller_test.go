@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
+	vpcmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMVPCMachinePoolReconciler_reconcile(t *testing.T) {
+	var (
+		mockvpc          *vpcmock.MockVpc
+		mockCtrl         *gomock.Controller
+		machinePoolScope *vpc.MachinePoolScope
+		reconciler       IBMVPCMachinePoolReconciler
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockvpc = vpcmock.NewMockVpc(mockCtrl)
+		reconciler = IBMVPCMachinePoolReconciler{
+			Log: klog.Background(),
+		}
+		machinePoolScope = &vpc.MachinePoolScope{
+			IBMVPCMachinePool: &infrav1.IBMVPCMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "capi-machine-pool",
+					Finalizers: []string{infrav1.MachinePoolFinalizer},
+				},
+			},
+			MachinePool: &clusterv1.MachinePool{
+				Spec: clusterv1.MachinePoolSpec{
+					ClusterName: "vpc-cluster",
+				},
+			},
+			Cluster: &clusterv1.Cluster{
+				Status: clusterv1.ClusterStatus{
+					Initialization: clusterv1.ClusterInitializationStatus{
+						InfrastructureProvisioned: ptr.To(true),
+					},
+				},
+			},
+			IBMVPCCluster: &infrav1.IBMVPCCluster{},
+			IBMVPCClient:  mockvpc,
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Reconcile creating IBMVPCMachinePool", func(t *testing.T) {
+		t.Run("Should wait for cluster infrastructure", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machinePoolScope.Cluster.Status.Initialization.InfrastructureProvisioned = nil
+			_, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition)).To(Equal(infrav1.WaitingForClusterInfrastructureReason))
+		})
+		t.Run("Should fail to find bootstrap data secret reference", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			result, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(machinePoolScope.IBMVPCMachinePool.Status.Ready).To(BeFalse())
+			g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition)).To(Equal(infrav1.WaitingForBootstrapDataReason))
+		})
+	})
+}
+
+func TestIBMVPCMachinePoolReconciler_Delete(t *testing.T) {
+	var (
+		mockvpc          *vpcmock.MockVpc
+		mockgt           *gtmock.MockGlobalTagging
+		mockCtrl         *gomock.Controller
+		machinePoolScope *vpc.MachinePoolScope
+		reconciler       IBMVPCMachinePoolReconciler
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockvpc = vpcmock.NewMockVpc(mockCtrl)
+		mockgt = gtmock.NewMockGlobalTagging(mockCtrl)
+		reconciler = IBMVPCMachinePoolReconciler{
+			Log: klog.Background(),
+		}
+		machinePoolScope = &vpc.MachinePoolScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "capi-cluster",
+				},
+			},
+			IBMVPCMachinePool: &infrav1.IBMVPCMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "capi-machine-pool",
+					Finalizers: []string{infrav1.MachinePoolFinalizer},
+				},
+				Status: infrav1.IBMVPCMachinePoolStatus{
+					InstanceGroupID: "capi-instance-group-id",
+					InstanceTemplate: &infrav1.VPCResource{
+						ID: ptr.To("capi-instance-template-id"),
+					},
+				},
+			},
+			IBMVPCClient:        mockvpc,
+			GlobalTaggingClient: mockgt,
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Reconciling deleting IBMVPCMachinePool", func(t *testing.T) {
+		t.Run("Should fail to get instance group", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 500}, errors.New("failed to get instance group"))
+			_, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(machinePoolScope.IBMVPCMachinePool.Finalizers).To(ContainElement(infrav1.MachinePoolFinalizer))
+		})
+		t.Run("Should requeue while instance group is being deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{
+				ID:             ptr.To("capi-instance-group-id"),
+				LifecycleState: ptr.To(vpcv1.InstanceGroupLifecycleStateDeletingConst),
+			}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(machinePoolScope.IBMVPCMachinePool.Finalizers).To(ContainElement(infrav1.MachinePoolFinalizer))
+		})
+		t.Run("Should delete instance templates and remove the finalizer", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			mockvpc.EXPECT().ListInstanceTemplates(gomock.AssignableToTypeOf(&vpcv1.ListInstanceTemplatesOptions{})).Return(&vpcv1.InstanceTemplateCollection{
+				Templates: []vpcv1.InstanceTemplateIntf{
+					&vpcv1.InstanceTemplate{ID: ptr.To("capi-instance-template-id"), CRN: ptr.To("capi-instance-template-crn"), Name: ptr.To("capi-cluster-capi-machine-pool-0123abcd")},
+				},
+			}, &core.DetailedResponse{}, nil)
+			mockgt.EXPECT().GetAttachedTags("capi-instance-template-crn").Return([]string{"capi-cluster"}, nil)
+			mockvpc.EXPECT().DeleteInstanceTemplate(&vpcv1.DeleteInstanceTemplateOptions{ID: ptr.To("capi-instance-template-id")}).Return(&core.DetailedResponse{}, nil)
+			_, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machinePoolScope.IBMVPCMachinePool.Finalizers).To(Not(ContainElement(infrav1.MachinePoolFinalizer)))
+		})
+	})
+}
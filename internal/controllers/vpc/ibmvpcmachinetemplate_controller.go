@@ -20,32 +20,47 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"                   //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
+	vpcscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 )
 
+// reservationRequeueAfter is the interval at which the capacity of a reservation targeted by a template is refreshed.
+const reservationRequeueAfter = 5 * time.Minute
+
 // IBMVPCMachineTemplateReconciler reconciles a IBMVPCMachineTemplate object.
 type IBMVPCMachineTemplateReconciler struct {
 	client.Client
 	Scheme          *runtime.Scheme
 	ServiceEndpoint []endpoints.ServiceEndpoint
+
+	ClientBuilder vpcscope.ClientBuilder
 }
 
 func (r *IBMVPCMachineTemplateReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	if r.ClientBuilder == nil {
+		r.ClientBuilder = vpcscope.ProdClientBuilder{}
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.IBMVPCMachineTemplate{}).
 		Complete(r)
@@ -66,10 +81,24 @@ func (r *IBMVPCMachineTemplateReconciler) Reconcile(ctx context.Context, req ctr
 
 	region := endpoints.ConstructRegionFromZone(machineTemplate.Spec.Template.Spec.Zone)
 
-	// Fetch the service endpoint.
-	svcEndpoint := endpoints.FetchVPCEndpoint(region, r.ServiceEndpoint)
-
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	clientBuilder := r.ClientBuilder
+	if clientBuilder == nil {
+		clientBuilder = vpcscope.ProdClientBuilder{}
+	}
+	// Use the credentials of the cluster owning the template, e.g. of its IBMCloudClusterIdentity.
+	ibmVPCCluster, err := r.getIBMVPCCluster(ctx, &machineTemplate)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	auth, err := clientBuilder.GetAuthenticator(ctx, vpcscope.AuthenticatorOptions{Client: r.Client, IBMVPCCluster: ibmVPCCluster})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+	vpcClient, err := clientBuilder.GetVPCClient(ctx, vpcscope.ClientOptions{
+		Authenticator:   auth,
+		Region:          region,
+		ServiceEndpoint: r.ServiceEndpoint,
+	})
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create IBM VPC client: %w", err)
 	}
@@ -77,6 +106,31 @@ func (r *IBMVPCMachineTemplateReconciler) Reconcile(ctx context.Context, req ctr
 	return r.reconcileNormal(ctx, vpcClient, machineTemplate)
 }
 
+// getIBMVPCCluster returns the IBMVPCCluster of the cluster owning the machine template, or of the cluster in its
+// cluster name label. It returns nil when the template does not belong to a cluster yet.
+func (r *IBMVPCMachineTemplateReconciler) getIBMVPCCluster(ctx context.Context, machineTemplate *infrav1.IBMVPCMachineTemplate) (*infrav1.IBMVPCCluster, error) {
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, machineTemplate.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner cluster of IBMVPCMachineTemplate %s/%s: %w", machineTemplate.Namespace, machineTemplate.Name, err)
+	}
+	if cluster == nil {
+		clusterName, ok := machineTemplate.Labels[clusterv1.ClusterNameLabel]
+		if !ok {
+			return nil, nil
+		}
+		if cluster, err = util.GetClusterByName(ctx, r.Client, machineTemplate.Namespace, clusterName); err != nil {
+			return nil, fmt.Errorf("failed to get cluster %s/%s: %w", machineTemplate.Namespace, clusterName, err)
+		}
+	}
+
+	ibmVPCCluster := &infrav1.IBMVPCCluster{}
+	key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}
+	if err := r.Get(ctx, key, ibmVPCCluster); err != nil {
+		return nil, fmt.Errorf("failed to get IBMVPCCluster %s/%s: %w", key.Namespace, key.Name, err)
+	}
+	return ibmVPCCluster, nil
+}
+
 func (r *IBMVPCMachineTemplateReconciler) reconcileNormal(ctx context.Context, vpcClient vpc.Vpc, machineTemplate infrav1.IBMVPCMachineTemplate) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	helper, err := v1beta1patch.NewHelper(&machineTemplate, r.Client)
@@ -102,10 +156,53 @@ func (r *IBMVPCMachineTemplateReconciler) reconcileNormal(ctx context.Context, v
 	cpu := fmt.Sprintf("%v", *profileDetails.VcpuCount.(*vpcv1.InstanceProfileVcpu).Value)
 	capacity[corev1.ResourceCPU] = resource.MustParse(cpu)
 	capacity[corev1.ResourceMemory] = resource.MustParse(memory)
+	if sourceSnapshot := machineTemplate.Spec.Template.Spec.SourceSnapshot; sourceSnapshot != nil {
+		snapshot, err := vpcscope.GetSnapshot(vpcClient, sourceSnapshot)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to fetch snapshot details: %w", err)
+		}
+		// The boot volume of the machines is created from the snapshot, unless resized to a larger size.
+		bootVolumeSizeGiB := ptr.Deref(snapshot.MinimumCapacity, 0)
+		if bootVolume := machineTemplate.Spec.Template.Spec.BootVolume; bootVolume != nil && bootVolume.SizeGiB > bootVolumeSizeGiB {
+			bootVolumeSizeGiB = bootVolume.SizeGiB
+		}
+		capacity[corev1.ResourceEphemeralStorage] = resource.MustParse(fmt.Sprintf("%dGi", bootVolumeSizeGiB))
+	}
 
 	log.V(3).Info("Calculated capacity for machine template", "capacity", capacity)
-	if !reflect.DeepEqual(machineTemplate.Status.Capacity, capacity) {
-		machineTemplate.Status.Capacity = capacity
+
+	oldStatus := machineTemplate.Status.DeepCopy()
+	result := ctrl.Result{}
+	var reservationErr error
+	if target := reservationTarget(machineTemplate); target != nil {
+		// Reservation capacity is consumed outside of the template, so keep tracking it.
+		result.RequeueAfter = reservationRequeueAfter
+		// The capacity is published even when the reservation details can't be retrieved, as the autoscaling from
+		// zero depends on it, and the last observed state of the reservation is kept.
+		if reservation, err := vpcscope.GetReservation(vpcClient, target); err != nil {
+			reservationErr = fmt.Errorf("failed to fetch reservation details: %w", err)
+			v1beta2conditions.Set(&machineTemplate, metav1.Condition{
+				Type:    infrav1.IBMVPCMachineTemplateReservationReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.IBMVPCMachineTemplateReservationLookupFailedV1Beta2Reason,
+				Message: reservationErr.Error(),
+			})
+		} else {
+			machineTemplate.Status.Reservation = vpcscope.ReservationStatus(reservation)
+			log.V(3).Info("Observed reservation for machine template", "reservation", machineTemplate.Status.Reservation)
+			v1beta2conditions.Set(&machineTemplate, metav1.Condition{
+				Type:   infrav1.IBMVPCMachineTemplateReservationReadyV1Beta2Condition,
+				Status: metav1.ConditionTrue,
+				Reason: infrav1.IBMVPCMachineTemplateReservationReadyV1Beta2Reason,
+			})
+		}
+	} else {
+		machineTemplate.Status.Reservation = nil
+		v1beta2conditions.Delete(&machineTemplate, infrav1.IBMVPCMachineTemplateReservationReadyV1Beta2Condition)
+	}
+	machineTemplate.Status.Capacity = capacity
+
+	if !reflect.DeepEqual(*oldStatus, machineTemplate.Status) {
 		if err := helper.Patch(ctx, &machineTemplate); err != nil {
 			if !apierrors.IsNotFound(err) {
 				log.Error(err, "Failed to patch machineTemplate")
@@ -113,6 +210,21 @@ func (r *IBMVPCMachineTemplateReconciler) reconcileNormal(ctx context.Context, v
 			}
 		}
 	}
+	if reservationErr != nil {
+		return ctrl.Result{}, reservationErr
+	}
 	log.V(3).Info("Machine template status", "status", machineTemplate.Status)
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// reservationTarget returns the reservation targeted by ID or name by the machine template, if any.
+func reservationTarget(machineTemplate infrav1.IBMVPCMachineTemplate) *infrav1.VPCReservationTarget {
+	placementTarget := machineTemplate.Spec.Template.Spec.PlacementTarget
+	if placementTarget == nil || placementTarget.Reservation == nil {
+		return nil
+	}
+	if placementTarget.Reservation.ID == nil && placementTarget.Reservation.Name == nil {
+		return nil
+	}
+	return placementTarget.Reservation
 }
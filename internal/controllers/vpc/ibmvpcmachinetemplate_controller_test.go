@@ -17,6 +17,7 @@ limitations under the License.
 package vpc
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -26,10 +27,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
@@ -165,6 +170,93 @@ func TestIBMVPCMachineTemplateReconciler_reconcileNormal(t *testing.T) {
 	},
 	)
 
+	t.Run("with updated profile ", func(tt *testing.T) {
+		g := NewWithT(tt)
+		profileDetails := func(name string, vcpu, memory int64) *vpcv1.InstanceProfile {
+			return &vpcv1.InstanceProfile{
+				Name:      ptr.To(name),
+				VcpuCount: &vpcv1.InstanceProfileVcpu{Type: ptr.To("fixed"), Value: ptr.To(vcpu)},
+				Memory:    &vpcv1.InstanceProfileMemory{Type: ptr.To("fixed"), Value: ptr.To(memory)},
+			}
+		}
+		ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("namespace-%s", util.RandomString(5)))
+		g.Expect(err).To(BeNil())
+		defer func() {
+			g.Expect(testEnv.Cleanup(ctx, ns)).To(Succeed())
+		}()
+		vPCMachineTemplate := stubVPCMachineTemplate("bx2-4x16")
+		createObject(g, &vPCMachineTemplate, ns.Name)
+		defer cleanupObject(g, &vPCMachineTemplate)
+
+		mockController, mockvpc, reconciler := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: ptr.To("bx2-4x16")}).Return(profileDetails("bx2-4x16", 4, 16), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: ptr.To("bx2-8x32")}).Return(profileDetails("bx2-8x32", 8, 32), &core.DetailedResponse{}, nil)
+		_, err = reconciler.reconcileNormal(ctx, mockvpc, vPCMachineTemplate)
+		g.Expect(err).To(BeNil())
+
+		key := client.ObjectKey{Name: vPCMachineTemplate.Name, Namespace: ns.Name}
+		machineTemplate := &infrav1.IBMVPCMachineTemplate{}
+		g.Expect(testEnv.Get(ctx, key, machineTemplate)).To(Succeed())
+		machineTemplate.Spec.Template.Spec.Profile = "bx2-8x32"
+		g.Expect(testEnv.Update(ctx, machineTemplate)).To(Succeed())
+		_, err = reconciler.reconcileNormal(ctx, mockvpc, *machineTemplate)
+		g.Expect(err).To(BeNil())
+
+		expectedCapacity := corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: resource.MustParse("32G"),
+		}
+		g.Eventually(func() bool {
+			machineTemplate := &infrav1.IBMVPCMachineTemplate{}
+			g.Expect(testEnv.Get(ctx, key, machineTemplate)).To(Succeed())
+			return reflect.DeepEqual(machineTemplate.Status.Capacity, expectedCapacity)
+		}, 10*time.Second).Should(Equal(true))
+	},
+	)
+
+	t.Run("with a source snapshot resized for the boot volume ", func(tt *testing.T) {
+		g := NewWithT(tt)
+		ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("namespace-%s", util.RandomString(5)))
+		g.Expect(err).To(BeNil())
+		defer func() {
+			g.Expect(testEnv.Cleanup(ctx, ns)).To(Succeed())
+		}()
+		vPCMachineTemplate := stubVPCMachineTemplate("bx2-4x16")
+		vPCMachineTemplate.Spec.Template.Spec.Image = nil
+		vPCMachineTemplate.Spec.Template.Spec.SourceSnapshot = &infrav1.VPCSnapshotReference{Name: ptr.To("capi-snapshot")}
+		vPCMachineTemplate.Spec.Template.Spec.BootVolume = &infrav1.VPCVolume{SizeGiB: 200}
+		createObject(g, &vPCMachineTemplate, ns.Name)
+		defer cleanupObject(g, &vPCMachineTemplate)
+
+		mockController, mockvpc, reconciler := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetInstanceProfile(gomock.AssignableToTypeOf(&vpcv1.GetInstanceProfileOptions{})).Return(&vpcv1.InstanceProfile{
+			VcpuCount: &vpcv1.InstanceProfileVcpu{Value: ptr.To(int64(4))},
+			Memory:    &vpcv1.InstanceProfileMemory{Value: ptr.To(int64(16))},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetSnapshotByName("capi-snapshot").Return(&vpcv1.Snapshot{
+			ID:              ptr.To("capi-snapshot-id"),
+			Bootable:        ptr.To(true),
+			MinimumCapacity: ptr.To(int64(100)),
+		}, nil)
+		_, err = reconciler.reconcileNormal(ctx, mockvpc, vPCMachineTemplate)
+		g.Expect(err).To(BeNil())
+
+		expectedCapacity := corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("4"),
+			corev1.ResourceMemory:           resource.MustParse("16G"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("200Gi"),
+		}
+		g.Eventually(func() bool {
+			machineTemplate := &infrav1.IBMVPCMachineTemplate{}
+			key := client.ObjectKey{Name: vPCMachineTemplate.Name, Namespace: ns.Name}
+			g.Expect(testEnv.Get(ctx, key, machineTemplate)).To(Succeed())
+			return reflect.DeepEqual(machineTemplate.Status.Capacity, expectedCapacity)
+		}, 10*time.Second).Should(Equal(true))
+	},
+	)
+
 	t.Run("with invalid profile ", func(tt *testing.T) {
 		g := NewWithT(tt)
 		ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("namespace-%s", util.RandomString(5)))
@@ -231,3 +323,162 @@ func stubVPCMachineTemplate(profile string) infrav1.IBMVPCMachineTemplate {
 		},
 	}
 }
+
+func TestIBMVPCMachineTemplateReconciler_reconcileReservation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	profileDetails := &vpcv1.InstanceProfile{
+		Name:      ptr.To("bx2-4x16"),
+		VcpuCount: &vpcv1.InstanceProfileVcpu{Value: ptr.To(int64(4))},
+		Memory:    &vpcv1.InstanceProfileMemory{Value: ptr.To(int64(16))},
+	}
+	expectedCapacity := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("16G"),
+	}
+	setup := func(t *testing.T) (*mock.MockVpc, *IBMVPCMachineTemplateReconciler, infrav1.IBMVPCMachineTemplate) {
+		t.Helper()
+		machineTemplate := stubVPCMachineTemplate("bx2-4x16")
+		machineTemplate.Namespace = "default"
+		machineTemplate.Spec.Template.Spec.PlacementTarget = &infrav1.VPCMachinePlacementTarget{
+			Reservation: &infrav1.VPCReservationTarget{ID: ptr.To("reservation-id")},
+		}
+		reconciler := &IBMVPCMachineTemplateReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&machineTemplate).WithStatusSubresource(&machineTemplate).Build(),
+		}
+		mockvpc := mock.NewMockVpc(gomock.NewController(t))
+		mockvpc.EXPECT().GetInstanceProfile(gomock.AssignableToTypeOf(&vpcv1.GetInstanceProfileOptions{})).Return(profileDetails, &core.DetailedResponse{}, nil)
+		return mockvpc, reconciler, machineTemplate
+	}
+
+	t.Run("Should publish the capacity and the reservation", func(t *testing.T) {
+		g := NewWithT(t)
+		mockvpc, reconciler, machineTemplate := setup(t)
+		mockvpc.EXPECT().GetReservation(gomock.AssignableToTypeOf(&vpcv1.GetReservationOptions{})).Return(&vpcv1.Reservation{
+			ID:       ptr.To("reservation-id"),
+			Status:   ptr.To(vpcv1.ReservationStatusActiveConst),
+			Capacity: &vpcv1.ReservationCapacity{Total: ptr.To(int64(10)), Available: ptr.To(int64(2))},
+		}, &core.DetailedResponse{}, nil)
+
+		result, err := reconciler.reconcileNormal(ctx, mockvpc, machineTemplate)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(reservationRequeueAfter))
+
+		updated := &infrav1.IBMVPCMachineTemplate{}
+		g.Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(&machineTemplate), updated)).To(Succeed())
+		for name, quantity := range expectedCapacity {
+			g.Expect(updated.Status.Capacity[name].Equal(quantity)).To(BeTrue())
+		}
+		g.Expect(updated.Status.Reservation).To(Equal(&infrav1.VPCReservationStatus{ID: "reservation-id", Status: vpcv1.ReservationStatusActiveConst, Total: 10, Available: 2}))
+		condition := v1beta2conditions.Get(updated, infrav1.IBMVPCMachineTemplateReservationReadyV1Beta2Condition)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	t.Run("Should publish the capacity when the reservation cannot be retrieved", func(t *testing.T) {
+		g := NewWithT(t)
+		mockvpc, reconciler, machineTemplate := setup(t)
+		mockvpc.EXPECT().GetReservation(gomock.AssignableToTypeOf(&vpcv1.GetReservationOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to get reservation"))
+
+		_, err := reconciler.reconcileNormal(ctx, mockvpc, machineTemplate)
+		g.Expect(err).To(HaveOccurred())
+
+		updated := &infrav1.IBMVPCMachineTemplate{}
+		g.Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(&machineTemplate), updated)).To(Succeed())
+		for name, quantity := range expectedCapacity {
+			g.Expect(updated.Status.Capacity[name].Equal(quantity)).To(BeTrue())
+		}
+		g.Expect(updated.Status.Reservation).To(BeNil())
+		condition := v1beta2conditions.Get(updated, infrav1.IBMVPCMachineTemplateReservationReadyV1Beta2Condition)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(condition.Reason).To(Equal(infrav1.IBMVPCMachineTemplateReservationLookupFailedV1Beta2Reason))
+		g.Expect(condition.Message).To(ContainSubstring("failed to get reservation"))
+	})
+}
+
+func TestIBMVPCMachineTemplateReconciler_getIBMVPCCluster(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "capi-cluster",
+			Namespace: "default",
+			UID:       "capi-cluster-uid",
+		},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: infrav1.GroupVersion.Group,
+				Kind:     "IBMVPCCluster",
+				Name:     "vpc-cluster",
+			},
+		},
+	}
+	ibmVPCCluster := &infrav1.IBMVPCCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vpc-cluster",
+			Namespace: "default",
+		},
+		Spec: infrav1.IBMVPCClusterSpec{
+			IdentityRef: &infrav1.IBMCloudClusterIdentityReference{Name: "capi-identity"},
+		},
+	}
+	reconciler := &IBMVPCMachineTemplateReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, ibmVPCCluster).Build(),
+	}
+
+	t.Run("Should return the IBMVPCCluster of the owner cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		machineTemplate := &infrav1.IBMVPCMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-machine-template",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       cluster.Name,
+						UID:        cluster.UID,
+					},
+				},
+			},
+		}
+		result, err := reconciler.getIBMVPCCluster(ctx, machineTemplate)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Spec.IdentityRef.Name).To(Equal("capi-identity"))
+	})
+
+	t.Run("Should return the IBMVPCCluster of the cluster in the cluster name label", func(t *testing.T) {
+		g := NewWithT(t)
+		machineTemplate := &infrav1.IBMVPCMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-machine-template",
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: cluster.Name},
+			},
+		}
+		result, err := reconciler.getIBMVPCCluster(ctx, machineTemplate)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Name).To(Equal(ibmVPCCluster.Name))
+	})
+
+	t.Run("Should return nil when the template does not belong to a cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		machineTemplate := &infrav1.IBMVPCMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-machine-template",
+				Namespace: "default",
+			},
+		}
+		result, err := reconciler.getIBMVPCCluster(ctx, machineTemplate)
+		g.Expect(err).To(BeNil())
+		g.Expect(result).To(BeNil())
+	})
+}
@@ -18,6 +18,8 @@ package vpc
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -55,12 +57,12 @@ func (r *IBMVPCCluster) Default(_ context.Context, _ *infrav1.IBMVPCCluster) err
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMVPCCluster) ValidateCreate(_ context.Context, obj *infrav1.IBMVPCCluster) (admission.Warnings, error) {
-	return validateIBMVPCCluster(obj)
+	return validateIBMVPCCluster(nil, obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCCluster) ValidateUpdate(_ context.Context, _, newObj *infrav1.IBMVPCCluster) (warnings admission.Warnings, err error) {
-	return validateIBMVPCCluster(newObj)
+func (r *IBMVPCCluster) ValidateUpdate(_ context.Context, oldObj, newObj *infrav1.IBMVPCCluster) (warnings admission.Warnings, err error) {
+	return validateIBMVPCCluster(oldObj, newObj)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -68,11 +70,23 @@ func (r *IBMVPCCluster) ValidateDelete(_ context.Context, _ *infrav1.IBMVPCClust
 	return nil, nil
 }
 
-func validateIBMVPCCluster(vpcCluster *infrav1.IBMVPCCluster) (admission.Warnings, error) {
+// validateIBMVPCCluster validates an IBMVPCCluster, and its update from oldCluster when it is not nil.
+func validateIBMVPCCluster(oldCluster, vpcCluster *infrav1.IBMVPCCluster) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 	if err := validateIBMVPCClusterControlPlane(vpcCluster); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := validateIBMVPCClusterAdditionalTags(vpcCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateIBMVPCClusterFileShares(vpcCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if oldCluster != nil {
+		if err := validateIBMVPCClusterFileSharesRemoval(oldCluster, vpcCluster); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -88,3 +102,32 @@ func validateIBMVPCClusterControlPlane(vpcCluster *infrav1.IBMVPCCluster) *field
 	}
 	return nil
 }
+
+// validateIBMVPCClusterAdditionalTags validates that the additional tags are only set when the network is configured,
+// as the resources of the cluster are only tagged when it is.
+func validateIBMVPCClusterAdditionalTags(vpcCluster *infrav1.IBMVPCCluster) *field.Error {
+	if len(vpcCluster.Spec.AdditionalTags) > 0 && vpcCluster.Spec.Network == nil {
+		return field.Forbidden(field.NewPath("spec", "additionalTags"), "additionalTags can only be set when the network is configured")
+	}
+	return nil
+}
+
+// validateIBMVPCClusterFileShares validates that the file shares are only set when the network is configured, as
+// they are only reconciled when it is.
+func validateIBMVPCClusterFileShares(vpcCluster *infrav1.IBMVPCCluster) *field.Error {
+	if len(vpcCluster.Spec.FileShares) > 0 && vpcCluster.Spec.Network == nil {
+		return field.Forbidden(field.NewPath("spec", "fileShares"), "fileShares can only be set when the network is configured")
+	}
+	return nil
+}
+
+// validateIBMVPCClusterFileSharesRemoval validates that no file share is removed from the cluster, as the file shares
+// are not deleted by the controller, so that no file share created for the cluster is orphaned.
+func validateIBMVPCClusterFileSharesRemoval(oldCluster, vpcCluster *infrav1.IBMVPCCluster) *field.Error {
+	for _, oldFileShare := range oldCluster.Spec.FileShares {
+		if !slices.ContainsFunc(vpcCluster.Spec.FileShares, func(fileShare infrav1.VPCFileShare) bool { return fileShare.Name == oldFileShare.Name }) {
+			return field.Forbidden(field.NewPath("spec", "fileShares"), fmt.Sprintf("file share %q cannot be removed", oldFileShare.Name))
+		}
+	}
+	return nil
+}
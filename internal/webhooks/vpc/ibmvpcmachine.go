@@ -55,12 +55,27 @@ func (r *IBMVPCMachine) Default(_ context.Context, obj *infrav1.IBMVPCMachine) e
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMVPCMachine) ValidateCreate(_ context.Context, obj *infrav1.IBMVPCMachine) (admission.Warnings, error) {
 	allErrs := validateIBMVPCMachineVolume(obj.Spec)
+	allErrs = append(allErrs, validateAdditionalNetworkInterfaces(obj.Spec)...)
+	allErrs = append(allErrs, validateNetworkAttachments(obj.Spec)...)
+	allErrs = append(allErrs, validateAvailabilityPolicy(obj.Spec)...)
+	allErrs = append(allErrs, validateBootSource(obj.Spec)...)
+	allErrs = append(allErrs, validateDefaultTrustedProfile(obj.Spec)...)
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCMachine) ValidateUpdate(_ context.Context, _, _ *infrav1.IBMVPCMachine) (warnings admission.Warnings, err error) {
-	return nil, nil
+func (r *IBMVPCMachine) ValidateUpdate(_ context.Context, oldObj, newObj *infrav1.IBMVPCMachine) (warnings admission.Warnings, err error) {
+	allErrs := validateIBMVPCMachineVolume(newObj.Spec)
+	allErrs = append(allErrs, validateAdditionalNetworkInterfaces(newObj.Spec)...)
+	allErrs = append(allErrs, validateNetworkAttachments(newObj.Spec)...)
+	allErrs = append(allErrs, validateAvailabilityPolicy(newObj.Spec)...)
+	allErrs = append(allErrs, validateBootSource(newObj.Spec)...)
+	allErrs = append(allErrs, validateDefaultTrustedProfile(newObj.Spec)...)
+	allErrs = append(allErrs, validateVolumeUpdates(oldObj.Spec, newObj.Spec)...)
+	allErrs = append(allErrs, validateAdditionalVolumesRemoval(oldObj.Spec, newObj.Spec)...)
+	allErrs = append(allErrs, validateProfileUpdate(oldObj, newObj)...)
+	allErrs = append(allErrs, validateSecurityOptionsUpdate(oldObj.Spec, newObj.Spec)...)
+	return nil, aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
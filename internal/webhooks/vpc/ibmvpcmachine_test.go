@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 
@@ -78,3 +79,87 @@ func TestIBMVPCMachine_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestIBMVPCMachine_ValidateUpdate(t *testing.T) {
+	oldMachine := &infrav1.IBMVPCMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default"},
+		Spec: infrav1.IBMVPCMachineSpec{
+			Image:   &infrav1.IBMVPCResourceReference{},
+			Profile: "bx2-2x8",
+		},
+	}
+	tests := []struct {
+		name    string
+		update  func(spec *infrav1.IBMVPCMachineSpec)
+		wantErr bool
+	}{
+		{
+			name:    "Should accept an unchanged machine",
+			update:  func(*infrav1.IBMVPCMachineSpec) {},
+			wantErr: false,
+		},
+		{
+			name: "Should reject an additional network interface without subnet",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.AdditionalNetworkInterfaces = []infrav1.NetworkInterface{{}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject a virtual network interface in the network interface attachment mode",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.VirtualNetworkInterface = &infrav1.VPCVirtualNetworkInterface{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject a spot instance placed on a dedicated host",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.AvailabilityPolicy = &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassSpot}
+				spec.PlacementTarget = &infrav1.VPCMachinePlacementTarget{DedicatedHost: &infrav1.VPCResource{}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject removing the boot source",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.Image = nil
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject a source snapshot combined with an image",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.SourceSnapshot = &infrav1.VPCSnapshotReference{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject a default trusted profile with the metadata service disabled",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.DefaultTrustedProfile = &infrav1.VPCTrustedProfileReference{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should reject changing the security options",
+			update: func(spec *infrav1.IBMVPCMachineSpec) {
+				spec.SecurityOptions = &infrav1.VPCSecurityOptions{SecureBoot: ptr.To(true)}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			newMachine := oldMachine.DeepCopy()
+			tt.update(&newMachine.Spec)
+			_, err := (&IBMVPCMachine{}).ValidateUpdate(context.Background(), oldMachine, newMachine)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
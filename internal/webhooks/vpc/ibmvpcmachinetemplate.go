@@ -53,6 +53,12 @@ func (r *IBMVPCMachineTemplate) Default(_ context.Context, obj *infrav1.IBMVPCMa
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMVPCMachineTemplate) ValidateCreate(_ context.Context, obj *infrav1.IBMVPCMachineTemplate) (admission.Warnings, error) {
 	allErrs := validateIBMVPCMachineVolume(obj.Spec.Template.Spec)
+	allErrs = append(allErrs, validateAdditionalNetworkInterfaces(obj.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateNetworkAttachments(obj.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateAvailabilityPolicy(obj.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateBootSource(obj.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateDefaultTrustedProfile(obj.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateTemplateReservedIP(obj.Spec.Template.Spec)...)
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
 }
 
@@ -18,8 +18,10 @@ package vpc
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
@@ -57,7 +59,15 @@ func validateVolumes(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
 func validateAdditionalVolumes(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
 	var allErrs field.ErrorList
 
+	names := map[string]bool{}
 	for i := range spec.AdditionalVolumes {
+		// Volumes are matched to their attachments by name, which therefore has to be unique.
+		if name := spec.AdditionalVolumes[i].Name; name != "" {
+			if names[name] {
+				allErrs = append(allErrs, field.Duplicate(field.NewPath(fmt.Sprintf("spec.AdditionalVolumes[%d]", i)).Child("name"), name))
+			}
+			names[name] = true
+		}
 		// A check is required for SizeGiB here but not in BootVolumes because BootVolumes have a default size of 100GiB that is allocated when the size is missing.
 		// The same is not true for AdditionalVolumes, therefore it is a mandatory field here.
 		if spec.AdditionalVolumes[i].SizeGiB == 0 {
@@ -109,6 +119,192 @@ func validateBootVolume(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
 	return allErrs
 }
 
+// validateVolumeUpdates validates that the boot and additional volumes are not shrunk, as volumes can only be expanded,
+// and that their profile is not changed to or from the second-generation sdp profile, which IBM Cloud cannot apply to
+// an existing volume. Additional volumes are matched by name, or by index when unnamed.
+func validateVolumeUpdates(oldSpec, newSpec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if oldSpec.BootVolume != nil && newSpec.BootVolume != nil {
+		allErrs = append(allErrs, validateVolumeUpdate(field.NewPath("spec", "bootVolume"), oldSpec.BootVolume, newSpec.BootVolume)...)
+	}
+
+	for i, volume := range newSpec.AdditionalVolumes {
+		for j, oldVolume := range oldSpec.AdditionalVolumes {
+			if volume.Name != oldVolume.Name || (volume.Name == "" && i != j) {
+				continue
+			}
+			allErrs = append(allErrs, validateVolumeUpdate(field.NewPath("spec", "additionalVolumes").Index(i), oldVolume, volume)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateVolumeUpdate validates the update of a volume from oldVolume to volume.
+func validateVolumeUpdate(path *field.Path, oldVolume, volume *infrav1.VPCVolume) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if volume.SizeGiB != 0 && volume.SizeGiB < oldVolume.SizeGiB {
+		allErrs = append(allErrs, field.Forbidden(path.Child("sizeGiB"), fmt.Sprintf("sizeGiB cannot be decreased from %d", oldVolume.SizeGiB)))
+	}
+	if oldVolume.Profile != volume.Profile && (oldVolume.Profile == sdpProfile || volume.Profile == sdpProfile) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("profile"), fmt.Sprintf("profile cannot be changed from %q to %q, as the %s profile cannot be changed to or from other profile families", oldVolume.Profile, volume.Profile, sdpProfile)))
+	}
+
+	return allErrs
+}
+
+// validateAdditionalVolumesRemoval validates that additional volumes are only removed when all the additional volumes
+// are named, as removed volumes are matched to their attachments by name to be detached from the instance.
+func validateAdditionalVolumesRemoval(oldSpec, newSpec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := sets.New[string]()
+	unnamed := 0
+	for _, volume := range newSpec.AdditionalVolumes {
+		if volume.Name == "" {
+			unnamed++
+			continue
+		}
+		names.Insert(volume.Name)
+	}
+
+	removed := false
+	oldUnnamed := 0
+	for _, volume := range oldSpec.AdditionalVolumes {
+		if volume.Name == "" {
+			oldUnnamed++
+			continue
+		}
+		if !names.Has(volume.Name) {
+			removed = true
+		}
+	}
+	if (removed && (unnamed > 0 || oldUnnamed > 0)) || unnamed < oldUnnamed {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "additionalVolumes"), "additional volumes can only be removed when all the additional volumes are named"))
+	}
+
+	return allErrs
+}
+
+// validateProfileUpdate validates that the profile of an IBMVPCMachine is only changed when the in-place resize of its
+// instance is allowed by the InPlaceResizeAnnotation, so that no instance is resized implicitly.
+func validateProfileUpdate(oldObj, newObj *infrav1.IBMVPCMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if oldObj.Spec.Profile != newObj.Spec.Profile && newObj.Annotations[infrav1.InPlaceResizeAnnotation] != "true" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "profile"), fmt.Sprintf("profile can only be changed when the %s annotation is set to true", infrav1.InPlaceResizeAnnotation)))
+	}
+
+	return allErrs
+}
+
+// validateSecurityOptionsUpdate validates that the security options of an IBMVPCMachine are not changed, as they are
+// only applied to its instance when it is created.
+func validateSecurityOptionsUpdate(oldSpec, newSpec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !reflect.DeepEqual(oldSpec.SecurityOptions, newSpec.SecurityOptions) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "securityOptions"), "securityOptions is immutable"))
+	}
+
+	return allErrs
+}
+
+// validateAdditionalNetworkInterfaces validates the additional network interfaces configuration.
+func validateAdditionalNetworkInterfaces(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i := range spec.AdditionalNetworkInterfaces {
+		if spec.AdditionalNetworkInterfaces[i].Subnet == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "additionalNetworkInterfaces").Index(i).Child("subnet"), "subnet has to be specified for additional network interfaces"))
+		}
+		if spec.AdditionalNetworkInterfaces[i].ReservedIP != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "additionalNetworkInterfaces").Index(i).Child("reservedIP"), "reservedIP is only supported on the primary network interface"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNetworkAttachments validates the virtual network interfaces configuration.
+func validateNetworkAttachments(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.VirtualNetworkInterface != nil && spec.NetworkAttachmentMode != infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "virtualNetworkInterface"), "virtualNetworkInterface can only be set when networkAttachmentMode is VirtualNetworkInterface"))
+	}
+	if vni := spec.VirtualNetworkInterface; vni != nil && vni.DeletionPolicy == infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain && vni.RetainedNamePrefix == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "virtualNetworkInterface", "retainedNamePrefix"), "retainedNamePrefix is required when deletionPolicy is Retain"))
+	}
+
+	return allErrs
+}
+
+// validateAvailabilityPolicy validates the availability policy, as spot instances cannot be placed on a dedicated host.
+func validateAvailabilityPolicy(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.AvailabilityPolicy != nil && spec.AvailabilityPolicy.Class == infrav1.VPCAvailabilityClassSpot && spec.PlacementTarget != nil && spec.PlacementTarget.DedicatedHost != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "availabilityPolicy", "class"), "spot instances cannot be placed on a dedicated host"))
+	}
+	if spec.AvailabilityPolicy != nil && spec.AvailabilityPolicy.Class == infrav1.VPCAvailabilityClassSpot && spec.PlacementTarget != nil && spec.PlacementTarget.Reservation != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "availabilityPolicy", "class"), "spot instances cannot consume a reservation"))
+	}
+
+	return allErrs
+}
+
+// validateBootSource validates that the instance boots from exactly one of an image, a catalog offering or a source
+// snapshot, the latter not being combinable with the others.
+func validateBootSource(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Image == nil && spec.CatalogOffering == nil && spec.SourceSnapshot == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "image"), "one of image, catalogOffering or sourceSnapshot has to be specified"))
+	}
+	if spec.SourceSnapshot != nil && (spec.Image != nil || spec.CatalogOffering != nil) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sourceSnapshot"), "sourceSnapshot cannot be specified with image or catalogOffering"))
+	}
+	if spec.SourceSnapshot != nil && spec.SourceSnapshot.CRN != nil && !isValidCRN(*spec.SourceSnapshot.CRN) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "sourceSnapshot", "crn"), *spec.SourceSnapshot.CRN, "crn not in proper IBM Cloud CRN format"))
+	}
+
+	return allErrs
+}
+
+// validateDefaultTrustedProfile validates the default trusted profile, whose tokens are only served to the instance
+// by its metadata service.
+func validateDefaultTrustedProfile(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.DefaultTrustedProfile == nil {
+		return allErrs
+	}
+	if spec.MetadataService == nil || !spec.MetadataService.Enabled {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "defaultTrustedProfile"), "defaultTrustedProfile requires metadataService to be enabled"))
+	}
+	if spec.DefaultTrustedProfile.CRN != nil && !isValidCRN(*spec.DefaultTrustedProfile.CRN) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "defaultTrustedProfile", "crn"), *spec.DefaultTrustedProfile.CRN, "crn not in proper IBM Cloud CRN format"))
+	}
+
+	return allErrs
+}
+
+// validateTemplateReservedIP validates the reserved IP of the primary network interface of a machine template, whose
+// replicas cannot share a reserved IP or a static address.
+func validateTemplateReservedIP(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	reservedIP := spec.PrimaryNetworkInterface.ReservedIP
+	if reservedIP != nil && reservedIP.PoolRef == nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "primaryNetworkInterface", "reservedIP"), "only poolRef can be set for the reservedIP of a machine template"))
+	}
+
+	return allErrs
+}
+
 // volumeProfileSupportsIops reports whether the volume profile allows the iops to be specified by the user.
 func volumeProfileSupportsIops(profile string) bool {
 	return profile == customProfile || profile == sdpProfile
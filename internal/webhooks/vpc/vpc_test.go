@@ -19,6 +19,11 @@ package vpc
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 )
 
@@ -70,6 +75,13 @@ func Test_validateVolumes(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "Duplicate names for Additional Volumes",
+			spec: infrav1.IBMVPCMachineSpec{
+				AdditionalVolumes: []*infrav1.VPCVolume{{Name: "data", SizeGiB: 25}, {Name: "data", SizeGiB: 50}},
+			},
+			wantError: true,
+		},
 		{
 			name: "Valid iops and bandwidth for sdp profile for Boot Volume",
 			spec: infrav1.IBMVPCMachineSpec{
@@ -149,3 +161,577 @@ func Test_validateVolumes(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateVolumeUpdates(t *testing.T) {
+	oldSpec := infrav1.IBMVPCMachineSpec{
+		BootVolume: &infrav1.VPCVolume{SizeGiB: 100},
+		AdditionalVolumes: []*infrav1.VPCVolume{
+			{Name: "data", SizeGiB: 50, Profile: "general-purpose"},
+			{SizeGiB: 20, Profile: "general-purpose"},
+		},
+	}
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Expanded volumes",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume: &infrav1.VPCVolume{SizeGiB: 200},
+				AdditionalVolumes: []*infrav1.VPCVolume{
+					{Name: "data", SizeGiB: 100},
+					{SizeGiB: 20},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Shrunk boot volume",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume:        &infrav1.VPCVolume{SizeGiB: 50},
+				AdditionalVolumes: oldSpec.AdditionalVolumes,
+			},
+			wantError: true,
+		},
+		{
+			name: "Shrunk named additional volume",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume: oldSpec.BootVolume,
+				AdditionalVolumes: []*infrav1.VPCVolume{
+					{Name: "data", SizeGiB: 10},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Shrunk unnamed additional volume",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume: oldSpec.BootVolume,
+				AdditionalVolumes: []*infrav1.VPCVolume{
+					{Name: "data", SizeGiB: 50},
+					{SizeGiB: 10},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Changed profile within the first-generation profiles",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume: oldSpec.BootVolume,
+				AdditionalVolumes: []*infrav1.VPCVolume{
+					{Name: "data", SizeGiB: 50, Profile: "custom", Iops: 1000},
+					{SizeGiB: 20, Profile: "10iops-tier"},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Changed profile to sdp",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume: oldSpec.BootVolume,
+				AdditionalVolumes: []*infrav1.VPCVolume{
+					{Name: "data", SizeGiB: 50, Profile: "sdp"},
+					{SizeGiB: 20},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Replaced additional volume",
+			spec: infrav1.IBMVPCMachineSpec{
+				BootVolume: oldSpec.BootVolume,
+				AdditionalVolumes: []*infrav1.VPCVolume{
+					{Name: "logs", SizeGiB: 10},
+				},
+			},
+			wantError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateVolumeUpdates(oldSpec, tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateVolumeUpdates() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateAdditionalVolumesRemoval(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldSpec   []*infrav1.VPCVolume
+		spec      []*infrav1.VPCVolume
+		wantError bool
+	}{
+		{
+			name:      "Removed named volume",
+			oldSpec:   []*infrav1.VPCVolume{{Name: "data"}, {Name: "logs"}},
+			spec:      []*infrav1.VPCVolume{{Name: "data"}},
+			wantError: false,
+		},
+		{
+			name:      "Added unnamed volume",
+			oldSpec:   []*infrav1.VPCVolume{{Name: "data"}},
+			spec:      []*infrav1.VPCVolume{{Name: "data"}, {SizeGiB: 10}},
+			wantError: false,
+		},
+		{
+			name:      "Expanded unnamed volume",
+			oldSpec:   []*infrav1.VPCVolume{{SizeGiB: 10}},
+			spec:      []*infrav1.VPCVolume{{SizeGiB: 20}},
+			wantError: false,
+		},
+		{
+			name:      "Removed unnamed volume",
+			oldSpec:   []*infrav1.VPCVolume{{Name: "data"}, {SizeGiB: 10}},
+			spec:      []*infrav1.VPCVolume{{Name: "data"}},
+			wantError: true,
+		},
+		{
+			name:      "Removed named volume with an unnamed volume",
+			oldSpec:   []*infrav1.VPCVolume{{Name: "data"}, {SizeGiB: 10}},
+			spec:      []*infrav1.VPCVolume{{SizeGiB: 10}},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAdditionalVolumesRemoval(infrav1.IBMVPCMachineSpec{AdditionalVolumes: tt.oldSpec}, infrav1.IBMVPCMachineSpec{AdditionalVolumes: tt.spec})
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateAdditionalVolumesRemoval() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateProfileUpdate(t *testing.T) {
+	oldObj := &infrav1.IBMVPCMachine{Spec: infrav1.IBMVPCMachineSpec{Profile: "bx2-2x8"}}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		profile     string
+		wantError   bool
+	}{
+		{
+			name:      "Unchanged profile",
+			profile:   "bx2-2x8",
+			wantError: false,
+		},
+		{
+			name:      "Changed profile without annotation",
+			profile:   "bx2-4x16",
+			wantError: true,
+		},
+		{
+			name:        "Changed profile with in-place resize disabled",
+			annotations: map[string]string{infrav1.InPlaceResizeAnnotation: "false"},
+			profile:     "bx2-4x16",
+			wantError:   true,
+		},
+		{
+			name:        "Changed profile with in-place resize allowed",
+			annotations: map[string]string{infrav1.InPlaceResizeAnnotation: "true"},
+			profile:     "bx2-4x16",
+			wantError:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newObj := &infrav1.IBMVPCMachine{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Spec:       infrav1.IBMVPCMachineSpec{Profile: tt.profile},
+			}
+			if err := validateProfileUpdate(oldObj, newObj); (err != nil) != tt.wantError {
+				t.Errorf("validateProfileUpdate() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateAdditionalNetworkInterfaces(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No additional network interfaces",
+			spec:      infrav1.IBMVPCMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Valid additional network interfaces",
+			spec: infrav1.IBMVPCMachineSpec{
+				AdditionalNetworkInterfaces: []infrav1.NetworkInterface{{Subnet: "storage-subnet"}, {Subnet: "multus-subnet"}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Missing subnet for additional network interface",
+			spec: infrav1.IBMVPCMachineSpec{
+				AdditionalNetworkInterfaces: []infrav1.NetworkInterface{{Subnet: "storage-subnet"}, {}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Reserved IP for additional network interface",
+			spec: infrav1.IBMVPCMachineSpec{
+				AdditionalNetworkInterfaces: []infrav1.NetworkInterface{{Subnet: "storage-subnet", ReservedIP: &infrav1.VPCReservedIP{Address: ptr.To("10.240.1.10")}}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAdditionalNetworkInterfaces(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateAdditionalNetworkInterfaces() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateNetworkAttachments(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "Default network attachment mode",
+			spec:      infrav1.IBMVPCMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Virtual network interface options with virtual network interface mode",
+			spec: infrav1.IBMVPCMachineSpec{
+				NetworkAttachmentMode: infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface,
+				VirtualNetworkInterface: &infrav1.VPCVirtualNetworkInterface{
+					DeletionPolicy:     infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain,
+					RetainedNamePrefix: "workers",
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Retained virtual network interfaces without name prefix",
+			spec: infrav1.IBMVPCMachineSpec{
+				NetworkAttachmentMode: infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface,
+				VirtualNetworkInterface: &infrav1.VPCVirtualNetworkInterface{
+					DeletionPolicy: infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain,
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Virtual network interface options with network interface mode",
+			spec: infrav1.IBMVPCMachineSpec{
+				NetworkAttachmentMode: infrav1.VPCNetworkAttachmentModeNetworkInterface,
+				VirtualNetworkInterface: &infrav1.VPCVirtualNetworkInterface{
+					AllowIPSpoofing: ptr.To(true),
+				},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateNetworkAttachments(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateNetworkAttachments() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateAvailabilityPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Spot instance",
+			spec: infrav1.IBMVPCMachineSpec{
+				AvailabilityPolicy: &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassSpot},
+			},
+			wantError: false,
+		},
+		{
+			name: "Standard instance on a dedicated host",
+			spec: infrav1.IBMVPCMachineSpec{
+				AvailabilityPolicy: &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassStandard, HostFailure: infrav1.VPCHostFailurePolicyStop},
+				PlacementTarget:    &infrav1.VPCMachinePlacementTarget{DedicatedHost: &infrav1.VPCResource{Name: ptr.To("dedicated-host")}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Spot instance on a dedicated host",
+			spec: infrav1.IBMVPCMachineSpec{
+				AvailabilityPolicy: &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassSpot},
+				PlacementTarget:    &infrav1.VPCMachinePlacementTarget{DedicatedHost: &infrav1.VPCResource{Name: ptr.To("dedicated-host")}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Spot instance consuming a reservation",
+			spec: infrav1.IBMVPCMachineSpec{
+				AvailabilityPolicy: &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassSpot},
+				PlacementTarget:    &infrav1.VPCMachinePlacementTarget{Reservation: &infrav1.VPCReservationTarget{Name: ptr.To("reservation")}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAvailabilityPolicy(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateAvailabilityPolicy() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateBootSource(t *testing.T) {
+	snapshotCRN := "crn:v1:bluemix:public:is:us-south-1:a/account-id::snapshot:r006-snapshot-id"
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "Boot from an image",
+			spec:      infrav1.IBMVPCMachineSpec{Image: &infrav1.IBMVPCResourceReference{Name: ptr.To("image")}},
+			wantError: false,
+		},
+		{
+			name:      "Boot from a snapshot by CRN",
+			spec:      infrav1.IBMVPCMachineSpec{SourceSnapshot: &infrav1.VPCSnapshotReference{CRN: ptr.To(snapshotCRN)}},
+			wantError: false,
+		},
+		{
+			name:      "No boot source",
+			spec:      infrav1.IBMVPCMachineSpec{},
+			wantError: true,
+		},
+		{
+			name: "Boot from a snapshot and an image",
+			spec: infrav1.IBMVPCMachineSpec{
+				Image:          &infrav1.IBMVPCResourceReference{Name: ptr.To("image")},
+				SourceSnapshot: &infrav1.VPCSnapshotReference{Name: ptr.To("snapshot")},
+			},
+			wantError: true,
+		},
+		{
+			name:      "Boot from a snapshot with an invalid CRN",
+			spec:      infrav1.IBMVPCMachineSpec{SourceSnapshot: &infrav1.VPCSnapshotReference{CRN: ptr.To("snapshot-crn")}},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateBootSource(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateBootSource() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateDefaultTrustedProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Trusted profile served by the metadata service",
+			spec: infrav1.IBMVPCMachineSpec{
+				MetadataService:       &infrav1.VPCMetadataService{Enabled: true},
+				DefaultTrustedProfile: &infrav1.VPCTrustedProfileReference{ID: ptr.To("Profile-id")},
+			},
+			wantError: false,
+		},
+		{
+			name:      "Metadata service without trusted profile",
+			spec:      infrav1.IBMVPCMachineSpec{MetadataService: &infrav1.VPCMetadataService{Enabled: false}},
+			wantError: false,
+		},
+		{
+			name: "Trusted profile with the metadata service disabled",
+			spec: infrav1.IBMVPCMachineSpec{
+				MetadataService:       &infrav1.VPCMetadataService{Enabled: false},
+				DefaultTrustedProfile: &infrav1.VPCTrustedProfileReference{ID: ptr.To("Profile-id")},
+			},
+			wantError: true,
+		},
+		{
+			name: "Trusted profile with an invalid CRN",
+			spec: infrav1.IBMVPCMachineSpec{
+				MetadataService:       &infrav1.VPCMetadataService{Enabled: true},
+				DefaultTrustedProfile: &infrav1.VPCTrustedProfileReference{CRN: ptr.To("profile-crn")},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateDefaultTrustedProfile(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateDefaultTrustedProfile() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateTemplateReservedIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No reserved IP",
+			spec:      infrav1.IBMVPCMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Reserved IP from IPAM pool",
+			spec: infrav1.IBMVPCMachineSpec{
+				PrimaryNetworkInterface: infrav1.NetworkInterface{
+					ReservedIP: &infrav1.VPCReservedIP{
+						PoolRef: &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "control-plane"},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Static reserved IP address",
+			spec: infrav1.IBMVPCMachineSpec{
+				PrimaryNetworkInterface: infrav1.NetworkInterface{
+					ReservedIP: &infrav1.VPCReservedIP{Address: ptr.To("10.240.0.10")},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Existing reserved IP",
+			spec: infrav1.IBMVPCMachineSpec{
+				PrimaryNetworkInterface: infrav1.NetworkInterface{
+					ReservedIP: &infrav1.VPCReservedIP{ID: ptr.To("reserved-ip-id")},
+				},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTemplateReservedIP(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateTemplateReservedIP() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterAdditionalTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCClusterSpec
+		wantError bool
+	}{
+		{
+			name:      "Additional tags with network",
+			spec:      infrav1.IBMVPCClusterSpec{AdditionalTags: []string{"env:test"}, Network: &infrav1.VPCNetworkSpec{}},
+			wantError: false,
+		},
+		{
+			name:      "Additional tags without network",
+			spec:      infrav1.IBMVPCClusterSpec{AdditionalTags: []string{"env:test"}},
+			wantError: true,
+		},
+		{
+			name:      "No additional tags without network",
+			spec:      infrav1.IBMVPCClusterSpec{},
+			wantError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMVPCClusterAdditionalTags(&infrav1.IBMVPCCluster{Spec: tt.spec}); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterAdditionalTags() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterFileShares(t *testing.T) {
+	fileShares := []infrav1.VPCFileShare{{Name: "share", Size: 10, Zone: "us-south-1"}}
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCClusterSpec
+		wantError bool
+	}{
+		{
+			name:      "File shares with network",
+			spec:      infrav1.IBMVPCClusterSpec{FileShares: fileShares, Network: &infrav1.VPCNetworkSpec{}},
+			wantError: false,
+		},
+		{
+			name:      "File shares without network",
+			spec:      infrav1.IBMVPCClusterSpec{FileShares: fileShares},
+			wantError: true,
+		},
+		{
+			name:      "No file shares without network",
+			spec:      infrav1.IBMVPCClusterSpec{},
+			wantError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMVPCClusterFileShares(&infrav1.IBMVPCCluster{Spec: tt.spec}); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterFileShares() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterFileSharesRemoval(t *testing.T) {
+	fileShares := []infrav1.VPCFileShare{{Name: "share", Size: 10, Zone: "us-south-1"}, {Name: "data", Size: 20, Zone: "us-south-1"}}
+	tests := []struct {
+		name          string
+		oldFileShares []infrav1.VPCFileShare
+		fileShares    []infrav1.VPCFileShare
+		wantError     bool
+	}{
+		{
+			name:          "Unchanged file shares",
+			oldFileShares: fileShares,
+			fileShares:    fileShares,
+			wantError:     false,
+		},
+		{
+			name:          "Added file share",
+			oldFileShares: fileShares[:1],
+			fileShares:    fileShares,
+			wantError:     false,
+		},
+		{
+			name:          "Removed file share",
+			oldFileShares: fileShares,
+			fileShares:    fileShares[1:],
+			wantError:     true,
+		},
+		{
+			name:          "Removed all file shares",
+			oldFileShares: fileShares,
+			wantError:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldCluster := &infrav1.IBMVPCCluster{Spec: infrav1.IBMVPCClusterSpec{FileShares: tt.oldFileShares}}
+			vpcCluster := &infrav1.IBMVPCCluster{Spec: infrav1.IBMVPCClusterSpec{FileShares: tt.fileShares}}
+			if err := validateIBMVPCClusterFileSharesRemoval(oldCluster, vpcCluster); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterFileSharesRemoval() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
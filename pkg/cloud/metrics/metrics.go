@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/prometheus/client_golang/prometheus"
+
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "capibm"
+	metricsSubsystem = "ibmcloud_api"
+
+	serviceLabel   = "service"
+	operationLabel = "operation"
+	codeLabel      = "code"
+
+	// unknownCode is the code label value of the calls for which the HTTP status code is not known,
+	// e.g. when the request failed before a response was received.
+	unknownCode = "unknown"
+)
+
+var (
+	apiCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "requests_total",
+		Help:      "Total number of IBM Cloud API calls by service, operation and HTTP status code.",
+	}, []string{serviceLabel, operationLabel, codeLabel})
+
+	apiCallErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "request_errors_total",
+		Help:      "Total number of failed IBM Cloud API calls by service, operation and HTTP status code.",
+	}, []string{serviceLabel, operationLabel, codeLabel})
+
+	apiCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the IBM Cloud API calls in seconds by service, operation and HTTP status code.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{serviceLabel, operationLabel, codeLabel})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(apiCallsTotal, apiCallErrorsTotal, apiCallDuration)
+}
+
+// statusCoder is implemented by the errors of the PowerVS client.
+type statusCoder interface {
+	Code() int
+}
+
+// statusCodeError is implemented by the request failures of the COS client.
+type statusCodeError interface {
+	StatusCode() int
+}
+
+// ObserveAPICall records a call to the operation of an IBM Cloud service which started at start.
+func ObserveAPICall(service, operation string, start time.Time, response *core.DetailedResponse, err error) {
+	code := StatusCode(response, err)
+	apiCallsTotal.WithLabelValues(service, operation, code).Inc()
+	apiCallDuration.WithLabelValues(service, operation, code).Observe(time.Since(start).Seconds())
+	if err != nil {
+		apiCallErrorsTotal.WithLabelValues(service, operation, code).Inc()
+	}
+}
+
+// StatusCode returns the HTTP status code of an IBM Cloud API call from the response if set, otherwise from the error.
+// It returns "unknown" if the status code is not available, e.g. when the request failed before a response was received.
+func StatusCode(response *core.DetailedResponse, err error) string {
+	if code := HTTPStatusCode(response, err); code != 0 {
+		return strconv.Itoa(code)
+	}
+	return unknownCode
+}
+
+// HTTPStatusCode returns the HTTP status code of an IBM Cloud API call from the response if set, otherwise from the error.
+// It returns 0 if the status code is not available.
+func HTTPStatusCode(response *core.DetailedResponse, err error) int {
+	if response != nil && response.StatusCode != 0 {
+		return response.StatusCode
+	}
+	if err == nil {
+		return 0
+	}
+
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) && httpProblem.Response != nil {
+		return httpProblem.Response.GetStatusCode()
+	}
+	var coder statusCoder
+	if errors.As(err, &coder) {
+		return coder.Code()
+	}
+	var codeErr statusCodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.StatusCode()
+	}
+	return 0
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	. "github.com/onsi/gomega"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("request failed with code %d", e.code)
+}
+
+func (e *codeError) Code() int {
+	return e.code
+}
+
+func TestObserveAPICall(t *testing.T) {
+	testCases := []struct {
+		name         string
+		operation    string
+		response     *core.DetailedResponse
+		err          error
+		expectedCode string
+		expectErrors bool
+	}{
+		{
+			name:         "Should record a successful call with the status code of the response",
+			operation:    "GetInstance",
+			response:     &core.DetailedResponse{StatusCode: 200},
+			expectedCode: "200",
+		},
+		{
+			name:         "Should record a failed call with the status code of the response",
+			operation:    "CreateInstance",
+			response:     &core.DetailedResponse{StatusCode: 429},
+			err:          errors.New("too many requests"),
+			expectedCode: "429",
+			expectErrors: true,
+		},
+		{
+			name:         "Should record a failed call with the status code of the wrapped error",
+			operation:    "DeleteInstance",
+			err:          fmt.Errorf("failed to delete instance: %w", &codeError{code: 404}),
+			expectedCode: "404",
+			expectErrors: true,
+		},
+		{
+			name:         "Should record a failed call without status code",
+			operation:    "ListInstances",
+			err:          errors.New("connection refused"),
+			expectedCode: unknownCode,
+			expectErrors: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ObserveAPICall("test", tc.operation, time.Now(), tc.response, tc.err)
+
+			g.Expect(testutil.ToFloat64(apiCallsTotal.WithLabelValues("test", tc.operation, tc.expectedCode))).To(Equal(float64(1)))
+			expectedErrors := float64(0)
+			if tc.expectErrors {
+				expectedErrors = 1
+			}
+			g.Expect(testutil.ToFloat64(apiCallErrorsTotal.WithLabelValues("test", tc.operation, tc.expectedCode))).To(Equal(expectedErrors))
+		})
+	}
+}
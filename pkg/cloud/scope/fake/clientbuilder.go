@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake implements the client builders of the scopes returning the clients of an in-memory IBM Cloud backend.
+package fake
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	powervsscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/powervs"
+	vpcscope "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/scope/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/fake"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+)
+
+// PowerVSClientBuilder builds the clients of the PowerVS scopes from a fake backend, without credentials.
+type PowerVSClientBuilder struct {
+	Backend *fake.Backend
+}
+
+var _ powervsscope.ClientBuilder = PowerVSClientBuilder{}
+
+// GetAuthenticator returns an authenticator of the account of the backend.
+func (b PowerVSClientBuilder) GetAuthenticator(_ context.Context, _ powervsscope.AuthenticatorOptions) (core.Authenticator, error) {
+	return fake.NewAuthenticator()
+}
+
+// GetPowerVSClient returns the client of the workspace in the options.
+func (b PowerVSClientBuilder) GetPowerVSClient(_ context.Context, opts powervsscope.ClientOptions) (powervs.PowerVS, error) {
+	return b.Backend.PowerVSClient(opts.WorkspaceID), nil
+}
+
+// GetVPCClient returns the VPC client of the backend.
+func (b PowerVSClientBuilder) GetVPCClient(_ context.Context, _ powervsscope.ClientOptions) (vpc.Vpc, error) {
+	return b.Backend.VPCClient(), nil
+}
+
+// GetTransitGatewayClient returns the transit gateway client of the backend.
+func (b PowerVSClientBuilder) GetTransitGatewayClient(_ context.Context, _ powervsscope.ClientOptions) (transitgateway.TransitGateway, error) {
+	return b.Backend.TransitGatewayClient(), nil
+}
+
+// GetResourceControllerClient returns the resource controller client of the backend.
+func (b PowerVSClientBuilder) GetResourceControllerClient(_ context.Context, _ powervsscope.ClientOptions) (resourcecontroller.ResourceController, error) {
+	return b.Backend.ResourceControllerClient(), nil
+}
+
+// GetResourceManagerClient returns the resource manager client of the backend.
+func (b PowerVSClientBuilder) GetResourceManagerClient(_ context.Context, _ powervsscope.ClientOptions) (resourcemanager.ResourceManager, error) {
+	return b.Backend.ResourceManagerClient(), nil
+}
+
+// GetCOSClient returns the COS client of the bucket region in the options.
+func (b PowerVSClientBuilder) GetCOSClient(_ context.Context, opts powervsscope.COSClientOptions) (cos.Cos, error) {
+	return b.Backend.COSClient(opts.BucketRegion), nil
+}
+
+// VPCClientBuilder builds the clients of the VPC scopes from a fake backend, without credentials.
+type VPCClientBuilder struct {
+	Backend *fake.Backend
+}
+
+var _ vpcscope.ClientBuilder = VPCClientBuilder{}
+
+// GetAuthenticator returns an authenticator of the account of the backend.
+func (b VPCClientBuilder) GetAuthenticator(_ context.Context, _ vpcscope.AuthenticatorOptions) (core.Authenticator, error) {
+	return fake.NewAuthenticator()
+}
+
+// GetVPCClient returns the VPC client of the backend.
+func (b VPCClientBuilder) GetVPCClient(_ context.Context, _ vpcscope.ClientOptions) (vpc.Vpc, error) {
+	return b.Backend.VPCClient(), nil
+}
+
+// GetGlobalTaggingClient returns the global tagging client of the backend.
+func (b VPCClientBuilder) GetGlobalTaggingClient(_ context.Context, _ vpcscope.ClientOptions) (globaltagging.GlobalTagging, error) {
+	return b.Backend.GlobalTaggingClient(), nil
+}
+
+// GetResourceControllerClient returns the resource controller client of the backend.
+func (b VPCClientBuilder) GetResourceControllerClient(_ context.Context, _ vpcscope.ClientOptions) (resourcecontroller.ResourceController, error) {
+	return b.Backend.ResourceControllerClient(), nil
+}
+
+// GetResourceManagerClient returns the resource manager client of the backend.
+func (b VPCClientBuilder) GetResourceManagerClient(_ context.Context, _ vpcscope.ClientOptions) (resourcemanager.ResourceManager, error) {
+	return b.Backend.ResourceManagerClient(), nil
+}
+
+// GetCOSClient returns the COS client of the bucket region in the options.
+func (b VPCClientBuilder) GetCOSClient(_ context.Context, opts vpcscope.COSClientOptions) (cos.Cos, error) {
+	return b.Backend.COSClient(opts.BucketRegion), nil
+}
@@ -45,7 +45,7 @@ type stubClientBuilder struct {
 	rmClient      resourcemanager.ResourceManager
 }
 
-func (s stubClientBuilder) GetAuthenticator(_ context.Context) (core.Authenticator, error) {
+func (s stubClientBuilder) GetAuthenticator(_ context.Context, _ AuthenticatorOptions) (core.Authenticator, error) {
 	return nil, nil
 }
 func (s stubClientBuilder) GetPowerVSClient(_ context.Context, _ ClientOptions) (powervs.PowerVS, error) {
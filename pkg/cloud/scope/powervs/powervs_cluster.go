@@ -60,6 +60,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
 )
 
 // vpcSubnetIPVersion4 defines the IP v4 string used for VPC Subnet generation.
@@ -104,11 +105,21 @@ type ClientOptions struct {
 // Status fields (COS instance ID, bucket region) that are not known at scope
 // construction time for non-machine scopes.
 type COSClientOptions struct {
+	Authenticator   core.Authenticator
 	InstanceID      string
 	BucketRegion    string
 	ServiceEndpoint []endpoints.ServiceEndpoint
 }
 
+// AuthenticatorOptions carries the parameters needed to construct an authenticator.
+// When IdentityRef is set, the credentials are read from the referenced IBMCloudClusterIdentity,
+// otherwise the default env/file credentials are used.
+type AuthenticatorOptions struct {
+	Client      client.Client
+	Namespace   string
+	IdentityRef infrav1.IBMCloudClusterIdentityReference
+}
+
 // ClusterScopeParams defines the input parameters used to create a new ClusterScope.
 type ClusterScopeParams struct {
 	Client            client.Client
@@ -131,6 +142,9 @@ type ClusterScope struct {
 	COSClient             cos.Cos
 	ResourceManagerClient resourcemanager.ResourceManager
 
+	// Authenticator is the authenticator used to build the IBM Cloud clients.
+	Authenticator core.Authenticator
+
 	// ClientBuilder is retained so that deferred client construction
 	// (e.g. setupCOSClient) can use the same injected builder rather than
 	// hard-coding the production implementation.
@@ -145,7 +159,7 @@ type ClusterScope struct {
 // ClientBuilder defines the contract for constructing IBM Cloud service clients.
 // This interface enables clean dependency injection and robust mocking for tests.
 type ClientBuilder interface {
-	GetAuthenticator(ctx context.Context) (core.Authenticator, error)
+	GetAuthenticator(ctx context.Context, options AuthenticatorOptions) (core.Authenticator, error)
 	GetPowerVSClient(ctx context.Context, options ClientOptions) (powervs.PowerVS, error)
 	GetVPCClient(ctx context.Context, options ClientOptions) (vpc.Vpc, error)
 	GetTransitGatewayClient(ctx context.Context, options ClientOptions) (transitgateway.TransitGateway, error)
@@ -157,9 +171,13 @@ type ClientBuilder interface {
 // ProdClientBuilder is the production implementation of the ClientBuilder interface.
 type ProdClientBuilder struct{}
 
-// GetAuthenticator returns an IBM Cloud authenticator using default env/file credentials.
-func (b ProdClientBuilder) GetAuthenticator(_ context.Context) (core.Authenticator, error) {
-	return authenticator.GetAuthenticator()
+// GetAuthenticator returns an IBM Cloud authenticator using the credentials of the referenced
+// IBMCloudClusterIdentity, or the default env/file credentials if no identity is referenced.
+func (b ProdClientBuilder) GetAuthenticator(ctx context.Context, opts AuthenticatorOptions) (core.Authenticator, error) {
+	if opts.IdentityRef.Name == "" {
+		return authenticator.GetAuthenticator()
+	}
+	return authenticator.GetAuthenticatorFromIdentity(ctx, opts.Client, opts.IdentityRef.Name, opts.Namespace)
 }
 
 // GetPowerVSClient constructs a production PowerVS client for the given options.
@@ -185,7 +203,7 @@ func (b ProdClientBuilder) GetPowerVSClient(ctx context.Context, opts ClientOpti
 }
 
 // GetVPCClient constructs a production VPC client for the given options.
-func (b ProdClientBuilder) GetVPCClient(_ context.Context, opts ClientOptions) (vpc.Vpc, error) {
+func (b ProdClientBuilder) GetVPCClient(ctx context.Context, opts ClientOptions) (vpc.Vpc, error) {
 	if opts.Debug {
 		core.SetLoggingLevel(core.LevelDebug)
 	}
@@ -195,7 +213,12 @@ func (b ProdClientBuilder) GetVPCClient(_ context.Context, opts ClientOptions) (
 	}
 
 	svcEndpoint := endpoints.FetchVPCEndpoint(opts.VPCRegion, opts.ServiceEndpoint)
-	return vpc.NewService(svcEndpoint)
+	return vpc.NewService(ctx, vpc.ServiceOptions{
+		VpcV1Options: &vpcv1.VpcV1Options{
+			Authenticator: opts.Authenticator,
+			URL:           svcEndpoint,
+		},
+	})
 }
 
 // GetTransitGatewayClient constructs a production Transit Gateway client for the given options.
@@ -212,7 +235,7 @@ func (b ProdClientBuilder) GetTransitGatewayClient(ctx context.Context, opts Cli
 		tgOptions.URL = tgServiceEndpoint
 	}
 
-	return transitgateway.NewService(tgOptions)
+	return transitgateway.NewService(ctx, tgOptions)
 }
 
 // GetResourceControllerClient constructs a production Resource Controller client for the given options.
@@ -231,7 +254,7 @@ func (b ProdClientBuilder) GetResourceControllerClient(ctx context.Context, opts
 		rcOptions.URL = rcEndpoint
 	}
 
-	return resourcecontroller.NewService(rcOptions)
+	return resourcecontroller.NewService(ctx, rcOptions)
 }
 
 // GetResourceManagerClient constructs a production Resource Manager client for the given options.
@@ -248,20 +271,20 @@ func (b ProdClientBuilder) GetResourceManagerClient(ctx context.Context, opts Cl
 		rmOptions.URL = rmEndpoint
 	}
 
-	return resourcemanager.NewService(rmOptions)
+	return resourcemanager.NewService(ctx, rmOptions)
 }
 
 // GetCOSClient constructs a production COS client for the given options.
 func (b ProdClientBuilder) GetCOSClient(ctx context.Context, opts COSClientOptions) (cos.Cos, error) {
 	log := ctrl.LoggerFrom(ctx)
 
-	props, err := authenticator.GetProperties()
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch service properties: %w", err)
-	}
-	apiKey := props["APIKEY"]
-	if apiKey == "" {
-		return nil, fmt.Errorf("IBM Cloud API key is not provided, set IBMCLOUD_API_KEY environmental variable")
+	auth := opts.Authenticator
+	if auth == nil {
+		var err error
+		auth, err = authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
 	}
 
 	serviceEndpoint := fmt.Sprintf("s3.%s.%s", opts.BucketRegion, cosURLDomain)
@@ -281,7 +304,12 @@ func (b ProdClientBuilder) GetCOSClient(ctx context.Context, opts COSClientOptio
 		},
 	}
 
-	return cos.NewService(cosOptions, apiKey, opts.InstanceID)
+	// Exchange the API key directly when available, otherwise request the IAM access tokens from the authenticator,
+	// e.g. when authenticating as a trusted profile with a compute resource token.
+	if iamAuth, ok := auth.(*core.IamAuthenticator); ok && iamAuth.ApiKey != "" {
+		return cos.NewService(ctx, cosOptions, iamAuth.ApiKey, opts.InstanceID)
+	}
+	return cos.NewServiceWithAuthenticator(ctx, cosOptions, auth, opts.InstanceID)
 }
 
 // NewPowerVSClusterScope creates a new ClusterScope from the supplied parameters.
@@ -342,10 +370,15 @@ func (s *ClusterScope) initClients(ctx context.Context, params *ClusterScopePara
 	log := ctrl.LoggerFrom(ctx)
 
 	// Build the authenticator
-	auth, err := params.ClientBuilder.GetAuthenticator(ctx)
+	auth, err := params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{
+		Client:      s.Client,
+		Namespace:   s.IBMPowerVSCluster.Namespace,
+		IdentityRef: s.IBMPowerVSCluster.Spec.IdentityRef,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create authenticator: %w", err)
 	}
+	s.Authenticator = auth
 
 	// Build the unified ClientOptions
 	opts := ClientOptions{
@@ -402,6 +435,20 @@ func (s *ClusterScope) Close() error {
 	return s.PatchObject()
 }
 
+// Fork returns a context holding a new flow of reconciliation phases and a copy of the scope with its IBM Cloud clients
+// bound to it, to reconcile resources concurrently with the other phases of ctx while recording their API calls
+// under their own phases.
+func (s *ClusterScope) Fork(ctx context.Context) (context.Context, *ClusterScope) {
+	ctx = tracing.Fork(ctx)
+	scope := *s
+	scope.IBMVPCClient = tracing.Bind(ctx, s.IBMVPCClient)
+	scope.TransitGatewayClient = tracing.Bind(ctx, s.TransitGatewayClient)
+	scope.ResourceClient = tracing.Bind(ctx, s.ResourceClient)
+	scope.COSClient = tracing.Bind(ctx, s.COSClient)
+	scope.ResourceManagerClient = tracing.Bind(ctx, s.ResourceManagerClient)
+	return ctx, &scope
+}
+
 // Name returns the CAPI cluster name.
 func (s *ClusterScope) Name() string {
 	return s.Cluster.Name
@@ -605,9 +652,13 @@ func (s *ClusterScope) resolveResourceGroupIDByName() (string, error) {
 		return "", fmt.Errorf("resource group name is not set in the spec")
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return "", fmt.Errorf("failed to get authenticator: %w", err)
+	auth := s.Authenticator
+	if auth == nil {
+		var err error
+		auth, err = authenticator.GetAuthenticator()
+		if err != nil {
+			return "", fmt.Errorf("failed to get authenticator: %w", err)
+		}
 	}
 
 	account, err := accounts.GetAccount(auth)
@@ -2688,6 +2739,7 @@ func (s *ClusterScope) setupCOSClient(ctx context.Context, instanceID, bucketReg
 	}
 
 	cosClient, err := s.ClientBuilder.GetCOSClient(ctx, COSClientOptions{
+		Authenticator:   s.Authenticator,
 		InstanceID:      instanceID,
 		BucketRegion:    bucketRegion,
 		ServiceEndpoint: s.ServiceEndpoint,
@@ -49,6 +49,7 @@ type ImageScopeParams struct {
 	Client          client.Client
 	IBMPowerVSImage *infrav1.IBMPowerVSImage
 	Zone            string
+	IdentityRef     infrav1.IBMCloudClusterIdentityReference
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	ClientBuilder   ClientBuilder
 }
@@ -101,7 +102,11 @@ func (p *ImageScopeParams) validate() error {
 func (s *ImageScope) initClients(ctx context.Context, params *ImageScopeParams) error {
 	log := ctrl.LoggerFrom(ctx)
 
-	auth, err := params.ClientBuilder.GetAuthenticator(ctx)
+	auth, err := params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{
+		Client:      s.Client,
+		Namespace:   s.IBMPowerVSImage.Namespace,
+		IdentityRef: params.IdentityRef,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create authenticator: %w", err)
 	}
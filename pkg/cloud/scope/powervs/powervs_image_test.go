@@ -61,7 +61,7 @@ func newIBMPowerVSImage(name string) *infrav1.IBMPowerVSImage {
 // errAuthBuilder is a ClientBuilder whose GetAuthenticator always fails.
 type errAuthBuilder struct{ stubClientBuilder }
 
-func (e errAuthBuilder) GetAuthenticator(_ context.Context) (core.Authenticator, error) {
+func (e errAuthBuilder) GetAuthenticator(_ context.Context, _ AuthenticatorOptions) (core.Authenticator, error) {
 	return nil, errors.New("authenticator error")
 }
 
@@ -187,7 +187,11 @@ func (p *MachineScopeParams) validate() error {
 func (s *MachineScope) initClients(ctx context.Context, params *MachineScopeParams) error {
 	log := ctrl.LoggerFrom(ctx)
 
-	auth, err := params.ClientBuilder.GetAuthenticator(ctx)
+	auth, err := params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{
+		Client:      s.Client,
+		Namespace:   s.IBMPowerVSMachine.Namespace,
+		IdentityRef: s.IBMPowerVSCluster.Spec.IdentityRef,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create authenticator: %w", err)
 	}
@@ -243,6 +247,7 @@ func (s *MachineScope) initClients(ctx context.Context, params *MachineScopePara
 	if s.useIgnition() {
 		cosStatus := s.IBMPowerVSCluster.Status.COSInstance
 		s.COSClient, err = params.ClientBuilder.GetCOSClient(ctx, COSClientOptions{
+			Authenticator:   auth,
 			InstanceID:      cosStatus.ID,
 			BucketRegion:    cosStatus.BucketRegion,
 			ServiceEndpoint: s.ServiceEndpoint,
@@ -974,7 +979,7 @@ func (s *MachineScope) createCOSClient(ctx context.Context) (cos.Cos, error) {
 		},
 	}
 
-	cosClient, err := cos.NewServiceWithHMAC(cosOptions, accessKeyID, secretAccessKey)
+	cosClient, err := cos.NewServiceWithHMAC(ctx, cosOptions, accessKeyID, secretAccessKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HMAC COS client: %w", err)
 	}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+)
+
+// ClientBuilder defines the contract for constructing the IBM Cloud service clients of the VPC scopes.
+// It allows the reconcilers to be run against another backend than IBM Cloud, e.g. in tests.
+type ClientBuilder interface {
+	GetAuthenticator(ctx context.Context, options AuthenticatorOptions) (core.Authenticator, error)
+	GetVPCClient(ctx context.Context, options ClientOptions) (vpc.Vpc, error)
+	GetGlobalTaggingClient(ctx context.Context, options ClientOptions) (globaltagging.GlobalTagging, error)
+	GetResourceControllerClient(ctx context.Context, options ClientOptions) (resourcecontroller.ResourceController, error)
+	GetResourceManagerClient(ctx context.Context, options ClientOptions) (resourcemanager.ResourceManager, error)
+	GetCOSClient(ctx context.Context, options COSClientOptions) (cos.Cos, error)
+}
+
+// AuthenticatorOptions carries the parameters needed to construct an authenticator.
+// The credentials of the IBMCloudClusterIdentity referenced by the cluster are used if set,
+// otherwise the default env/file credentials.
+type AuthenticatorOptions struct {
+	Client        client.Client
+	IBMVPCCluster *infrav1.IBMVPCCluster
+}
+
+// ClientOptions carries the parameters needed to construct the IBM Cloud service clients.
+type ClientOptions struct {
+	Authenticator   core.Authenticator
+	Region          string
+	ServiceEndpoint []endpoints.ServiceEndpoint
+}
+
+// COSClientOptions carries the parameters needed to construct a COS client authenticated with HMAC credentials.
+type COSClientOptions struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	BucketRegion    string
+	ServiceEndpoint []endpoints.ServiceEndpoint
+}
+
+// ProdClientBuilder is the production implementation of the ClientBuilder interface.
+type ProdClientBuilder struct{}
+
+// GetAuthenticator returns an IBM Cloud authenticator using the credentials of the IBMCloudClusterIdentity
+// referenced by the cluster, or the default env/file credentials if no identity is referenced.
+func (b ProdClientBuilder) GetAuthenticator(ctx context.Context, opts AuthenticatorOptions) (core.Authenticator, error) {
+	return getAuthenticator(ctx, opts.Client, opts.IBMVPCCluster)
+}
+
+// GetVPCClient constructs a production VPC client for the given options.
+func (b ProdClientBuilder) GetVPCClient(ctx context.Context, opts ClientOptions) (vpc.Vpc, error) {
+	svcEndpoint := endpoints.FetchVPCEndpoint(opts.Region, opts.ServiceEndpoint)
+	return vpc.NewService(ctx, vpc.ServiceOptions{
+		VpcV1Options: &vpcv1.VpcV1Options{
+			Authenticator: opts.Authenticator,
+			URL:           svcEndpoint,
+		},
+	})
+}
+
+// GetGlobalTaggingClient constructs a production Global Tagging client for the given options.
+func (b ProdClientBuilder) GetGlobalTaggingClient(ctx context.Context, opts ClientOptions) (globaltagging.GlobalTagging, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	gtOptions := globaltagging.ServiceOptions{
+		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
+			Authenticator: opts.Authenticator,
+		},
+	}
+	if gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), opts.ServiceEndpoint); gtEndpoint != "" {
+		log.V(3).Info("Overriding the default global tagging endpoint", "GlobalTaggingEndpoint", gtEndpoint)
+		gtOptions.URL = gtEndpoint
+	}
+	return globaltagging.NewService(ctx, gtOptions)
+}
+
+// GetResourceControllerClient constructs a production Resource Controller client for the given options.
+func (b ProdClientBuilder) GetResourceControllerClient(ctx context.Context, opts ClientOptions) (resourcecontroller.ResourceController, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	rcOptions := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: opts.Authenticator,
+		},
+	}
+	if rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), opts.ServiceEndpoint); rcEndpoint != "" {
+		log.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
+		rcOptions.URL = rcEndpoint
+	}
+	return resourcecontroller.NewService(ctx, rcOptions)
+}
+
+// GetResourceManagerClient constructs a production Resource Manager client for the given options.
+func (b ProdClientBuilder) GetResourceManagerClient(ctx context.Context, opts ClientOptions) (resourcemanager.ResourceManager, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	rmOptions := &resourcemanagerv2.ResourceManagerV2Options{
+		Authenticator: opts.Authenticator,
+	}
+	if rmEndpoint := endpoints.FetchEndpoints(string(endpoints.RM), opts.ServiceEndpoint); rmEndpoint != "" {
+		log.V(3).Info("Overriding the default resource manager endpoint", "ResourceManagerEndpoint", rmEndpoint)
+		rmOptions.URL = rmEndpoint
+	}
+	return resourcemanager.NewService(ctx, rmOptions)
+}
+
+// GetCOSClient constructs a production COS client authenticated with the HMAC credentials of the options.
+func (b ProdClientBuilder) GetCOSClient(ctx context.Context, opts COSClientOptions) (cos.Cos, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	serviceEndpoint := fmt.Sprintf("s3.%s.%s", opts.BucketRegion, cosURLDomain)
+	if cosServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.COS), opts.ServiceEndpoint); cosServiceEndpoint != "" {
+		log.V(3).Info("Overriding the default COS endpoint", "cosEndpoint", cosServiceEndpoint)
+		serviceEndpoint = cosServiceEndpoint
+	}
+
+	cosOptions := cos.ServiceOptions{
+		Options: &cosSession.Options{
+			Config: aws.Config{
+				Endpoint: ptr.To(serviceEndpoint),
+				Region:   ptr.To(opts.BucketRegion),
+			},
+		},
+	}
+	return cos.NewServiceWithHMAC(ctx, cosOptions, opts.AccessKeyID, opts.SecretAccessKey)
+}
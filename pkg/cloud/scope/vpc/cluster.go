@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
 
@@ -37,6 +38,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/pagingutils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 )
@@ -56,6 +58,9 @@ type ClusterScopeParams struct {
 	Cluster         *clusterv1.Cluster
 	IBMVPCCluster   *infrav1.IBMVPCCluster
 	ServiceEndpoint []endpoints.ServiceEndpoint
+
+	// ClientBuilder constructs the IBM Cloud clients, ProdClientBuilder is used if not set.
+	ClientBuilder ClientBuilder
 }
 
 // ClusterScope defines a scope defined around a cluster.
@@ -64,14 +69,18 @@ type ClusterScope struct {
 	Client      client.Client
 	patchHelper *v1beta1patch.Helper
 
-	IBMVPCClient    vpc.Vpc
-	Cluster         *clusterv1.Cluster
-	IBMVPCCluster   *infrav1.IBMVPCCluster
-	ServiceEndpoint []endpoints.ServiceEndpoint
+	IBMVPCClient        vpc.Vpc
+	GlobalTaggingClient globaltagging.GlobalTagging
+	Cluster             *clusterv1.Cluster
+	IBMVPCCluster       *infrav1.IBMVPCCluster
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// Authenticator is the authenticator used to build the IBM Cloud clients.
+	Authenticator core.Authenticator
 }
 
 // NewClusterScope creates a new ClusterScopeV2 from the supplied parameters.
-func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
+func NewClusterScope(ctx context.Context, params ClusterScopeParams) (*ClusterScope, error) {
 	if params.Cluster == nil {
 		return nil, errors.New("failed to generate new scope from nil Cluster")
 	}
@@ -88,25 +97,43 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		return nil, fmt.Errorf("failed to init patch helper: %w", err)
 	}
 
-	// Fetch the service endpoint.
-	svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
+	if params.ClientBuilder == nil {
+		params.ClientBuilder = ProdClientBuilder{}
+	}
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	auth, err := params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{Client: params.Client, IBMVPCCluster: params.IBMVPCCluster})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	clientOptions := ClientOptions{
+		Authenticator:   auth,
+		Region:          params.IBMVPCCluster.Spec.Region,
+		ServiceEndpoint: params.ServiceEndpoint,
+	}
+	vpcClient, err := params.ClientBuilder.GetVPCClient(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
 
+	globalTaggingClient, err := params.ClientBuilder.GetGlobalTaggingClient(ctx, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
+	}
+
 	if params.Logger.V(DEBUGLEVEL).Enabled() {
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
 	return &ClusterScope{
-		Logger:        params.Logger,
-		Client:        params.Client,
-		IBMVPCClient:  vpcClient,
-		Cluster:       params.Cluster,
-		IBMVPCCluster: params.IBMVPCCluster,
-		patchHelper:   helper,
+		Logger:              params.Logger,
+		Client:              params.Client,
+		IBMVPCClient:        vpcClient,
+		GlobalTaggingClient: globalTaggingClient,
+		Cluster:             params.Cluster,
+		IBMVPCCluster:       params.IBMVPCCluster,
+		Authenticator:       auth,
+		patchHelper:         helper,
 	}, nil
 }
 
@@ -339,6 +366,45 @@ func (s *ClusterScope) ensureSubnetUnique(subnetName string) (*vpcv1.Subnet, err
 	return subnet, nil
 }
 
+// DeleteRetainedVirtualNetworkInterfaces deletes the unattached virtual network interfaces in the subnet retained by the
+// machines of the cluster and tagged with it, which would otherwise prevent the deletion of the subnet. It returns
+// whether any of them is being deleted.
+func (s *ClusterScope) DeleteRetainedVirtualNetworkInterfaces(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.IBMVPCCluster.Status.Subnet.ID == nil {
+		return false, nil
+	}
+
+	vnis, err := s.IBMVPCClient.ListVirtualNetworkInterfacesBySubnet(*s.IBMVPCCluster.Status.Subnet.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list virtual network interfaces in subnet %s: %w", *s.IBMVPCCluster.Status.Subnet.ID, err)
+	}
+	deleting := false
+	for _, vni := range vnis {
+		if vni.Target != nil || vni.CRN == nil || vni.AutoDelete == nil || *vni.AutoDelete {
+			continue
+		}
+		tags, err := s.GlobalTaggingClient.GetAttachedTags(*vni.CRN)
+		if err != nil {
+			return false, fmt.Errorf("failed to get tags of virtual network interface %s: %w", *vni.ID, err)
+		}
+		if !slices.Contains(tags, s.IBMVPCCluster.Name) {
+			continue
+		}
+		deleting = true
+		if vni.LifecycleState != nil && *vni.LifecycleState == vpcv1.VirtualNetworkInterfaceLifecycleStateDeletingConst {
+			continue
+		}
+		log.Info("Deleting retained virtual network interface", "id", *vni.ID)
+		if _, _, err := s.IBMVPCClient.DeleteVirtualNetworkInterfaces(&vpcv1.DeleteVirtualNetworkInterfacesOptions{ID: vni.ID}); err != nil {
+			record.Warnf(s.IBMVPCCluster, "FailedDeleteVirtualNetworkInterface", "Failed virtual network interface deletion - %v", err)
+			return false, fmt.Errorf("failed to delete virtual network interface %s: %w", *vni.ID, err)
+		}
+		record.Eventf(s.IBMVPCCluster, "SuccessfulDeleteVirtualNetworkInterface", "Deleted virtual network interface %q", *vni.ID)
+	}
+	return deleting, nil
+}
+
 // DeleteSubnet deletes a subnet associated with subnet id.
 func (s *ClusterScope) DeleteSubnet(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx)
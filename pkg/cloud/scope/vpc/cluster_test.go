@@ -27,10 +27,12 @@ import (
 
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
 	. "github.com/onsi/gomega"
@@ -84,7 +86,7 @@ func TestNewClusterScope(t *testing.T) {
 	for _, tc := range testCases {
 		g := NewWithT(t)
 		t.Run(tc.name, func(_ *testing.T) {
-			_, err := NewClusterScope(tc.params)
+			_, err := NewClusterScope(ctx, tc.params)
 			// Note: only error/failure cases covered
 			// TO-DO: cover success cases
 			g.Expect(err).To(Not(BeNil()))
@@ -429,6 +431,75 @@ func TestCreateSubnet(t *testing.T) {
 	})
 }
 
+func TestDeleteRetainedVirtualNetworkInterfaces(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, mock.NewMockVpc(mockController), gtmock.NewMockGlobalTagging(mockController)
+	}
+
+	vpcCluster := infrav1.IBMVPCCluster{
+		Status: infrav1.IBMVPCClusterStatus{
+			Subnet: infrav1.Subnet{
+				ID: core.StringPtr("foo-vpc-subnet-id"),
+			},
+		},
+	}
+
+	t.Run("Should delete the retained virtual network interfaces tagged with the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupClusterScope(clusterName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCCluster.Status = vpcCluster.Status
+		mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("foo-vpc-subnet-id").Return([]vpcv1.VirtualNetworkInterface{
+			{ID: ptr.To("attached-vni-id"), CRN: ptr.To("attached-vni-crn"), AutoDelete: ptr.To(false), Target: &vpcv1.VirtualNetworkInterfaceTarget{ID: ptr.To("attachment-id")}},
+			{ID: ptr.To("auto-delete-vni-id"), CRN: ptr.To("auto-delete-vni-crn"), AutoDelete: ptr.To(true)},
+			{ID: ptr.To("other-vni-id"), CRN: ptr.To("other-vni-crn"), AutoDelete: ptr.To(false)},
+			{ID: ptr.To("vni-id"), CRN: ptr.To("vni-crn"), AutoDelete: ptr.To(false)},
+		}, nil)
+		mockgt.EXPECT().GetAttachedTags("other-vni-crn").Return([]string{"other-cluster"}, nil)
+		mockgt.EXPECT().GetAttachedTags("vni-crn").Return([]string{clusterName}, nil)
+		mockvpc.EXPECT().DeleteVirtualNetworkInterfaces(&vpcv1.DeleteVirtualNetworkInterfacesOptions{ID: ptr.To("vni-id")}).Return(&vpcv1.VirtualNetworkInterface{}, &core.DetailedResponse{}, nil)
+		deleting, err := scope.DeleteRetainedVirtualNetworkInterfaces(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(deleting).To(BeTrue())
+	})
+
+	t.Run("Should not delete virtual network interfaces not retained by the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupClusterScope(clusterName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCCluster.Status = vpcCluster.Status
+		mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("foo-vpc-subnet-id").Return([]vpcv1.VirtualNetworkInterface{
+			{ID: ptr.To("other-vni-id"), CRN: ptr.To("other-vni-crn"), AutoDelete: ptr.To(false)},
+		}, nil)
+		mockgt.EXPECT().GetAttachedTags("other-vni-crn").Return(nil, nil)
+		deleting, err := scope.DeleteRetainedVirtualNetworkInterfaces(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(deleting).To(BeFalse())
+	})
+
+	t.Run("Should return error when deleting a retained virtual network interface fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupClusterScope(clusterName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCCluster.Status = vpcCluster.Status
+		mockvpc.EXPECT().ListVirtualNetworkInterfacesBySubnet("foo-vpc-subnet-id").Return([]vpcv1.VirtualNetworkInterface{
+			{ID: ptr.To("vni-id"), CRN: ptr.To("vni-crn"), AutoDelete: ptr.To(false)},
+		}, nil)
+		mockgt.EXPECT().GetAttachedTags("vni-crn").Return([]string{clusterName}, nil)
+		mockvpc.EXPECT().DeleteVirtualNetworkInterfaces(gomock.AssignableToTypeOf(&vpcv1.DeleteVirtualNetworkInterfacesOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to delete virtual network interface"))
+		_, err := scope.DeleteRetainedVirtualNetworkInterfaces(ctx)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
 func TestDeleteSubnet(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
@@ -23,12 +23,12 @@ import (
 	"net/http"
 	"reflect"
 	"regexp"
+	"slices"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
-	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
@@ -38,12 +38,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
@@ -81,6 +81,9 @@ type ClusterScopeParamsV2 struct {
 	ServiceEndpoint []endpoints.ServiceEndpoint
 
 	IBMVPCClient vpc.Vpc
+
+	// ClientBuilder constructs the IBM Cloud clients, ProdClientBuilder is used if not set.
+	ClientBuilder ClientBuilder
 }
 
 // ClusterScopeV2 defines a scope defined around a VPC Cluster for the V2 workflow.
@@ -98,10 +101,13 @@ type ClusterScopeV2 struct {
 	Cluster         *clusterv1.Cluster
 	IBMVPCCluster   *infrav1.IBMVPCCluster
 	ServiceEndpoint []endpoints.ServiceEndpoint
+
+	// Authenticator is the authenticator used to build the IBM Cloud clients.
+	Authenticator core.Authenticator
 }
 
 // NewClusterScopeV2 creates a new ClusterScopeV2 from the supplied parameters.
-func NewClusterScopeV2(params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
+func NewClusterScopeV2(ctx context.Context, params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
 	if params.Client == nil {
 		err := errors.New("error failed to generate new scope from nil Client")
 		return nil, err
@@ -123,8 +129,21 @@ func NewClusterScopeV2(params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
 		return nil, fmt.Errorf("error failed to init patch helper: %w", err)
 	}
 
-	vpcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
-	vpcClient, err := vpc.NewService(vpcEndpoint)
+	if params.ClientBuilder == nil {
+		params.ClientBuilder = ProdClientBuilder{}
+	}
+
+	auth, err := params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{Client: params.Client, IBMVPCCluster: params.IBMVPCCluster})
+	if err != nil {
+		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
+	}
+
+	clientOptions := ClientOptions{
+		Authenticator:   auth,
+		Region:          params.IBMVPCCluster.Spec.Region,
+		ServiceEndpoint: params.ServiceEndpoint,
+	}
+	vpcClient, err := params.ClientBuilder.GetVPCClient(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("error failed to create IBM VPC client: %w", err)
 	}
@@ -137,53 +156,17 @@ func NewClusterScopeV2(params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
-	}
-
-	// Create Global Tagging client.
-	gtOptions := globaltagging.ServiceOptions{
-		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
-			Authenticator: auth,
-		},
-	}
-	// Override the global tagging endpoint if provided.
-	if gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), params.ServiceEndpoint); gtEndpoint != "" {
-		gtOptions.URL = gtEndpoint
-		params.Logger.V(3).Info("Overriding the default global tagging endpoint", "GlobaTaggingEndpoint", gtEndpoint)
-	}
-	globalTaggingClient, err := globaltagging.NewService(gtOptions)
+	globalTaggingClient, err := params.ClientBuilder.GetGlobalTaggingClient(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
 	}
 
-	// Create Resource Controller client.
-	rcOptions := resourcecontroller.ServiceOptions{
-		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
-			Authenticator: auth,
-		},
-	}
-	// Override the resource controller endpoint if provided.
-	if rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint); rcEndpoint != "" {
-		rcOptions.URL = rcEndpoint
-		params.Logger.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
-	}
-	resourceControllerClient, err := resourcecontroller.NewService(rcOptions)
+	resourceControllerClient, err := params.ClientBuilder.GetResourceControllerClient(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource controller client: %w", err)
 	}
 
-	// Create Resource Manager client.
-	rmOptions := &resourcemanagerv2.ResourceManagerV2Options{
-		Authenticator: auth,
-	}
-	// Override the ResourceManager endpoint if provided.
-	if rmEndpoint := endpoints.FetchEndpoints(string(endpoints.RM), params.ServiceEndpoint); rmEndpoint != "" {
-		rmOptions.URL = rmEndpoint
-		params.Logger.V(3).Info("Overriding the default resource manager endpoint", "ResourceManagerEndpoint", rmEndpoint)
-	}
-	resourceManagerClient, err := resourcemanager.NewService(rmOptions)
+	resourceManagerClient, err := params.ClientBuilder.GetResourceManagerClient(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource manager client: %w", err)
 	}
@@ -199,6 +182,7 @@ func NewClusterScopeV2(params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
 		ResourceControllerClient: resourceControllerClient,
 		ResourceManagerClient:    resourceManagerClient,
 		VPCClient:                vpcClient,
+		Authenticator:            auth,
 	}
 	return clusterScope, nil
 }
@@ -637,7 +621,7 @@ func (s *ClusterScopeV2) TagResource(tagName string, resourceCRN string) error {
 			ResourceID: ptr.To(resourceCRN),
 		},
 	})
-	tagOptions.SetTagName(tagName)
+	tagOptions.SetTagNames(mergeTags([]string{tagName}, s.AdditionalTags()))
 	tagOptions.SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
 
 	if _, _, err = s.GlobalTaggingClient.AttachTag(tagOptions); err != nil {
@@ -647,6 +631,110 @@ func (s *ClusterScopeV2) TagResource(tagName string, resourceCRN string) error {
 	return nil
 }
 
+// AdditionalTags returns the additional user tags of the cluster.
+func (s *ClusterScopeV2) AdditionalTags() []string {
+	return mergeTags(s.IBMVPCCluster.Spec.AdditionalTags)
+}
+
+// ReconcileAdditionalTags attaches the additional tags to the resources created for the cluster, and detaches the
+// tags removed from the spec, when they changed. Resources created afterwards are tagged on creation.
+func (s *ClusterScopeV2) ReconcileAdditionalTags(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	tags := s.AdditionalTags()
+	if slices.Equal(tags, s.IBMVPCCluster.Status.AttachedTags) {
+		return nil
+	}
+
+	resourceCRNs, err := s.clusterResourceCRNs()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve cluster resources: %w", err)
+	}
+	log.V(3).Info("Updating additional tags of cluster resources", "tags", tags, "attachedTags", s.IBMVPCCluster.Status.AttachedTags, "resources", len(resourceCRNs))
+	if err := reconcileAttachedTags(s.GlobalTaggingClient, resourceCRNs, tags, s.IBMVPCCluster.Status.AttachedTags); err != nil {
+		return err
+	}
+	s.IBMVPCCluster.Status.AttachedTags = tags
+	return nil
+}
+
+// clusterResourceCRNs returns the CRNs of the resources created for the cluster, which are tagged with the name of
+// the cluster, as opposed to the existing resources provided in the spec.
+func (s *ClusterScopeV2) clusterResourceCRNs() ([]string, error) {
+	var crns []*string
+	if status := s.NetworkStatus(); status != nil {
+		if status.VPC != nil {
+			vpcDetails, _, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To(status.VPC.ID)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve vpc %s: %w", status.VPC.ID, err)
+			}
+			crns = append(crns, vpcDetails.CRN)
+		}
+		for _, subnets := range []map[string]*infrav1.ResourceStatus{status.ControlPlaneSubnets, status.WorkerSubnets} {
+			for _, subnet := range subnets {
+				subnetDetails, _, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To(subnet.ID)})
+				if err != nil {
+					return nil, fmt.Errorf("failed to retrieve subnet %s: %w", subnet.ID, err)
+				}
+				crns = append(crns, subnetDetails.CRN)
+			}
+		}
+		for _, publicGateway := range status.PublicGateways {
+			publicGatewayDetails, _, err := s.VPCClient.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{ID: ptr.To(publicGateway.ID)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve public gateway %s: %w", publicGateway.ID, err)
+			}
+			crns = append(crns, publicGatewayDetails.CRN)
+		}
+		for _, securityGroup := range status.SecurityGroups {
+			securityGroupDetails, _, err := s.VPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: ptr.To(securityGroup.ID)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve security group %s: %w", securityGroup.ID, err)
+			}
+			crns = append(crns, securityGroupDetails.CRN)
+		}
+		for _, loadBalancer := range status.LoadBalancers {
+			if loadBalancer.ID == nil {
+				continue
+			}
+			loadBalancerDetails, _, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: loadBalancer.ID})
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve load balancer %s: %w", *loadBalancer.ID, err)
+			}
+			crns = append(crns, loadBalancerDetails.CRN)
+		}
+	}
+	if s.IBMVPCCluster.Status.Image != nil {
+		imageDetails, _, err := s.VPCClient.GetImage(&vpcv1.GetImageOptions{ID: ptr.To(s.IBMVPCCluster.Status.Image.ID)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve image %s: %w", s.IBMVPCCluster.Status.Image.ID, err)
+		}
+		crns = append(crns, imageDetails.CRN)
+	}
+	for _, fileShare := range s.IBMVPCCluster.Status.FileShares {
+		shareDetails, _, err := s.VPCClient.GetShare(&vpcv1.GetShareOptions{ID: ptr.To(fileShare.ID)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve file share %s: %w", fileShare.ID, err)
+		}
+		crns = append(crns, shareDetails.CRN)
+	}
+
+	// Only the resources created for the cluster are tagged with its name.
+	var resourceCRNs []string
+	for _, crn := range crns {
+		if crn == nil {
+			continue
+		}
+		tags, err := s.GlobalTaggingClient.GetAttachedTags(*crn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve tags of resource %s: %w", *crn, err)
+		}
+		if slices.Contains(tags, s.Name()) || slices.Contains(tags, s.IBMVPCCluster.Name) {
+			resourceCRNs = append(resourceCRNs, *crn)
+		}
+	}
+	return resourceCRNs, nil
+}
+
 // ReconcileVPC reconciles the cluster's VPC.
 func (s *ClusterScopeV2) ReconcileVPC(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -1103,9 +1191,23 @@ func (s *ClusterScopeV2) updateSubnetStatus(subnetDetails *vpcv1.Subnet, isContr
 	} else {
 		s.SetResourceStatus(infrav1.ResourceTypeWorkerSubnet, resourceStatus)
 	}
+	if subnetDetails.Zone != nil && subnetDetails.Zone.Name != nil {
+		s.setFailureDomain(*subnetDetails.Zone.Name, isControlPlane)
+	}
 	return requeue, nil
 }
 
+// setFailureDomain adds the zone of a subnet to the cluster's failure domains.
+// A zone is suitable for control plane machines once it has a control plane subnet.
+func (s *ClusterScopeV2) setFailureDomain(zone string, isControlPlane bool) {
+	if s.IBMVPCCluster.Status.FailureDomains == nil {
+		s.IBMVPCCluster.Status.FailureDomains = make(clusterv1beta1.FailureDomains)
+	}
+	failureDomain := s.IBMVPCCluster.Status.FailureDomains[zone]
+	failureDomain.ControlPlane = failureDomain.ControlPlane || isControlPlane
+	s.IBMVPCCluster.Status.FailureDomains[zone] = failureDomain
+}
+
 // createSubnet creates a new VPC subnet.
 func (s *ClusterScopeV2) createSubnet(ctx context.Context, subnet infrav1.Subnet, isControlPlane bool) error {
 	// TODO(cjschaef): Move to webhook validation.
@@ -2294,3 +2396,175 @@ func (s *ClusterScopeV2) getDefaultLoadBalancerListeners(defaultBackendPool bool
 	defaultListeners = append(defaultListeners, s.buildLoadBalancerListener(defaultListener))
 	return defaultListeners
 }
+
+// ReconcileFileShares reconciles the cluster's VPC file shares, and the mount target in the VPC of the file shares
+// with the VPC access control mode. It requeues until the file shares and their mount targets are stable.
+func (s *ClusterScopeV2) ReconcileFileShares(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	// If no File Shares were supplied, we have nothing to do.
+	if len(s.IBMVPCCluster.Spec.FileShares) == 0 {
+		return false, nil
+	}
+
+	if s.IBMVPCCluster.Status.FileShares == nil {
+		s.IBMVPCCluster.Status.FileShares = make(map[string]*infrav1.VPCFileShareStatus)
+	}
+	requeue := false
+	for _, fileShare := range s.IBMVPCCluster.Spec.FileShares {
+		ready, err := s.reconcileFileShare(ctx, fileShare)
+		if err != nil {
+			return false, fmt.Errorf("error failed reconciling file share %s: %w", fileShare.Name, err)
+		} else if !ready {
+			log.V(3).Info("requeuing for file share", "fileShareName", fileShare.Name)
+			requeue = true
+		}
+	}
+	return requeue, nil
+}
+
+// reconcileFileShare reconciles a file share, looked up by the ID in the status or by name, or created, and returns
+// whether it is ready.
+func (s *ClusterScopeV2) reconcileFileShare(ctx context.Context, fileShare infrav1.VPCFileShare) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	status, ok := s.IBMVPCCluster.Status.FileShares[fileShare.Name]
+	var shareDetails *vpcv1.Share
+	var err error
+	if ok {
+		shareDetails, _, err = s.VPCClient.GetShare(&vpcv1.GetShareOptions{
+			ID: ptr.To(status.ID),
+		})
+		if err != nil {
+			return false, fmt.Errorf("error failed lookup of file share: %w", err)
+		}
+	} else {
+		status = &infrav1.VPCFileShareStatus{}
+		if shareDetails, err = s.VPCClient.GetShareByName(fileShare.Name); err != nil {
+			return false, fmt.Errorf("error failed lookup of file share by name: %w", err)
+		} else if shareDetails == nil {
+			if shareDetails, err = s.createFileShare(ctx, fileShare); err != nil {
+				return false, err
+			}
+		}
+	}
+	if shareDetails == nil {
+		return false, fmt.Errorf("error could not find file share with id=%s", status.ID)
+	}
+
+	status.ID = *shareDetails.ID
+	status.AccessControlMode = infrav1.VPCFileShareAccessControlModeSecurityGroup
+	if ptr.Deref(shareDetails.AccessControlMode, "") == vpcv1.ShareAccessControlModeVPCConst {
+		status.AccessControlMode = infrav1.VPCFileShareAccessControlModeVPC
+	}
+	status.Ready = false
+	s.IBMVPCCluster.Status.FileShares[fileShare.Name] = status
+	if ptr.Deref(shareDetails.LifecycleState, "") != vpcv1.ShareLifecycleStateStableConst {
+		log.V(3).Info("file share is not stable", "fileShareName", fileShare.Name, "lifecycleState", shareDetails.LifecycleState)
+		return false, nil
+	}
+
+	// Mount targets of file shares with the security group access control mode are created for each machine.
+	if status.AccessControlMode != infrav1.VPCFileShareAccessControlModeVPC {
+		status.Ready = true
+		return true, nil
+	}
+	mountTarget, err := s.reconcileFileShareVPCMountTarget(ctx, status)
+	if err != nil {
+		return false, err
+	}
+	status.MountTargetID = *mountTarget.ID
+	status.MountPath = ptr.Deref(mountTarget.MountPath, "")
+	status.Ready = ptr.Deref(mountTarget.LifecycleState, "") == vpcv1.ShareMountTargetLifecycleStateStableConst
+	return status.Ready, nil
+}
+
+// createFileShare creates a file share and tags it with the name of the cluster.
+func (s *ClusterScopeV2) createFileShare(ctx context.Context, fileShare infrav1.VPCFileShare) (*vpcv1.Share, error) {
+	log := ctrl.LoggerFrom(ctx)
+	resourceGroupID, err := s.GetResourceGroupID()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving resource group id for file share creation: %w", err)
+	}
+	profile := fileShare.Profile
+	if profile == "" {
+		profile = "dp2"
+	}
+	accessControlMode := vpcv1.SharePrototypeShareBySizeAccessControlModeSecurityGroupConst
+	if fileShare.AccessControlMode == infrav1.VPCFileShareAccessControlModeVPC {
+		accessControlMode = vpcv1.SharePrototypeShareBySizeAccessControlModeVPCConst
+	}
+
+	log.Info("Creating file share", "fileShareName", fileShare.Name, "size", fileShare.Size, "profile", profile, "zone", fileShare.Zone)
+	shareDetails, _, err := s.VPCClient.CreateShare(&vpcv1.CreateShareOptions{
+		SharePrototype: &vpcv1.SharePrototypeShareBySize{
+			Name: ptr.To(fileShare.Name),
+			Size: ptr.To(fileShare.Size),
+			Profile: &vpcv1.ShareProfileIdentityByName{
+				Name: ptr.To(profile),
+			},
+			Zone: &vpcv1.ZoneIdentityByName{
+				Name: ptr.To(fileShare.Zone),
+			},
+			AccessControlMode: ptr.To(accessControlMode),
+			ResourceGroup: &vpcv1.ResourceGroupIdentityByID{
+				ID: ptr.To(resourceGroupID),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error failed to create file share: %w", err)
+	}
+	if shareDetails == nil {
+		return nil, fmt.Errorf("error failed creating file share")
+	}
+
+	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
+	if err := s.TagResource(s.IBMVPCCluster.Name, *shareDetails.CRN); err != nil {
+		return nil, fmt.Errorf("error failed to tag file share %s: %w", *shareDetails.CRN, err)
+	}
+	return shareDetails, nil
+}
+
+// reconcileFileShareVPCMountTarget returns the mount target of a file share in the cluster's VPC, named after the
+// cluster, which is created if it does not exist.
+func (s *ClusterScopeV2) reconcileFileShareVPCMountTarget(ctx context.Context, status *infrav1.VPCFileShareStatus) (*vpcv1.ShareMountTarget, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if status.MountTargetID != "" {
+		mountTarget, _, err := s.VPCClient.GetShareMountTarget(&vpcv1.GetShareMountTargetOptions{
+			ShareID: ptr.To(status.ID),
+			ID:      ptr.To(status.MountTargetID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of file share mount target: %w", err)
+		}
+		return mountTarget, nil
+	}
+
+	name := s.IBMVPCCluster.Name
+	mountTarget, err := s.VPCClient.GetShareMountTargetByName(status.ID, name)
+	if err != nil {
+		return nil, fmt.Errorf("error failed lookup of file share mount target by name: %w", err)
+	} else if mountTarget != nil {
+		return mountTarget, nil
+	}
+
+	vpcID, err := s.GetVPCID()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving vpc id for file share mount target creation: %w", err)
+	}
+	log.Info("Creating file share mount target", "fileShareID", status.ID, "mountTargetName", name)
+	mountTarget, _, err = s.VPCClient.CreateShareMountTarget(&vpcv1.CreateShareMountTargetOptions{
+		ShareID: ptr.To(status.ID),
+		ShareMountTargetPrototype: &vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPC{
+			Name:              ptr.To(name),
+			AccessProtocol:    ptr.To(vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPCAccessProtocolNfs4Const),
+			TransitEncryption: ptr.To(vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPCTransitEncryptionNoneConst),
+			VPC: &vpcv1.VPCIdentityByID{
+				ID: vpcID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error failed to create file share mount target: %w", err)
+	}
+	return mountTarget, nil
+}
@@ -17,19 +17,34 @@ limitations under the License.
 package vpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/blang/semver/v4"
+	ignV3Types "github.com/coreos/ignition/v2/config/v3_4/types"
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
@@ -37,19 +52,41 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
-	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"                   //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
+	ignV2Types "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/ignition"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/pagingutils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
 )
 
+const (
+	cosURLDomain = "cloud-object-storage.appdomain.cloud"
+
+	// cosHMACAccessKeyField and cosHMACSecretKeyField are the keys expected in the
+	// Secret referenced by the cluster's Ignition COS configuration.
+	cosHMACAccessKeyField = "access_key_id"
+	cosHMACSecretKeyField = "secret_access_key"
+
+	// presignExpiry is the lifetime of the pre-signed ignition URL embedded in user-data.
+	presignExpiry = time.Hour
+)
+
+var (
+	// ErrVolumeUpdateRefused indicates that a volume cannot be updated as requested by the spec, so that the update is
+	// not retried until the spec changes.
+	ErrVolumeUpdateRefused = errors.New("volume update refused")
+)
+
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
 type MachineScopeParams struct {
 	IBMVPCClient    vpc.Vpc
@@ -60,6 +97,9 @@ type MachineScopeParams struct {
 	IBMVPCCluster   *infrav1.IBMVPCCluster
 	IBMVPCMachine   *infrav1.IBMVPCMachine
 	ServiceEndpoint []endpoints.ServiceEndpoint
+
+	// ClientBuilder constructs the IBM Cloud clients, ProdClientBuilder is used if not set.
+	ClientBuilder ClientBuilder
 }
 
 // MachineScope defines a scope defined around a machine and its cluster.
@@ -69,15 +109,22 @@ type MachineScope struct {
 
 	IBMVPCClient        vpc.Vpc
 	GlobalTaggingClient globaltagging.GlobalTagging
+	COSClient           cos.Cos
 	Cluster             *clusterv1.Cluster
 	Machine             *clusterv1.Machine
 	IBMVPCCluster       *infrav1.IBMVPCCluster
 	IBMVPCMachine       *infrav1.IBMVPCMachine
 	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// Authenticator is the authenticator used to build the IBM Cloud clients, from the credentials of the cluster.
+	Authenticator core.Authenticator
+
+	// ClientBuilder is retained so that the COS client, which is created on demand, uses the same builder.
+	ClientBuilder ClientBuilder
 }
 
 // NewMachineScope creates a new MachineScope from the supplied parameters.
-func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
+func NewMachineScope(ctx context.Context, params MachineScopeParams) (*MachineScope, error) {
 	if params.Machine == nil {
 		return nil, errors.New("failed to generate new scope from nil Machine")
 	}
@@ -94,35 +141,30 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		return nil, fmt.Errorf("failed to init patch helper: %w", err)
 	}
 
-	// Fetch the service endpoint.
-	svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
+	if params.ClientBuilder == nil {
+		params.ClientBuilder = ProdClientBuilder{}
+	}
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	auth, err := params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{Client: params.Client, IBMVPCCluster: params.IBMVPCCluster})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
+		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
 	}
 
-	if params.Logger.V(DEBUGLEVEL).Enabled() {
-		core.SetLoggingLevel(core.LevelDebug)
+	clientOptions := ClientOptions{
+		Authenticator:   auth,
+		Region:          params.IBMVPCCluster.Spec.Region,
+		ServiceEndpoint: params.ServiceEndpoint,
 	}
-
-	auth, err := authenticator.GetAuthenticator()
+	vpcClient, err := params.ClientBuilder.GetVPCClient(ctx, clientOptions)
 	if err != nil {
-		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
+		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
 
-	// Create Global Tagging client.
-	gtOptions := globaltagging.ServiceOptions{
-		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
-			Authenticator: auth,
-		},
-	}
-	// Override the Global Tagging endpoint if provided.
-	if gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), params.ServiceEndpoint); gtEndpoint != "" {
-		gtOptions.URL = gtEndpoint
-		params.Logger.Info("Overriding the default global tagging endpoint", "GlobalTaggingEndpoint", gtEndpoint)
+	if params.Logger.V(DEBUGLEVEL).Enabled() {
+		core.SetLoggingLevel(core.LevelDebug)
 	}
-	globalTaggingClient, err := globaltagging.NewService(gtOptions)
+
+	globalTaggingClient, err := params.ClientBuilder.GetGlobalTaggingClient(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
 	}
@@ -136,9 +178,422 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		patchHelper:         helper,
 		Machine:             params.Machine,
 		IBMVPCMachine:       params.IBMVPCMachine,
+		ServiceEndpoint:     params.ServiceEndpoint,
+		Authenticator:       auth,
+		ClientBuilder:       params.ClientBuilder,
 	}, nil
 }
 
+// GetZone returns the zone of the machine, which is the zone of its failure domain when its subnet is picked from it.
+func (m *MachineScope) GetZone() string {
+	if m.usesFailureDomainSubnet() {
+		return m.Machine.Spec.FailureDomain
+	}
+	return m.IBMVPCMachine.Spec.Zone
+}
+
+// usesFailureDomainSubnet returns whether the machine subnet is picked from its failure domain, i.e. when no subnet is
+// set for the machine and the Machine is assigned a failure domain.
+func (m *MachineScope) usesFailureDomainSubnet() bool {
+	return m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet == "" && m.Machine != nil && m.Machine.Spec.FailureDomain != ""
+}
+
+// getFailureDomainSubnetID returns the ID of the cluster's subnet in the zone of the machine's failure domain.
+// The control plane subnets are used for the control plane machines and the worker subnets for the others.
+func (m *MachineScope) getFailureDomainSubnetID() (*string, error) {
+	zone := m.Machine.Spec.FailureDomain
+	if m.IBMVPCCluster.Status.Network == nil {
+		// Without extended VPC Infrastructure support, all the machines share the cluster's subnet.
+		subnet := m.IBMVPCCluster.Status.Subnet
+		if subnet.ID != nil && subnet.Zone != nil && *subnet.Zone == zone {
+			return subnet.ID, nil
+		}
+		return nil, fmt.Errorf("error no subnet found in failure domain %s for machine %s", zone, m.IBMVPCMachine.Name)
+	}
+
+	subnets := m.IBMVPCCluster.Status.Network.WorkerSubnets
+	if util.IsControlPlaneMachine(m.Machine) {
+		subnets = m.IBMVPCCluster.Status.Network.ControlPlaneSubnets
+	}
+	for _, name := range slices.Sorted(maps.Keys(subnets)) {
+		subnetDetails, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: ptr.To(subnets[name].ID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving subnet %s for machine %s: %w", name, m.IBMVPCMachine.Name, err)
+		}
+		if subnetDetails != nil && subnetDetails.Zone != nil && subnetDetails.Zone.Name != nil && *subnetDetails.Zone.Name == zone {
+			return subnetDetails.ID, nil
+		}
+	}
+	return nil, fmt.Errorf("error no subnet found in failure domain %s for machine %s", zone, m.IBMVPCMachine.Name)
+}
+
+// buildNetworkInterfacePrototype returns the prototype of a network interface of the machine in the subnet, with the security groups.
+func (m *MachineScope) buildNetworkInterfacePrototype(subnetID *string, securityGroups []infrav1.VPCResource) (*vpcv1.NetworkInterfacePrototype, error) {
+	prototype := &vpcv1.NetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: subnetID,
+		},
+	}
+
+	// Populate the network interface's SecurityGroups, if provided.
+	if len(securityGroups) > 0 {
+		securityGroupIdentities, err := m.getSecurityGroupIdentities(securityGroups)
+		if err != nil {
+			return nil, err
+		}
+		prototype.SecurityGroups = securityGroupIdentities
+	}
+	return prototype, nil
+}
+
+// usesVirtualNetworkInterfaces returns whether the network interfaces of the machine are virtual network interfaces
+// attached with network attachments.
+func (m *MachineScope) usesVirtualNetworkInterfaces() bool {
+	return m.IBMVPCMachine.Spec.NetworkAttachmentMode == infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+}
+
+// virtualNetworkInterfaceAutoDelete returns whether the virtual network interfaces of the machine are deleted with its instance.
+func (m *MachineScope) virtualNetworkInterfaceAutoDelete() bool {
+	vni := m.IBMVPCMachine.Spec.VirtualNetworkInterface
+	return vni == nil || vni.DeletionPolicy != infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain
+}
+
+// retainedVirtualNetworkInterfaceSuffixLength is the length of the random suffix of the names of the retained virtual
+// network interfaces.
+const retainedVirtualNetworkInterfaceSuffixLength = 5
+
+// retainedVirtualNetworkInterfacePrefix returns the name prefix of the retained virtual network interfaces of the network
+// interface of the machine at index, 0 being the primary network interface, or false if they are not retained.
+func (m *MachineScope) retainedVirtualNetworkInterfacePrefix(index int) (string, bool) {
+	if m.virtualNetworkInterfaceAutoDelete() || m.IBMVPCMachine.Spec.VirtualNetworkInterface.RetainedNamePrefix == "" {
+		return "", false
+	}
+	return fmt.Sprintf("%s-%d-", m.IBMVPCMachine.Spec.VirtualNetworkInterface.RetainedNamePrefix, index), true
+}
+
+// isRetainedVirtualNetworkInterfaceName returns whether name is the name of a retained virtual network interface with the
+// given prefix. As the random suffix has no hyphen, the names for different prefixes or indexes never match each other.
+func isRetainedVirtualNetworkInterfaceName(name, prefix string) bool {
+	suffix, ok := strings.CutPrefix(name, prefix)
+	return ok && len(suffix) == retainedVirtualNetworkInterfaceSuffixLength && !strings.Contains(suffix, "-")
+}
+
+// findRetainedVirtualNetworkInterface returns an unattached retained virtual network interface with the name prefix in the
+// subnet of the network interface prototype, or nil if there is none. When the prototype binds a reserved IP, only the
+// retained virtual network interface it is bound to, if any, is returned.
+func (m *MachineScope) findRetainedVirtualNetworkInterface(prefix string, networkInterface *vpcv1.NetworkInterfacePrototype) (*vpcv1.VirtualNetworkInterface, error) {
+	vnis, err := m.IBMVPCClient.ListVirtualNetworkInterfacesByNamePrefix(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("error listing retained virtual network interfaces %s* for machine %s: %w", prefix, m.IBMVPCMachine.Name, err)
+	}
+	subnet, _ := networkInterface.Subnet.(*vpcv1.SubnetIdentity)
+	primaryIP, _ := networkInterface.PrimaryIP.(*vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID)
+	for i, vni := range vnis {
+		if primaryIP != nil && primaryIP.ID != nil && (vni.PrimaryIP == nil || ptr.Deref(vni.PrimaryIP.ID, "") != *primaryIP.ID) {
+			continue
+		}
+		if vni.Name == nil || !isRetainedVirtualNetworkInterfaceName(*vni.Name, prefix) || vni.Target != nil {
+			continue
+		}
+		if vni.LifecycleState != nil && *vni.LifecycleState != vpcv1.VirtualNetworkInterfaceLifecycleStateStableConst {
+			continue
+		}
+		if subnet != nil && subnet.ID != nil && (vni.Subnet == nil || vni.Subnet.ID == nil || *vni.Subnet.ID != *subnet.ID) {
+			continue
+		}
+		return &vnis[i], nil
+	}
+	return nil, nil
+}
+
+// isRetainedVirtualNetworkInterface returns whether the virtual network interface with the ID is an unattached retained
+// virtual network interface of the primary network interface of the machine.
+func (m *MachineScope) isRetainedVirtualNetworkInterface(id string) (bool, error) {
+	prefix, retained := m.retainedVirtualNetworkInterfacePrefix(0)
+	if !m.usesVirtualNetworkInterfaces() || !retained || id == "" {
+		return false, nil
+	}
+	vnis, err := m.IBMVPCClient.ListVirtualNetworkInterfacesByNamePrefix(prefix)
+	if err != nil {
+		return false, fmt.Errorf("error listing retained virtual network interfaces %s* for machine %s: %w", prefix, m.IBMVPCMachine.Name, err)
+	}
+	for _, vni := range vnis {
+		if ptr.Deref(vni.ID, "") == id {
+			return vni.Name != nil && isRetainedVirtualNetworkInterfaceName(*vni.Name, prefix) && vni.Target == nil, nil
+		}
+	}
+	return false, nil
+}
+
+// buildNetworkAttachmentPrototype returns the prototype of a network attachment of the machine with a virtual network
+// interface in the subnet and with the security groups of the network interface prototype at index, 0 being the
+// primary network interface. When the virtual network interfaces are retained, an unattached one retained from a
+// deleted machine with the same name prefix is attached again, so that its IP address is kept across machine
+// replacement. Otherwise, the name of the virtual network interface is generated by IBM Cloud.
+func (m *MachineScope) buildNetworkAttachmentPrototype(index int, networkInterface *vpcv1.NetworkInterfacePrototype) (*vpcv1.InstanceNetworkAttachmentPrototype, error) {
+	var name *string
+	if prefix, retained := m.retainedVirtualNetworkInterfacePrefix(index); retained {
+		vni, err := m.findRetainedVirtualNetworkInterface(prefix, networkInterface)
+		if err != nil {
+			return nil, err
+		}
+		if vni != nil {
+			if vni.AutoDelete == nil || *vni.AutoDelete {
+				if err := m.updateVirtualNetworkInterface(*vni.ID, &vpcv1.VirtualNetworkInterfacePatch{AutoDelete: ptr.To(false)}); err != nil {
+					return nil, err
+				}
+			}
+			return &vpcv1.InstanceNetworkAttachmentPrototype{
+				VirtualNetworkInterface: &vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID{
+					ID: vni.ID,
+				},
+			}, nil
+		}
+		name = ptr.To(prefix + util.RandomString(retainedVirtualNetworkInterfaceSuffixLength))
+	}
+
+	vniPrototype := &vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext{
+		AutoDelete:     ptr.To(m.virtualNetworkInterfaceAutoDelete()),
+		Name:           name,
+		SecurityGroups: networkInterface.SecurityGroups,
+		Subnet:         networkInterface.Subnet,
+	}
+	if primaryIP, ok := networkInterface.PrimaryIP.(*vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID); ok {
+		vniPrototype.PrimaryIP = &vpcv1.VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByID{
+			ID: primaryIP.ID,
+		}
+	}
+	if options := m.IBMVPCMachine.Spec.VirtualNetworkInterface; options != nil {
+		vniPrototype.AllowIPSpoofing = options.AllowIPSpoofing
+		vniPrototype.EnableInfrastructureNat = options.EnableInfrastructureNAT
+	}
+	return &vpcv1.InstanceNetworkAttachmentPrototype{
+		VirtualNetworkInterface: vniPrototype,
+	}, nil
+}
+
+// updateVirtualNetworkInterface updates a virtual network interface of the machine, e.g. whether it is deleted with its instance.
+func (m *MachineScope) updateVirtualNetworkInterface(id string, vniPatch *vpcv1.VirtualNetworkInterfacePatch) error {
+	patch, err := vniPatch.AsPatch()
+	if err != nil {
+		return fmt.Errorf("error building virtual network interface patch for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	if _, _, err := m.IBMVPCClient.UpdateVirtualNetworkInterface(&vpcv1.UpdateVirtualNetworkInterfaceOptions{
+		ID:                           ptr.To(id),
+		VirtualNetworkInterfacePatch: patch,
+	}); err != nil {
+		return fmt.Errorf("error updating virtual network interface %s for machine %s: %w", id, m.IBMVPCMachine.Name, err)
+	}
+	return nil
+}
+
+// ipAddressClaimKey returns the key of the IPAddressClaim of the machine, which is named after it.
+func (m *MachineScope) ipAddressClaimKey() client.ObjectKey {
+	return client.ObjectKey{Namespace: m.IBMVPCMachine.Namespace, Name: m.IBMVPCMachine.Name}
+}
+
+// ReconcileIPAddressClaim ensures the IPAddressClaim of the machine exists when the address of its primary network
+// interface is allocated from a CAPI IPAM pool, and returns whether the address has been allocated.
+func (m *MachineScope) ReconcileIPAddressClaim(ctx context.Context) (bool, error) {
+	reservedIP := m.IBMVPCMachine.Spec.PrimaryNetworkInterface.ReservedIP
+	if reservedIP == nil || reservedIP.PoolRef == nil {
+		return true, nil
+	}
+	claim := &ipamv1.IPAddressClaim{}
+	err := m.Client.Get(ctx, m.ipAddressClaimKey(), claim)
+	if err == nil {
+		return claim.Status.AddressRef.Name != "", nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("error retrieving IPAddressClaim for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+
+	// The claim is owned by the machine, so that the address is released once the machine is deleted.
+	claim = &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.IBMVPCMachine.Name,
+			Namespace: m.IBMVPCMachine.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: m.Machine.Spec.ClusterName,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         infrav1.GroupVersion.String(),
+					Kind:               "IBMVPCMachine",
+					Name:               m.IBMVPCMachine.Name,
+					UID:                m.IBMVPCMachine.UID,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(true),
+				},
+			},
+		},
+		Spec: ipamv1.IPAddressClaimSpec{
+			ClusterName: m.Machine.Spec.ClusterName,
+			PoolRef:     *reservedIP.PoolRef,
+		},
+	}
+	if err := m.Client.Create(ctx, claim); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedCreateIPAddressClaim", "Failed IPAddressClaim creation - %v", err)
+		return false, fmt.Errorf("error creating IPAddressClaim for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulCreateIPAddressClaim", "Created IPAddressClaim %q", claim.Name)
+	return false, nil
+}
+
+// getClaimedAddress returns the IP address allocated to the IPAddressClaim of the machine.
+func (m *MachineScope) getClaimedAddress(ctx context.Context) (string, error) {
+	claim := &ipamv1.IPAddressClaim{}
+	if err := m.Client.Get(ctx, m.ipAddressClaimKey(), claim); err != nil {
+		return "", fmt.Errorf("error retrieving IPAddressClaim for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	if claim.Status.AddressRef.Name == "" {
+		return "", fmt.Errorf("error IPAddressClaim for machine %s has no address allocated", m.IBMVPCMachine.Name)
+	}
+	address := &ipamv1.IPAddress{}
+	if err := m.Client.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Status.AddressRef.Name}, address); err != nil {
+		return "", fmt.Errorf("error retrieving IPAddress %s for machine %s: %w", claim.Status.AddressRef.Name, m.IBMVPCMachine.Name, err)
+	}
+	return address.Spec.Address, nil
+}
+
+// buildPrimaryIPPrototype returns the prototype of the primary IP of the primary network interface of the machine in
+// the subnet, which binds the reserved IP requested for it, or nil to let the subnet pick the address.
+// For a static address or an address allocated by a CAPI IPAM provider, the reserved IP is created by the controller
+// and named after the machine, so that it is found again when the instance creation is retried.
+func (m *MachineScope) buildPrimaryIPPrototype(ctx context.Context, subnetID string) (vpcv1.NetworkInterfaceIPPrototypeIntf, error) {
+	spec := m.IBMVPCMachine.Spec.PrimaryNetworkInterface.ReservedIP
+	if spec == nil {
+		return nil, nil
+	}
+	if spec.ID != nil {
+		return &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID{
+			ID: spec.ID,
+		}, nil
+	}
+
+	address := ptr.Deref(spec.Address, "")
+	if spec.PoolRef != nil {
+		var err error
+		if address, err = m.getClaimedAddress(ctx); err != nil {
+			return nil, err
+		}
+	}
+	reservedIP, err := m.IBMVPCClient.GetSubnetReservedIPByName(subnetID, m.IBMVPCMachine.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving reserved IP for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	if reservedIP != nil {
+		if ptr.Deref(reservedIP.Address, "") != address {
+			return nil, fmt.Errorf("error reserved IP %s for machine %s has address %s instead of %s", *reservedIP.ID, m.IBMVPCMachine.Name, ptr.Deref(reservedIP.Address, ""), address)
+		}
+		// A reserved IP bound to the virtual network interface retained from a previous instance stays bound to it.
+		if target, ok := reservedIP.Target.(*vpcv1.ReservedIPTarget); ok && target != nil {
+			retained, err := m.isRetainedVirtualNetworkInterface(ptr.Deref(target.ID, ""))
+			if err != nil {
+				return nil, err
+			}
+			if !retained {
+				return nil, fmt.Errorf("error reserved IP %s for machine %s is already bound to %s", *reservedIP.ID, m.IBMVPCMachine.Name, ptr.Deref(target.ID, ""))
+			}
+		}
+	} else {
+		// The reserved IP is created unbound, which requires auto_delete to be false until the instance is deleted.
+		reservedIP, _, err = m.IBMVPCClient.CreateSubnetReservedIP(&vpcv1.CreateSubnetReservedIPOptions{
+			SubnetID:   ptr.To(subnetID),
+			Address:    ptr.To(address),
+			AutoDelete: ptr.To(false),
+			Name:       ptr.To(m.IBMVPCMachine.Name),
+		})
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateReservedIP", "Failed reserved IP creation - %v", err)
+			return nil, fmt.Errorf("error creating reserved IP %s for machine %s: %w", address, m.IBMVPCMachine.Name, err)
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulCreateReservedIP", "Created reserved IP %q with address %s", m.IBMVPCMachine.Name, address)
+	}
+	m.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.VPCReservedIPStatus{
+		ID:       *reservedIP.ID,
+		SubnetID: subnetID,
+		Address:  address,
+	}
+	return &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID{
+		ID: reservedIP.ID,
+	}, nil
+}
+
+// getSubnetID returns the ID of a subnet of the machine, from the cluster's Network Status or looked up by name.
+func (m *MachineScope) getSubnetID(subnet string) (*string, error) {
+	// If Network Status is available, attempt to retrieve subnet ID from there.
+	if m.IBMVPCCluster.Status.Network != nil {
+		if statusSubnet, ok := m.IBMVPCCluster.Status.Network.WorkerSubnets[subnet]; ok {
+			return ptr.To(statusSubnet.ID), nil
+		}
+		if statusSubnet, ok := m.IBMVPCCluster.Status.Network.ControlPlaneSubnets[subnet]; ok {
+			return ptr.To(statusSubnet.ID), nil
+		}
+	}
+	// If the ID hasn't been found, rely on Machine Spec for lookup, and finally falling back to previous logic of using the subnet value directly as an ID.
+	// For Machines not reliant directly on Cluster managed subnets, lookup subnet ID by name.
+	subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving subnet ID for machine %s: %w", m.IBMVPCMachine.Name, err)
+	} else if subnetDetails != nil {
+		return subnetDetails.ID, nil
+	}
+	return ptr.To(subnet), nil
+}
+
+// getSecurityGroupIdentities returns the identities of the security groups of a network interface of the machine.
+func (m *MachineScope) getSecurityGroupIdentities(sgs []infrav1.VPCResource) ([]vpcv1.SecurityGroupIdentityIntf, error) {
+	securityGroups := make([]vpcv1.SecurityGroupIdentityIntf, 0, len(sgs))
+	for _, sg := range sgs {
+		// Try using Security Group name if provided.
+		if sg.Name != nil {
+			// If Network Status is available, attempt to retrieve Security Group ID from there.
+			if m.IBMVPCCluster.Status.Network != nil {
+				if sgStatus, ok := m.IBMVPCCluster.Status.Network.SecurityGroups[*sg.Name]; ok {
+					securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
+						ID: ptr.To(sgStatus.ID),
+					})
+					continue
+				}
+			}
+			// If not found in Network Status, try looking up the Security Group via API.
+			sgDetails, err := m.IBMVPCClient.GetSecurityGroupByName(*sg.Name)
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving security group id with name %s for machine %s: %w", *sg.Name, m.IBMVPCMachine.Name, err)
+			} else if sgDetails != nil {
+				securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
+					ID: sgDetails.ID,
+				})
+				continue
+			}
+			// If Name was provided but it cannot be found in Network Status or via API, return an error.
+			return nil, fmt.Errorf("error cannot find security group %s for machine %s", *sg.Name, m.IBMVPCMachine.Name)
+		}
+		// If ID is provided for Security Group, attempt lookup to confirm it exists.
+		if sg.ID != nil {
+			sgOptions := &vpcv1.GetSecurityGroupOptions{
+				ID: sg.ID,
+			}
+			sgDetails, _, err := m.IBMVPCClient.GetSecurityGroup(sgOptions)
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving security by id %s for machine %s: %w", *sg.ID, m.IBMVPCMachine.Name, err)
+			} else if sgDetails == nil {
+				return nil, fmt.Errorf("error security group not found with id %s for machine %s", *sg.ID, m.IBMVPCMachine.Name)
+			}
+			securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
+				ID: sg.ID,
+			})
+			continue
+		}
+		// TODO(cjschaef): Replace with webhook validation check.
+		return nil, fmt.Errorf("error no name or id provided for security group for machine %s", m.IBMVPCMachine.Name)
+	}
+	return securityGroups, nil
+}
+
 // CreateMachine creates a vpc machine.
 func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, error) { //nolint: gocyclo
 	log := ctrl.LoggerFrom(ctx)
@@ -150,7 +605,7 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 		return instanceReply, nil
 	}
 
-	cloudInitData, err := m.GetBootstrapData()
+	cloudInitData, err := m.resolveUserData(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -165,85 +620,57 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 		Name: &m.IBMVPCMachine.Spec.Profile,
 	}
 
-	subnetIdentity := &vpcv1.SubnetIdentity{}
-	// If Network Status is available, attempt to retrieve subnet ID from there.
-	if m.IBMVPCCluster.Status.Network != nil {
-		if m.IBMVPCCluster.Status.Network.ControlPlaneSubnets != nil {
-			if subnet, ok := m.IBMVPCCluster.Status.Network.ControlPlaneSubnets[m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet]; ok {
-				subnetIdentity.ID = ptr.To(subnet.ID)
-			}
+	var primarySubnetID *string
+	if m.usesFailureDomainSubnet() {
+		// Without an explicit subnet, place the machine in the cluster's subnet of its failure domain.
+		primarySubnetID, err = m.getFailureDomainSubnetID()
+	} else {
+		primarySubnetID, err = m.getSubnetID(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet)
+	}
+	if err != nil {
+		return nil, err
+	}
+	primaryNetworkInterface, err := m.buildNetworkInterfacePrototype(primarySubnetID, m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups)
+	if err != nil {
+		return nil, err
+	}
+	if primaryNetworkInterface.PrimaryIP, err = m.buildPrimaryIPPrototype(ctx, *primarySubnetID); err != nil {
+		return nil, err
+	}
+
+	// Populate the additional network interfaces, if provided.
+	additionalNetworkInterfaces := make([]vpcv1.NetworkInterfacePrototype, 0, len(m.IBMVPCMachine.Spec.AdditionalNetworkInterfaces))
+	for _, networkInterface := range m.IBMVPCMachine.Spec.AdditionalNetworkInterfaces {
+		subnetID, err := m.getSubnetID(networkInterface.Subnet)
+		if err != nil {
+			return nil, err
 		}
-		if m.IBMVPCCluster.Status.Network.WorkerSubnets != nil {
-			if subnet, ok := m.IBMVPCCluster.Status.Network.WorkerSubnets[m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet]; ok {
-				subnetIdentity.ID = ptr.To(subnet.ID)
-			}
+		networkInterfacePrototype, err := m.buildNetworkInterfacePrototype(subnetID, networkInterface.SecurityGroups)
+		if err != nil {
+			return nil, err
 		}
+		additionalNetworkInterfaces = append(additionalNetworkInterfaces, *networkInterfacePrototype)
 	}
-	// If the ID hasn't been set yet, rely on Machine Spec for lookup, and finally falling back to previous logic of using the subnet value directly as an ID.
-	if subnetIdentity.ID == nil {
-		// For Machines not reliant directly on Cluster managed subnets, lookup subnet ID by name.
-		subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet)
+
+	// Attach the network interfaces as virtual network interfaces, if requested. Retained ones are named after their
+	// retained name prefix and index so that they can be found and attached again to the instance of a replacing machine.
+	var primaryNetworkAttachment *vpcv1.InstanceNetworkAttachmentPrototype
+	var additionalNetworkAttachments []vpcv1.InstanceNetworkAttachmentPrototype
+	if m.usesVirtualNetworkInterfaces() {
+		primaryNetworkAttachment, err = m.buildNetworkAttachmentPrototype(0, primaryNetworkInterface)
 		if err != nil {
-			return nil, fmt.Errorf("error retrieving subnet ID for machine %s: %w", m.IBMVPCMachine.Name, err)
-		} else if subnetDetails != nil {
-			subnetIdentity.ID = subnetDetails.ID
-		} else {
-			subnetIdentity.ID = &m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet
-		}
-	}
-	primaryNetworkInterface := &vpcv1.NetworkInterfacePrototype{
-		Subnet: subnetIdentity,
-	}
-
-	// Populate the PrimaryNetworkInterface's SecurityGroups, if provided.
-	if len(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups) > 0 {
-		securityGroups := make([]vpcv1.SecurityGroupIdentityIntf, 0, len(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups))
-		for _, sg := range m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups {
-			// Try using Security Group name if provided.
-			if sg.Name != nil {
-				// If Network Status is available, attempt to retrieve Security Group ID from there.
-				if m.IBMVPCCluster.Status.Network != nil {
-					if sgStatus, ok := m.IBMVPCCluster.Status.Network.SecurityGroups[*sg.Name]; ok {
-						securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
-							ID: ptr.To(sgStatus.ID),
-						})
-						continue
-					}
-				}
-				// If not found in Network Status, try looking up the Security Group via API.
-				sgDetails, err := m.IBMVPCClient.GetSecurityGroupByName(*sg.Name)
-				if err != nil {
-					return nil, fmt.Errorf("error retrieving security group id with name %s for machine %s: %w", *sg.Name, m.IBMVPCMachine.Name, err)
-				} else if sgDetails != nil {
-					securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
-						ID: sgDetails.ID,
-					})
-					continue
-				}
-				// If Name was provided but it cannot be found in Network Status or via API, return an error.
-				return nil, fmt.Errorf("error cannot find security group %s for machine %s", *sg.Name, m.IBMVPCMachine.Name)
-			}
-			// If ID is provided for Security Group, attempt lookup to confirm it exists.
-			if sg.ID != nil {
-				sgOptions := &vpcv1.GetSecurityGroupOptions{
-					ID: sg.ID,
-				}
-				sgDetails, _, err := m.IBMVPCClient.GetSecurityGroup(sgOptions)
-				if err != nil {
-					return nil, fmt.Errorf("error retrieving security by id %s for machine %s: %w", *sg.ID, m.IBMVPCMachine.Name, err)
-				} else if sgDetails == nil {
-					return nil, fmt.Errorf("error security group not found with id %s for machine %s", *sg.ID, m.IBMVPCMachine.Name)
-				}
-				securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
-					ID: sg.ID,
-				})
-				continue
+			return nil, err
+		}
+		for i := range additionalNetworkInterfaces {
+			networkAttachment, err := m.buildNetworkAttachmentPrototype(i+1, &additionalNetworkInterfaces[i])
+			if err != nil {
+				return nil, err
 			}
-			// TODO(cjschaef): Replace with webhook validation check.
-			return nil, fmt.Errorf("error no name or id provided for security group for machine %s", m.IBMVPCMachine.Name)
+			additionalNetworkAttachments = append(additionalNetworkAttachments, *networkAttachment)
 		}
-		// After processing all Security Groups, add them to the PrimaryNetworkInterface.
-		primaryNetworkInterface.SecurityGroups = securityGroups
+		primaryNetworkInterface = nil
+		additionalNetworkInterfaces = nil
+		log.Info("Machine creation configured with virtual network interfaces", "deleteWithInstance", m.virtualNetworkInterfaceAutoDelete())
 	}
 
 	var resourceGroupIdentity *vpcv1.ResourceGroupIdentity
@@ -265,7 +692,7 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 	}
 
 	zone := &vpcv1.ZoneIdentity{
-		Name: &m.IBMVPCMachine.Spec.Zone,
+		Name: ptr.To(m.GetZone()),
 	}
 
 	// Populate Placement target details, if provided.
@@ -277,11 +704,20 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 		}
 	}
 
+	// Populate the reservation affinity, if a reservation is targeted.
+	var reservationAffinity *vpcv1.InstanceReservationAffinityPrototype
+	if m.IBMVPCMachine.Spec.PlacementTarget != nil && m.IBMVPCMachine.Spec.PlacementTarget.Reservation != nil {
+		reservationAffinity, err = m.configureReservationAffinity(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring machine reservation affinity: %w", err)
+		}
+	}
+
 	// Populate any SSH Keys, if provided.
 	sshKeys := make([]vpcv1.KeyIdentityIntf, 0)
 	if m.IBMVPCMachine.Spec.SSHKeys != nil {
 		for _, sshKey := range m.IBMVPCMachine.Spec.SSHKeys {
-			keyID, err := fetchKeyID(ctx, sshKey, m)
+			keyID, err := fetchKeyID(ctx, sshKey, m.IBMVPCClient)
 			if err != nil {
 				return nil, fmt.Errorf("error while fetching SSHKey: %v error: %v", sshKey, err)
 			}
@@ -295,11 +731,25 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 	// Populate boot volume attachment, if provided.
 	var bootVolumeAttachment *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext
 	if m.IBMVPCMachine.Spec.BootVolume != nil {
-		bootVolumeAttachment = m.volumeToVPCVolumeAttachment(ctx, m.IBMVPCMachine.Spec.BootVolume)
+		bootVolumeAttachment = volumeToVPCVolumeAttachment(ctx, m.IBMVPCMachine.Spec.BootVolume)
+	}
+
+	// Populate the availability class and policy, if provided.
+	availability, availabilityPolicy := m.buildAvailabilityPrototypes()
+
+	// Populate the metadata service and the default trusted profile, if provided.
+	metadataService, defaultTrustedProfile := m.buildMetadataPrototypes()
+
+	// Populate the security options, if provided and supported by the profile.
+	enableSecureBoot, confidentialComputeMode, err := m.configureSecurityOptions(ctx)
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "UnsupportedSecurityOptions", "Unsupported security options - %v", err)
+		return nil, fmt.Errorf("error configuring machine security options: %w", err)
 	}
 
-	// Configure the Machine's Image or CatalogOffering based on provided fields.
-	// If an Image was provided, use that, if a Catalog Offering was provided use that (based on details provided), otherwise return an error.
+	// Configure the Machine's Image, CatalogOffering or SourceSnapshot based on provided fields.
+	// If an Image was provided, use that, if a Catalog Offering was provided use that (based on details provided),
+	// if a Source Snapshot was provided boot from it, otherwise return an error.
 	if m.IBMVPCMachine.Spec.Image != nil {
 		imageInstancePrototype := &vpcv1.InstancePrototype{
 			Name:                    ptr.To(m.IBMVPCMachine.Name),
@@ -310,7 +760,7 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 			VPC:                     vpcIdentity,
 			Zone:                    zone,
 		}
-		imageID, err := fetchImageID(ctx, m.IBMVPCMachine.Spec.Image, m)
+		imageID, err := fetchImageID(ctx, m.IBMVPCMachine.Spec.Image, m.IBMVPCClient, m.IBMVPCCluster)
 		if err != nil {
 			record.Warnf(m.IBMVPCMachine, "FailedRetrieveImage", "Failed image retrieval - %w", err)
 			return nil, fmt.Errorf("error while fetching image ID: %w", err)
@@ -323,12 +773,42 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 		if placementTarget != nil {
 			imageInstancePrototype.PlacementTarget = placementTarget
 		}
+		if reservationAffinity != nil {
+			imageInstancePrototype.ReservationAffinity = reservationAffinity
+		}
 		if len(sshKeys) > 0 {
 			imageInstancePrototype.Keys = sshKeys
 		}
 		if bootVolumeAttachment != nil {
 			imageInstancePrototype.BootVolumeAttachment = bootVolumeAttachment
 		}
+		if availability != nil {
+			imageInstancePrototype.Availability = availability
+		}
+		if availabilityPolicy != nil {
+			imageInstancePrototype.AvailabilityPolicy = availabilityPolicy
+		}
+		if enableSecureBoot != nil {
+			imageInstancePrototype.EnableSecureBoot = enableSecureBoot
+		}
+		if confidentialComputeMode != nil {
+			imageInstancePrototype.ConfidentialComputeMode = confidentialComputeMode
+		}
+		if metadataService != nil {
+			imageInstancePrototype.MetadataService = metadataService
+		}
+		if defaultTrustedProfile != nil {
+			imageInstancePrototype.DefaultTrustedProfile = defaultTrustedProfile
+		}
+		if len(additionalNetworkInterfaces) > 0 {
+			imageInstancePrototype.NetworkInterfaces = additionalNetworkInterfaces
+		}
+		if primaryNetworkAttachment != nil {
+			imageInstancePrototype.PrimaryNetworkAttachment = primaryNetworkAttachment
+		}
+		if len(additionalNetworkAttachments) > 0 {
+			imageInstancePrototype.NetworkAttachments = additionalNetworkAttachments
+		}
 
 		log.Info("Machine creation configured with existing image", "imageID", *imageID)
 		options.SetInstancePrototype(imageInstancePrototype)
@@ -371,18 +851,107 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 		if placementTarget != nil {
 			catalogInstancePrototype.PlacementTarget = placementTarget
 		}
+		if reservationAffinity != nil {
+			catalogInstancePrototype.ReservationAffinity = reservationAffinity
+		}
 		if len(sshKeys) > 0 {
 			catalogInstancePrototype.Keys = sshKeys
 		}
 		if bootVolumeAttachment != nil {
 			catalogInstancePrototype.BootVolumeAttachment = bootVolumeAttachment
 		}
+		if availability != nil {
+			catalogInstancePrototype.Availability = availability
+		}
+		if availabilityPolicy != nil {
+			catalogInstancePrototype.AvailabilityPolicy = availabilityPolicy
+		}
+		if enableSecureBoot != nil {
+			catalogInstancePrototype.EnableSecureBoot = enableSecureBoot
+		}
+		if confidentialComputeMode != nil {
+			catalogInstancePrototype.ConfidentialComputeMode = confidentialComputeMode
+		}
+		if metadataService != nil {
+			catalogInstancePrototype.MetadataService = metadataService
+		}
+		if defaultTrustedProfile != nil {
+			catalogInstancePrototype.DefaultTrustedProfile = defaultTrustedProfile
+		}
+		if len(additionalNetworkInterfaces) > 0 {
+			catalogInstancePrototype.NetworkInterfaces = additionalNetworkInterfaces
+		}
+		if primaryNetworkAttachment != nil {
+			catalogInstancePrototype.PrimaryNetworkAttachment = primaryNetworkAttachment
+		}
+		if len(additionalNetworkAttachments) > 0 {
+			catalogInstancePrototype.NetworkAttachments = additionalNetworkAttachments
+		}
 
 		catalogInstancePrototype.CatalogOffering = catalogOfferingPrototype
 		options.SetInstancePrototype(catalogInstancePrototype)
+	} else if m.IBMVPCMachine.Spec.SourceSnapshot != nil {
+		snapshot, err := GetSnapshot(m.IBMVPCClient, m.IBMVPCMachine.Spec.SourceSnapshot)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedRetrieveSnapshot", "Failed snapshot retrieval - %v", err)
+			return nil, fmt.Errorf("error while fetching snapshot: %w", err)
+		}
+		if bootVolume := m.IBMVPCMachine.Spec.BootVolume; bootVolume != nil && bootVolume.SizeGiB != 0 && bootVolume.SizeGiB < ptr.Deref(snapshot.MinimumCapacity, 0) {
+			return nil, fmt.Errorf("error boot volume size %d GiB is smaller than the %d GiB of snapshot %s", bootVolume.SizeGiB, *snapshot.MinimumCapacity, *snapshot.ID)
+		}
+		snapshotInstancePrototype := &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
+			Name:                    ptr.To(m.IBMVPCMachine.Name),
+			Profile:                 profile,
+			PrimaryNetworkInterface: primaryNetworkInterface,
+			ResourceGroup:           resourceGroupIdentity,
+			UserData:                ptr.To(cloudInitData),
+			VPC:                     vpcIdentity,
+			Zone:                    zone,
+			BootVolumeAttachment:    snapshotToVPCVolumeAttachment(ctx, snapshot, m.IBMVPCMachine.Spec.BootVolume),
+		}
+
+		// Configure additional fields if they were populated.
+		if placementTarget != nil {
+			snapshotInstancePrototype.PlacementTarget = placementTarget
+		}
+		if reservationAffinity != nil {
+			snapshotInstancePrototype.ReservationAffinity = reservationAffinity
+		}
+		if len(sshKeys) > 0 {
+			snapshotInstancePrototype.Keys = sshKeys
+		}
+		if availability != nil {
+			snapshotInstancePrototype.Availability = availability
+		}
+		if availabilityPolicy != nil {
+			snapshotInstancePrototype.AvailabilityPolicy = availabilityPolicy
+		}
+		if enableSecureBoot != nil {
+			snapshotInstancePrototype.EnableSecureBoot = enableSecureBoot
+		}
+		if confidentialComputeMode != nil {
+			snapshotInstancePrototype.ConfidentialComputeMode = confidentialComputeMode
+		}
+		if metadataService != nil {
+			snapshotInstancePrototype.MetadataService = metadataService
+		}
+		if defaultTrustedProfile != nil {
+			snapshotInstancePrototype.DefaultTrustedProfile = defaultTrustedProfile
+		}
+		if len(additionalNetworkInterfaces) > 0 {
+			snapshotInstancePrototype.NetworkInterfaces = additionalNetworkInterfaces
+		}
+		if primaryNetworkAttachment != nil {
+			snapshotInstancePrototype.PrimaryNetworkAttachment = primaryNetworkAttachment
+		}
+		if len(additionalNetworkAttachments) > 0 {
+			snapshotInstancePrototype.NetworkAttachments = additionalNetworkAttachments
+		}
+
+		log.Info("Machine creation configured with source snapshot", "snapshotID", *snapshot.ID)
+		options.SetInstancePrototype(snapshotInstancePrototype)
 	} else {
-		// TODO(cjschaef): Move this to webhook validation.
-		return nil, fmt.Errorf("error no machine image or catalog offering provided to build: %s", m.IBMVPCMachine.Spec.Name)
+		return nil, fmt.Errorf("error no machine image, catalog offering or source snapshot provided to build: %s", m.IBMVPCMachine.Spec.Name)
 	}
 
 	log.Info("Creating instance", "createOptions", options, "name", m.IBMVPCMachine.Name, "profile", *profile.Name, "resourceGroup", resourceGroupIdentity, "vpc", vpcIdentity, "zone", zone)
@@ -422,7 +991,169 @@ func (m *MachineScope) configurePlacementTarget(ctx context.Context) (vpcv1.Inst
 	return nil, nil
 }
 
-func (m *MachineScope) volumeToVPCVolumeAttachment(ctx context.Context, volume *infrav1.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
+// configureReservationAffinity configures the reservation affinity of a Machine based on its targeted reservation.
+// A reservation identified by ID or name must have available capacity.
+func (m *MachineScope) configureReservationAffinity(ctx context.Context) (*vpcv1.InstanceReservationAffinityPrototype, error) {
+	log := ctrl.LoggerFrom(ctx)
+	target := m.IBMVPCMachine.Spec.PlacementTarget.Reservation
+	if target.AffinityPolicy == infrav1.VPCReservationAffinityPolicyAutomatic {
+		log.Info("Machine creation configured with automatic reservation affinity")
+		return &vpcv1.InstanceReservationAffinityPrototype{
+			Policy: ptr.To(vpcv1.InstanceReservationAffinityPrototypePolicyAutomaticConst),
+		}, nil
+	}
+
+	reservation, err := GetReservation(m.IBMVPCClient, target)
+	if err != nil {
+		return nil, err
+	}
+	if status := ReservationStatus(reservation); status.Exhausted {
+		record.Warnf(m.IBMVPCMachine, "ReservationExhausted", "Reservation %q is exhausted, in %s status with %d available instances", status.ID, status.Status, status.Available)
+		return nil, fmt.Errorf("error reservation %s is exhausted", status.ID)
+	}
+
+	log.Info("Machine creation configured with reservation", "reservationID", *reservation.ID)
+	return &vpcv1.InstanceReservationAffinityPrototype{
+		Policy: ptr.To(vpcv1.InstanceReservationAffinityPrototypePolicyManualConst),
+		Pool: []vpcv1.ReservationIdentityIntf{
+			&vpcv1.ReservationIdentityByID{
+				ID: reservation.ID,
+			},
+		},
+	}, nil
+}
+
+// buildMetadataPrototypes returns the metadata service configuration and the default trusted profile of the instance,
+// if provided.
+func (m *MachineScope) buildMetadataPrototypes() (*vpcv1.InstanceMetadataServicePrototype, *vpcv1.InstanceDefaultTrustedProfilePrototype) {
+	var metadataService *vpcv1.InstanceMetadataServicePrototype
+	if spec := m.IBMVPCMachine.Spec.MetadataService; spec != nil {
+		metadataService = &vpcv1.InstanceMetadataServicePrototype{
+			Enabled: ptr.To(spec.Enabled),
+		}
+		switch spec.Protocol {
+		case infrav1.VPCMetadataServiceProtocolHTTP:
+			metadataService.Protocol = ptr.To(vpcv1.InstanceMetadataServicePrototypeProtocolHTTPConst)
+		case infrav1.VPCMetadataServiceProtocolHTTPS:
+			metadataService.Protocol = ptr.To(vpcv1.InstanceMetadataServicePrototypeProtocolHTTPSConst)
+		}
+		if spec.ResponseHopLimit != 0 {
+			metadataService.ResponseHopLimit = ptr.To(spec.ResponseHopLimit)
+		}
+	}
+
+	var defaultTrustedProfile *vpcv1.InstanceDefaultTrustedProfilePrototype
+	if spec := m.IBMVPCMachine.Spec.DefaultTrustedProfile; spec != nil {
+		defaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
+			AutoLink: spec.AutoLink,
+		}
+		if spec.ID != nil {
+			defaultTrustedProfile.Target = &vpcv1.TrustedProfileIdentityByID{
+				ID: spec.ID,
+			}
+		} else {
+			defaultTrustedProfile.Target = &vpcv1.TrustedProfileIdentityByCRN{
+				CRN: spec.CRN,
+			}
+		}
+	}
+	return metadataService, defaultTrustedProfile
+}
+
+// vpcConfidentialComputeModes maps the confidential compute modes of a machine to the ones of its instance.
+var vpcConfidentialComputeModes = map[infrav1.VPCConfidentialComputeMode]string{
+	infrav1.VPCConfidentialComputeModeDisabled: vpcv1.InstanceConfidentialComputeModeDisabledConst,
+	infrav1.VPCConfidentialComputeModeSGX:      vpcv1.InstanceConfidentialComputeModeSgxConst,
+	infrav1.VPCConfidentialComputeModeTDX:      vpcv1.InstanceConfidentialComputeModeTdxConst,
+}
+
+// configureSecurityOptions returns the secure boot and the confidential compute mode of the instance, if provided,
+// once validated against the secure boot and confidential compute modes supported by its profile.
+func (m *MachineScope) configureSecurityOptions(ctx context.Context) (*bool, *string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	securityOptions := m.IBMVPCMachine.Spec.SecurityOptions
+	if securityOptions == nil || (securityOptions.SecureBoot == nil && securityOptions.ConfidentialComputeMode == "") {
+		return nil, nil, nil
+	}
+
+	profileName := m.IBMVPCMachine.Spec.Profile
+	profile, _, err := m.IBMVPCClient.GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{
+		Name: ptr.To(profileName),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving instance profile %s: %w", profileName, err)
+	}
+
+	if err := validateSecurityOptions(securityOptions, profileName, profile); err != nil {
+		return nil, nil, err
+	}
+	var confidentialComputeMode *string
+	if securityOptions.ConfidentialComputeMode != "" {
+		confidentialComputeMode = ptr.To(vpcConfidentialComputeModes[securityOptions.ConfidentialComputeMode])
+	}
+
+	log.Info("Machine creation configured with security options", "secureBoot", securityOptions.SecureBoot, "confidentialComputeMode", securityOptions.ConfidentialComputeMode)
+	return securityOptions.SecureBoot, confidentialComputeMode, nil
+}
+
+// validateSecurityOptions validates that the secure boot and the confidential compute mode of the security options
+// are supported by the instance profile named profileName.
+func validateSecurityOptions(securityOptions *infrav1.VPCSecurityOptions, profileName string, profile *vpcv1.InstanceProfile) error {
+	if securityOptions == nil {
+		return nil
+	}
+	if securityOptions.SecureBoot != nil && (profile.SecureBootModes == nil || !slices.Contains(profile.SecureBootModes.Values, *securityOptions.SecureBoot)) {
+		return fmt.Errorf("error secure boot set to %t is not supported by instance profile %s", *securityOptions.SecureBoot, profileName)
+	}
+	if securityOptions.ConfidentialComputeMode != "" {
+		mode := vpcConfidentialComputeModes[securityOptions.ConfidentialComputeMode]
+		if profile.ConfidentialComputeModes == nil || !slices.Contains(profile.ConfidentialComputeModes.Values, mode) {
+			return fmt.Errorf("error confidential compute mode %s is not supported by instance profile %s", securityOptions.ConfidentialComputeMode, profileName)
+		}
+	}
+	return nil
+}
+
+// buildAvailabilityPrototypes returns the availability class and policy of the instance, if provided. Spot instances
+// are stopped, rather than deleted, when preempted, so that their machine is marked as failed instead of the
+// instance being recreated.
+func (m *MachineScope) buildAvailabilityPrototypes() (*vpcv1.InstanceAvailabilityPrototype, *vpcv1.InstanceAvailabilityPolicyPrototype) {
+	policy := m.IBMVPCMachine.Spec.AvailabilityPolicy
+	if policy == nil {
+		return nil, nil
+	}
+
+	var availability *vpcv1.InstanceAvailabilityPrototype
+	var availabilityPolicy *vpcv1.InstanceAvailabilityPolicyPrototype
+	switch policy.Class {
+	case infrav1.VPCAvailabilityClassStandard:
+		availability = &vpcv1.InstanceAvailabilityPrototype{
+			Class: ptr.To(vpcv1.InstanceAvailabilityPrototypeClassStandardConst),
+		}
+	case infrav1.VPCAvailabilityClassSpot:
+		availability = &vpcv1.InstanceAvailabilityPrototype{
+			Class: ptr.To(vpcv1.InstanceAvailabilityPrototypeClassSpotConst),
+		}
+		availabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
+			Preemption: ptr.To(vpcv1.InstanceAvailabilityPolicyPrototypePreemptionStopConst),
+		}
+	}
+	switch policy.HostFailure {
+	case infrav1.VPCHostFailurePolicyRestart:
+		if availabilityPolicy == nil {
+			availabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{}
+		}
+		availabilityPolicy.HostFailure = ptr.To(vpcv1.InstanceAvailabilityPolicyPrototypeHostFailureRestartConst)
+	case infrav1.VPCHostFailurePolicyStop:
+		if availabilityPolicy == nil {
+			availabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{}
+		}
+		availabilityPolicy.HostFailure = ptr.To(vpcv1.InstanceAvailabilityPolicyPrototypeHostFailureStopConst)
+	}
+	return availability, availabilityPolicy
+}
+
+func volumeToVPCVolumeAttachment(ctx context.Context, volume *infrav1.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
 	log := ctrl.LoggerFrom(ctx)
 	bootVolume := &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
 		DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
@@ -433,48 +1164,641 @@ func (m *MachineScope) volumeToVPCVolumeAttachment(ctx context.Context, volume *
 		bootVolume.Name = core.StringPtr(volume.Name)
 	}
 
-	if volume.Profile != "" {
-		bootVolume.Volume.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: core.StringPtr(volume.Profile),
+	if volume.Profile != "" {
+		bootVolume.Volume.Profile = &vpcv1.VolumeProfileIdentity{
+			Name: core.StringPtr(volume.Profile),
+		}
+	}
+
+	if volume.SizeGiB != 0 {
+		bootVolume.Volume.Capacity = core.Int64Ptr(volume.SizeGiB)
+	}
+
+	if volume.Iops != 0 {
+		bootVolume.Volume.Iops = core.Int64Ptr(volume.Iops)
+	}
+
+	if volume.Bandwidth != 0 {
+		bootVolume.Volume.Bandwidth = core.Int64Ptr(volume.Bandwidth)
+	}
+
+	if volume.EncryptionKeyCRN != "" {
+		bootVolume.Volume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: core.StringPtr(volume.EncryptionKeyCRN),
+		}
+		log.Info("Machine creation configured with volumn encryption key", "encryptionKeyCRN", volume.EncryptionKeyCRN)
+	}
+
+	return bootVolume
+}
+
+// snapshotToVPCVolumeAttachment returns the attachment of the boot volume created from the snapshot, configured like
+// the boot volume of the machine, if any. The boot volume is resized when its size is larger than the snapshot.
+func snapshotToVPCVolumeAttachment(ctx context.Context, snapshot *vpcv1.Snapshot, volume *infrav1.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext {
+	bootVolume := &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
+		DeleteVolumeOnInstanceDelete: core.BoolPtr(true),
+		Volume: &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{
+			// The profile of a volume created from a snapshot has no default.
+			Profile: &vpcv1.VolumeProfileIdentity{
+				Name: core.StringPtr("general-purpose"),
+			},
+			SourceSnapshot: &vpcv1.SnapshotIdentityByID{
+				ID: snapshot.ID,
+			},
+		},
+	}
+	if volume == nil {
+		return bootVolume
+	}
+
+	// Reuse the configuration of a boot volume created from an image.
+	imageBootVolume := volumeToVPCVolumeAttachment(ctx, volume)
+	bootVolume.DeleteVolumeOnInstanceDelete = imageBootVolume.DeleteVolumeOnInstanceDelete
+	bootVolume.Name = imageBootVolume.Name
+	if imageBootVolume.Volume.Profile != nil {
+		bootVolume.Volume.Profile = imageBootVolume.Volume.Profile
+	}
+	bootVolume.Volume.Capacity = imageBootVolume.Volume.Capacity
+	bootVolume.Volume.Iops = imageBootVolume.Volume.Iops
+	bootVolume.Volume.Bandwidth = imageBootVolume.Volume.Bandwidth
+	bootVolume.Volume.EncryptionKey = imageBootVolume.Volume.EncryptionKey
+	return bootVolume
+}
+
+// DeleteMachine deletes the vpc machine associated with machine instance id.
+func (m *MachineScope) DeleteMachine() error {
+	if m.IBMVPCMachine.Status.InstanceID == "" {
+		return m.deletePrimaryReservedIP()
+	}
+	if m.usesVirtualNetworkInterfaces() {
+		if err := m.reconcileVirtualNetworkInterfacesDeletion(); err != nil {
+			return err
+		}
+	}
+	if err := m.reconcilePrimaryReservedIPDeletion(); err != nil {
+		return err
+	}
+	options := &vpcv1.DeleteInstanceOptions{}
+	options.SetID(m.IBMVPCMachine.Status.InstanceID)
+	_, err := m.IBMVPCClient.DeleteInstance(options)
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
+	} else {
+		record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteInstance", "Deleted Instance %q", m.IBMVPCMachine.Name)
+	}
+	return err
+}
+
+// reconcileVirtualNetworkInterfacesDeletion ensures the virtual network interfaces attached to the machine's instance are
+// deleted or retained with the instance according to the deletion policy, which may have changed since their creation.
+// The retained ones are renamed after the retained name prefix, so that a replacing machine can attach them again, and
+// tagged with the cluster, so that they are deleted with it.
+func (m *MachineScope) reconcileVirtualNetworkInterfacesDeletion() error {
+	instance, _, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: ptr.To(m.IBMVPCMachine.Status.InstanceID),
+	})
+	if err != nil {
+		return fmt.Errorf("error retrieving instance %s for machine %s: %w", m.IBMVPCMachine.Status.InstanceID, m.IBMVPCMachine.Name, err)
+	}
+	var primaryAttachmentID string
+	if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.ID != nil {
+		primaryAttachmentID = *instance.PrimaryNetworkAttachment.ID
+	}
+	autoDelete := m.virtualNetworkInterfaceAutoDelete()
+	index := 0
+	for _, attachment := range instance.NetworkAttachments {
+		if attachment.VirtualNetworkInterface == nil || attachment.VirtualNetworkInterface.ID == nil {
+			continue
+		}
+		// The primary network interface is at index 0, the others follow in the order of their attachments.
+		attachmentIndex := 0
+		if attachment.ID == nil || *attachment.ID != primaryAttachmentID {
+			index++
+			attachmentIndex = index
+		}
+		vni, _, err := m.IBMVPCClient.GetVirtualNetworkInterface(&vpcv1.GetVirtualNetworkInterfaceOptions{
+			ID: attachment.VirtualNetworkInterface.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error retrieving virtual network interface %s for machine %s: %w", *attachment.VirtualNetworkInterface.ID, m.IBMVPCMachine.Name, err)
+		}
+		vniPatch := &vpcv1.VirtualNetworkInterfacePatch{}
+		if vni.AutoDelete == nil || *vni.AutoDelete != autoDelete {
+			vniPatch.AutoDelete = ptr.To(autoDelete)
+		}
+		if prefix, retained := m.retainedVirtualNetworkInterfacePrefix(attachmentIndex); retained && (vni.Name == nil || !isRetainedVirtualNetworkInterfaceName(*vni.Name, prefix)) {
+			vniPatch.Name = ptr.To(prefix + util.RandomString(retainedVirtualNetworkInterfaceSuffixLength))
+		}
+		if vniPatch.AutoDelete != nil || vniPatch.Name != nil {
+			if err := m.updateVirtualNetworkInterface(*vni.ID, vniPatch); err != nil {
+				return err
+			}
+		}
+		// The tag of the cluster records that the retained virtual network interface is deleted with the cluster.
+		if !autoDelete && vni.CRN != nil {
+			if err := m.TagResource(m.IBMVPCCluster.Name, *vni.CRN); err != nil {
+				return fmt.Errorf("error tagging virtual network interface %s for machine %s: %w", *vni.ID, m.IBMVPCMachine.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcilePrimaryReservedIPDeletion ensures the reserved IP created for the primary network interface of the machine is
+// deleted with its instance, unless it stays bound to a retained virtual network interface.
+func (m *MachineScope) reconcilePrimaryReservedIPDeletion() error {
+	status := m.IBMVPCMachine.Status.PrimaryReservedIP
+	if status == nil {
+		return nil
+	}
+	if !m.usesVirtualNetworkInterfaces() || m.virtualNetworkInterfaceAutoDelete() {
+		patch, err := (&vpcv1.ReservedIPPatch{AutoDelete: ptr.To(true)}).AsPatch()
+		if err != nil {
+			return fmt.Errorf("error building reserved IP patch for machine %s: %w", m.IBMVPCMachine.Name, err)
+		}
+		_, detailedResponse, err := m.IBMVPCClient.UpdateSubnetReservedIP(&vpcv1.UpdateSubnetReservedIPOptions{
+			SubnetID:        ptr.To(status.SubnetID),
+			ID:              ptr.To(status.ID),
+			ReservedIPPatch: patch,
+		})
+		if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			return fmt.Errorf("error updating reserved IP %s for machine %s: %w", status.ID, m.IBMVPCMachine.Name, err)
+		}
+	}
+	m.IBMVPCMachine.Status.PrimaryReservedIP = nil
+	return nil
+}
+
+// deletePrimaryReservedIP deletes the reserved IP created for the primary network interface of the machine when no
+// instance was created for it.
+func (m *MachineScope) deletePrimaryReservedIP() error {
+	status := m.IBMVPCMachine.Status.PrimaryReservedIP
+	if status == nil {
+		return nil
+	}
+	detailedResponse, err := m.IBMVPCClient.DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{
+		SubnetID: ptr.To(status.SubnetID),
+		ID:       ptr.To(status.ID),
+	})
+	if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteReservedIP", "Failed reserved IP deletion - %v", err)
+		return fmt.Errorf("error deleting reserved IP %s for machine %s: %w", status.ID, m.IBMVPCMachine.Name, err)
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteReservedIP", "Deleted reserved IP %q", status.ID)
+	m.IBMVPCMachine.Status.PrimaryReservedIP = nil
+	return nil
+}
+
+// ReconcileFloatingIP binds the floating IP requested for the machine to the primary network interface of its instance,
+// creating the floating IP if it does not exist, and reports its address as an ExternalIP address of the machine.
+func (m *MachineScope) ReconcileFloatingIP(ctx context.Context, instance *vpcv1.Instance) error {
+	log := ctrl.LoggerFrom(ctx)
+	if m.IBMVPCMachine.Spec.FloatingIP == nil {
+		if m.IBMVPCMachine.Status.FloatingIP == nil {
+			return nil
+		}
+		if err := m.releaseFloatingIP(instance); err != nil {
+			return err
+		}
+		m.SetAddresses(instance)
+		return nil
+	}
+	targetID, isVirtualNetworkInterface := primaryNetworkTargetID(instance)
+	if targetID == nil {
+		return fmt.Errorf("error no primary network interface found for machine %s", m.IBMVPCMachine.Name)
+	}
+
+	floatingIP, controllerCreated, err := m.getFloatingIP()
+	if err != nil {
+		return err
+	}
+	if floatingIP != nil && m.IBMVPCMachine.Status.FloatingIP != nil && !m.isSpecFloatingIP(floatingIP) {
+		// The floating IP of the spec changed, release the previous one before binding the new one.
+		if err := m.releaseFloatingIP(instance); err != nil {
+			return err
+		}
+		if floatingIP, controllerCreated, err = m.getFloatingIP(); err != nil {
+			return err
+		}
+	}
+	if floatingIP == nil {
+		if m.IBMVPCMachine.Spec.FloatingIP.ID != nil {
+			return fmt.Errorf("error floating IP %s not found for machine %s", *m.IBMVPCMachine.Spec.FloatingIP.ID, m.IBMVPCMachine.Name)
+		}
+		floatingIP, err = m.createFloatingIP(targetID, isVirtualNetworkInterface)
+		if err != nil {
+			return err
+		}
+		controllerCreated = true
+		log.Info("Created floating IP", "floatingIPID", *floatingIP.ID, "address", *floatingIP.Address)
+	} else if target, ok := floatingIP.Target.(*vpcv1.FloatingIPTarget); !ok || target.ID == nil || *target.ID != *targetID {
+		if ok && target.ID != nil {
+			return fmt.Errorf("error floating IP %s for machine %s is bound to another resource %s", *floatingIP.ID, m.IBMVPCMachine.Name, *target.ID)
+		}
+		floatingIP, err = m.bindFloatingIP(*floatingIP.ID, targetID, isVirtualNetworkInterface)
+		if err != nil {
+			return err
+		}
+		log.Info("Bound floating IP", "floatingIPID", *floatingIP.ID, "address", *floatingIP.Address)
+	}
+
+	m.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{
+		ID:                *floatingIP.ID,
+		Address:           ptr.Deref(floatingIP.Address, ""),
+		ControllerCreated: ptr.To(controllerCreated),
+	}
+	m.SetAddresses(instance)
+	return nil
+}
+
+// primaryNetworkTargetID returns the ID of the primary network interface of an instance, or of the virtual network
+// interface of its primary network attachment, and whether it is a virtual network interface.
+func primaryNetworkTargetID(instance *vpcv1.Instance) (*string, bool) {
+	if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.VirtualNetworkInterface != nil {
+		return instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID, true
+	}
+	if instance.PrimaryNetworkInterface != nil {
+		return instance.PrimaryNetworkInterface.ID, false
+	}
+	return nil, false
+}
+
+// getFloatingIP returns the floating IP of the machine if it exists, and whether it was created by the controller.
+// The ownership is recorded in the status, and with the tag of the cluster attached to the floating IP when it is
+// created, so that an existing floating IP found by its name is only released with the machine if it was created by
+// the controller.
+func (m *MachineScope) getFloatingIP() (*vpcv1.FloatingIP, bool, error) {
+	if status := m.IBMVPCMachine.Status.FloatingIP; status != nil {
+		floatingIP, detailedResponse, err := m.IBMVPCClient.GetFloatingIP(&vpcv1.GetFloatingIPOptions{
+			ID: ptr.To(status.ID),
+		})
+		if err == nil {
+			return floatingIP, ptr.Deref(status.ControllerCreated, false), nil
+		} else if detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound {
+			return nil, false, fmt.Errorf("error retrieving floating IP %s for machine %s: %w", status.ID, m.IBMVPCMachine.Name, err)
+		}
+	}
+
+	if id := m.IBMVPCMachine.Spec.FloatingIP.ID; id != nil {
+		floatingIP, detailedResponse, err := m.IBMVPCClient.GetFloatingIP(&vpcv1.GetFloatingIPOptions{
+			ID: id,
+		})
+		if err != nil {
+			if detailedResponse != nil && detailedResponse.StatusCode == http.StatusNotFound {
+				return nil, false, nil
+			}
+			return nil, false, fmt.Errorf("error retrieving floating IP %s for machine %s: %w", *id, m.IBMVPCMachine.Name, err)
+		}
+		return floatingIP, false, nil
+	}
+
+	name := ptr.Deref(m.IBMVPCMachine.Spec.FloatingIP.Name, m.IBMVPCMachine.Name)
+	floatingIP, err := m.IBMVPCClient.GetFloatingIPByName(name)
+	if err != nil {
+		return nil, false, fmt.Errorf("error retrieving floating IP %s for machine %s: %w", name, m.IBMVPCMachine.Name, err)
+	}
+	if floatingIP == nil || floatingIP.CRN == nil {
+		return floatingIP, false, nil
+	}
+	tags, err := m.GlobalTaggingClient.GetAttachedTags(*floatingIP.CRN)
+	if err != nil {
+		return nil, false, fmt.Errorf("error retrieving tags of floating IP %s for machine %s: %w", name, m.IBMVPCMachine.Name, err)
+	}
+	return floatingIP, slices.Contains(tags, m.IBMVPCCluster.Name), nil
+}
+
+// isSpecFloatingIP returns whether the floating IP is the one requested in the spec of the machine.
+func (m *MachineScope) isSpecFloatingIP(floatingIP *vpcv1.FloatingIP) bool {
+	if id := m.IBMVPCMachine.Spec.FloatingIP.ID; id != nil {
+		return ptr.Deref(floatingIP.ID, "") == *id
+	}
+	return ptr.Deref(floatingIP.Name, "") == ptr.Deref(m.IBMVPCMachine.Spec.FloatingIP.Name, m.IBMVPCMachine.Name)
+}
+
+// releaseFloatingIP releases the floating IP of the machine once it is removed or replaced in the spec: it is deleted if
+// it was created by the controller, or unbound from the primary network interface of the instance otherwise.
+func (m *MachineScope) releaseFloatingIP(instance *vpcv1.Instance) error {
+	status := m.IBMVPCMachine.Status.FloatingIP
+	if status == nil {
+		return nil
+	}
+	if ptr.Deref(status.ControllerCreated, false) {
+		return m.DeleteFloatingIP()
+	}
+
+	targetID, isVirtualNetworkInterface := primaryNetworkTargetID(instance)
+	if targetID != nil {
+		var detailedResponse *core.DetailedResponse
+		var err error
+		if isVirtualNetworkInterface {
+			detailedResponse, err = m.IBMVPCClient.RemoveNetworkInterfaceFloatingIP(&vpcv1.RemoveNetworkInterfaceFloatingIPOptions{
+				VirtualNetworkInterfaceID: targetID,
+				ID:                        ptr.To(status.ID),
+			})
+		} else {
+			detailedResponse, err = m.IBMVPCClient.RemoveInstanceNetworkInterfaceFloatingIP(&vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions{
+				InstanceID:         instance.ID,
+				NetworkInterfaceID: targetID,
+				ID:                 ptr.To(status.ID),
+			})
+		}
+		// A floating IP not bound to the network interface anymore is not found.
+		if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			record.Warnf(m.IBMVPCMachine, "FailedUnbindFloatingIP", "Failed floating IP unbinding - %v", err)
+			return fmt.Errorf("error unbinding floating IP %s from machine %s: %w", status.ID, m.IBMVPCMachine.Name, err)
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulUnbindFloatingIP", "Unbound floating IP %q", status.ID)
+	}
+	m.IBMVPCMachine.Status.FloatingIP = nil
+	return nil
+}
+
+// createFloatingIP creates the floating IP of the machine bound to the target network interface.
+func (m *MachineScope) createFloatingIP(targetID *string, isVirtualNetworkInterface bool) (*vpcv1.FloatingIP, error) {
+	var target vpcv1.FloatingIPTargetPrototypeIntf = &vpcv1.FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID{
+		ID: targetID,
+	}
+	if isVirtualNetworkInterface {
+		target = &vpcv1.FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID{
+			ID: targetID,
+		}
+	}
+	resourceGroupID := m.IBMVPCCluster.Spec.ResourceGroup
+	if m.IBMVPCCluster.Status.ResourceGroup != nil {
+		resourceGroupID = m.IBMVPCCluster.Status.ResourceGroup.ID
+	}
+
+	floatingIP, _, err := m.IBMVPCClient.CreateFloatingIP(&vpcv1.CreateFloatingIPOptions{
+		FloatingIPPrototype: &vpcv1.FloatingIPPrototypeFloatingIPByTarget{
+			Name: ptr.To(ptr.Deref(m.IBMVPCMachine.Spec.FloatingIP.Name, m.IBMVPCMachine.Name)),
+			ResourceGroup: &vpcv1.ResourceGroupIdentityByID{
+				ID: ptr.To(resourceGroupID),
+			},
+			Target: target,
+		},
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedCreateFloatingIP", "Failed floating IP creation - %v", err)
+		return nil, fmt.Errorf("error creating floating IP for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulCreateFloatingIP", "Created floating IP %q", *floatingIP.Name)
+	// The tag of the cluster records that the floating IP was created by the controller, in case its status is lost.
+	if err := m.TagResource(m.IBMVPCCluster.Name, *floatingIP.CRN); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedTagFloatingIP", "Failed floating IP tagging - %v", err)
+	}
+	if tags := m.AdditionalTags(); len(tags) > 0 {
+		if err := reconcileAttachedTags(m.GlobalTaggingClient, []string{*floatingIP.CRN}, tags, nil); err != nil {
+			// Attach the additional tags to all the resources of the machine again on the next reconciliation.
+			record.Warnf(m.IBMVPCMachine, "FailedTagFloatingIP", "Failed floating IP tagging - %v", err)
+			m.IBMVPCMachine.Status.AttachedTags = nil
+		}
+	}
+	return floatingIP, nil
+}
+
+// bindFloatingIP binds an existing floating IP to the target network interface of the machine.
+func (m *MachineScope) bindFloatingIP(id string, targetID *string, isVirtualNetworkInterface bool) (*vpcv1.FloatingIP, error) {
+	var target vpcv1.FloatingIPTargetPatchIntf = &vpcv1.FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByID{
+		ID: targetID,
+	}
+	if isVirtualNetworkInterface {
+		target = &vpcv1.FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID{
+			ID: targetID,
+		}
+	}
+	patch, err := (&vpcv1.FloatingIPPatch{Target: target}).AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("error building floating IP patch for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	floatingIP, _, err := m.IBMVPCClient.UpdateFloatingIP(&vpcv1.UpdateFloatingIPOptions{
+		ID:              ptr.To(id),
+		FloatingIPPatch: patch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error binding floating IP %s to machine %s: %w", id, m.IBMVPCMachine.Name, err)
+	}
+	return floatingIP, nil
+}
+
+// DeleteFloatingIP releases the floating IP of the machine if it was created by the controller.
+func (m *MachineScope) DeleteFloatingIP() error {
+	status := m.IBMVPCMachine.Status.FloatingIP
+	if status == nil || !ptr.Deref(status.ControllerCreated, false) {
+		return nil
+	}
+	detailedResponse, err := m.IBMVPCClient.DeleteFloatingIP(&vpcv1.DeleteFloatingIPOptions{
+		ID: ptr.To(status.ID),
+	})
+	if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteFloatingIP", "Failed floating IP deletion - %v", err)
+		return fmt.Errorf("error deleting floating IP %s for machine %s: %w", status.ID, m.IBMVPCMachine.Name, err)
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteFloatingIP", "Deleted floating IP %q", status.ID)
+	m.IBMVPCMachine.Status.FloatingIP = nil
+	return nil
+}
+
+// ReconcileFileShareMounts creates a mount target for each file share of the cluster mounted on the machine, in the
+// subnet and with the security groups of its primary network interface, and deletes the mount targets of the file
+// shares removed from the spec. The file shares with the VPC access control mode are mounted through the mount target
+// of the cluster. It returns whether some mount targets are not stable yet.
+func (m *MachineScope) ReconcileFileShareMounts(ctx context.Context, instance *vpcv1.Instance) (bool, error) {
+	mounts := m.IBMVPCMachine.Spec.FileShareMounts
+	if len(mounts) == 0 && len(m.IBMVPCMachine.Status.FileShareMounts) == 0 {
+		return false, nil
+	}
+
+	// Delete the mount targets of the file shares removed from the spec.
+	currentStatuses := make(map[string]infrav1.VPCFileShareMountStatus, len(m.IBMVPCMachine.Status.FileShareMounts))
+	for _, status := range m.IBMVPCMachine.Status.FileShareMounts {
+		if slices.ContainsFunc(mounts, func(mount infrav1.VPCFileShareMount) bool { return mount.Share == status.Share }) {
+			currentStatuses[status.Share] = status
+			continue
+		}
+		if err := m.deleteFileShareMountTarget(status); err != nil {
+			return false, err
+		}
+	}
+
+	pending := false
+	statuses := make([]infrav1.VPCFileShareMountStatus, 0, len(mounts))
+	for _, mount := range mounts {
+		status, err := m.reconcileFileShareMount(ctx, instance, mount, currentStatuses[mount.Share])
+		if err != nil {
+			return false, err
+		}
+		statuses = append(statuses, status)
+		pending = pending || !status.Ready
+	}
+	m.IBMVPCMachine.Status.FileShareMounts = statuses
+	return pending, nil
+}
+
+// reconcileFileShareMount returns the status of a file share mounted on the machine, once the file share of the
+// cluster is ready.
+func (m *MachineScope) reconcileFileShareMount(ctx context.Context, instance *vpcv1.Instance, mount infrav1.VPCFileShareMount, status infrav1.VPCFileShareMountStatus) (infrav1.VPCFileShareMountStatus, error) {
+	log := ctrl.LoggerFrom(ctx)
+	status.Share = mount.Share
+	status.Ready = false
+	index := slices.IndexFunc(m.IBMVPCCluster.Spec.FileShares, func(fileShare infrav1.VPCFileShare) bool { return fileShare.Name == mount.Share })
+	if index < 0 {
+		return status, fmt.Errorf("error file share %s mounted on machine %s is not a file share of the cluster", mount.Share, m.IBMVPCMachine.Name)
+	}
+	if zone := m.IBMVPCCluster.Spec.FileShares[index].Zone; instance.Zone != nil && ptr.Deref(instance.Zone.Name, "") != zone {
+		return status, fmt.Errorf("error file share %s in zone %s cannot be mounted on machine %s in zone %s", mount.Share, zone, m.IBMVPCMachine.Name, ptr.Deref(instance.Zone.Name, ""))
+	}
+	fileShare := m.IBMVPCCluster.Status.FileShares[mount.Share]
+	if fileShare == nil || !fileShare.Ready {
+		log.V(3).Info("Waiting for the file share of the cluster to be ready", "fileShareName", mount.Share)
+		return status, nil
+	}
+
+	status.ShareID = fileShare.ID
+	if fileShare.AccessControlMode == infrav1.VPCFileShareAccessControlModeVPC {
+		status.MountPath = fileShare.MountPath
+		status.Ready = true
+		return status, nil
+	}
+	mountTarget, err := m.getOrCreateFileShareMountTarget(ctx, instance, status)
+	if err != nil {
+		return status, err
+	}
+	status.MountTargetID = *mountTarget.ID
+	status.MountPath = ptr.Deref(mountTarget.MountPath, "")
+	status.Ready = ptr.Deref(mountTarget.LifecycleState, "") == vpcv1.ShareMountTargetLifecycleStateStableConst
+	return status, nil
+}
+
+// getOrCreateFileShareMountTarget returns the mount target of a file share for the machine, named after it, which is
+// created with a virtual network interface in the subnet and with the security groups of the primary network
+// interface of the instance if it does not exist.
+func (m *MachineScope) getOrCreateFileShareMountTarget(ctx context.Context, instance *vpcv1.Instance, status infrav1.VPCFileShareMountStatus) (*vpcv1.ShareMountTarget, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if status.MountTargetID != "" {
+		mountTarget, detailedResponse, err := m.IBMVPCClient.GetShareMountTarget(&vpcv1.GetShareMountTargetOptions{
+			ShareID: ptr.To(status.ShareID),
+			ID:      ptr.To(status.MountTargetID),
+		})
+		if err == nil {
+			return mountTarget, nil
 		}
+		if detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("error retrieving mount target %s of file share %s for machine %s: %w", status.MountTargetID, status.Share, m.IBMVPCMachine.Name, err)
+		}
+		log.Info("File share mount target not found, creating a new one", "mountTargetID", status.MountTargetID, "fileShareName", status.Share)
 	}
 
-	if volume.SizeGiB != 0 {
-		bootVolume.Volume.Capacity = core.Int64Ptr(volume.SizeGiB)
+	name := m.IBMVPCMachine.Name
+	mountTarget, err := m.IBMVPCClient.GetShareMountTargetByName(status.ShareID, name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving mount target of file share %s for machine %s: %w", status.Share, m.IBMVPCMachine.Name, err)
+	} else if mountTarget != nil {
+		return mountTarget, nil
 	}
 
-	if volume.Iops != 0 {
-		bootVolume.Volume.Iops = core.Int64Ptr(volume.Iops)
+	var subnetID *string
+	if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.Subnet != nil {
+		subnetID = instance.PrimaryNetworkAttachment.Subnet.ID
+	} else if instance.PrimaryNetworkInterface != nil && instance.PrimaryNetworkInterface.Subnet != nil {
+		subnetID = instance.PrimaryNetworkInterface.Subnet.ID
+	}
+	if subnetID == nil {
+		return nil, fmt.Errorf("error no subnet found for the mount target of file share %s for machine %s", status.Share, m.IBMVPCMachine.Name)
+	}
+	securityGroups, err := m.getSecurityGroupIdentities(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups)
+	if err != nil {
+		return nil, err
 	}
 
-	if volume.Bandwidth != 0 {
-		bootVolume.Volume.Bandwidth = core.Int64Ptr(volume.Bandwidth)
+	log.Info("Creating file share mount target", "fileShareName", status.Share, "mountTargetName", name, "subnetID", *subnetID)
+	mountTarget, _, err = m.IBMVPCClient.CreateShareMountTarget(&vpcv1.CreateShareMountTargetOptions{
+		ShareID: ptr.To(status.ShareID),
+		ShareMountTargetPrototype: &vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup{
+			Name:              ptr.To(name),
+			AccessProtocol:    ptr.To(vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroupAccessProtocolNfs4Const),
+			TransitEncryption: ptr.To(vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroupTransitEncryptionNoneConst),
+			VirtualNetworkInterface: &vpcv1.ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext{
+				Subnet: &vpcv1.SubnetIdentityByID{
+					ID: subnetID,
+				},
+				SecurityGroups: securityGroups,
+			},
+		},
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedCreateFileShareMountTarget", "Failed mount target creation of file share %s - %v", status.Share, err)
+		return nil, fmt.Errorf("error creating mount target of file share %s for machine %s: %w", status.Share, m.IBMVPCMachine.Name, err)
 	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulCreateFileShareMountTarget", "Created mount target %q of file share %q", *mountTarget.ID, status.Share)
+	return mountTarget, nil
+}
 
-	if volume.EncryptionKeyCRN != "" {
-		bootVolume.Volume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-			CRN: core.StringPtr(volume.EncryptionKeyCRN),
+// DeleteFileShareMounts deletes the mount targets of the file shares created for the machine.
+func (m *MachineScope) DeleteFileShareMounts() error {
+	for _, status := range m.IBMVPCMachine.Status.FileShareMounts {
+		if err := m.deleteFileShareMountTarget(status); err != nil {
+			return err
 		}
-		log.Info("Machine creation configured with volumn encryption key", "encryptionKeyCRN", volume.EncryptionKeyCRN)
 	}
+	m.IBMVPCMachine.Status.FileShareMounts = nil
+	return nil
+}
 
-	return bootVolume
+// deleteFileShareMountTarget deletes the mount target of a file share created for the machine, if any.
+func (m *MachineScope) deleteFileShareMountTarget(status infrav1.VPCFileShareMountStatus) error {
+	if status.MountTargetID == "" {
+		return nil
+	}
+	_, detailedResponse, err := m.IBMVPCClient.DeleteShareMountTarget(&vpcv1.DeleteShareMountTargetOptions{
+		ShareID: ptr.To(status.ShareID),
+		ID:      ptr.To(status.MountTargetID),
+	})
+	if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteFileShareMountTarget", "Failed mount target deletion of file share %s - %v", status.Share, err)
+		return fmt.Errorf("error deleting mount target %s of file share %s for machine %s: %w", status.MountTargetID, status.Share, m.IBMVPCMachine.Name, err)
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteFileShareMountTarget", "Deleted mount target %q of file share %q", status.MountTargetID, status.Share)
+	return nil
 }
 
-// DeleteMachine deletes the vpc machine associated with machine instance id.
-func (m *MachineScope) DeleteMachine() error {
-	if m.IBMVPCMachine.Status.InstanceID == "" {
+// DeleteMachineIgnition deletes the ignition data associated with the machine from the COS bucket.
+func (m *MachineScope) DeleteMachineIgnition(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if !m.useIgnition() {
+		log.V(3).Info("Machine is not using user data of type ignition")
 		return nil
 	}
-	options := &vpcv1.DeleteInstanceOptions{}
-	options.SetID(m.IBMVPCMachine.Status.InstanceID)
-	_, err := m.IBMVPCClient.DeleteInstance(options)
-	if err != nil {
-		record.Warnf(m.IBMVPCMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
-	} else {
-		record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteInstance", "Deleted Instance %q", m.IBMVPCMachine.Name)
+
+	if m.COSClient == nil {
+		cosClient, err := m.createCOSClient(ctx)
+		// Without the HMAC secret the bootstrap data cannot be deleted, so consider it deleted rather than block
+		// the deletion of the machine.
+		if apierrors.IsNotFound(err) {
+			log.Info("COS HMAC secret not found, skipping machine ignition deletion", "secret", m.IBMVPCCluster.Spec.Ignition.COSInstance.HMACSecretName)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create COS client for ignition deletion: %w", err)
+		}
+		m.COSClient = cosClient
+	}
+
+	bucket := m.IBMVPCCluster.Spec.Ignition.COSInstance.BucketName
+	key := m.bootstrapDataKey()
+	if _, err := m.COSClient.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: ptr.To(bucket),
+		Key:    ptr.To(key),
+	}); err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && (aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == s3.ErrCodeNoSuchBucket) {
+			log.V(3).Info("Machine ignition already deleted", "bucket", bucket, "key", key)
+			return nil
+		}
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteMachineIgnition", "Failed machine ignition deletion - %v", err)
+		return fmt.Errorf("failed to delete COS object %s from bucket %s: %w", key, bucket, err)
 	}
-	return err
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteMachineIgnition", "Deleted machine ignition %q", m.IBMVPCMachine.Name)
+	return nil
 }
 
 func (m *MachineScope) ensureInstanceUnique(instanceName string) (*vpcv1.Instance, error) {
@@ -914,7 +2238,167 @@ func (m *MachineScope) GetBootstrapData() (string, error) {
 	return string(value), nil
 }
 
-func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+// resolveUserData returns the user data for the instance. When Ignition is configured, the bootstrap
+// data is uploaded to COS and an Ignition config pointing to it is returned instead.
+func (m *MachineScope) resolveUserData(ctx context.Context) (string, error) {
+	userData, err := m.GetBootstrapData()
+	if err != nil {
+		return "", err
+	}
+
+	if !m.useIgnition() {
+		return userData, nil
+	}
+
+	data, err := m.ignitionUserData(ctx, []byte(userData))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ignitionUserData uploads the bootstrap data to COS and wraps the resulting
+// pre-signed URL in an Ignition v2 or v3 config that replaces itself with it.
+func (m *MachineScope) ignitionUserData(ctx context.Context, userData []byte) ([]byte, error) {
+	presignedURL, err := m.createIgnitionData(ctx, userData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user data object: %w", err)
+	}
+
+	ignVersion := m.getIgnitionVersion()
+	semver, err := semver.ParseTolerant(ignVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ignition version %q: %w", ignVersion, err)
+	}
+
+	switch semver.Major {
+	case 2:
+		ignData := &ignV2Types.Config{
+			Ignition: ignV2Types.Ignition{
+				Version: semver.String(),
+				Config: ignV2Types.IgnitionConfig{
+					Replace: &ignV2Types.ConfigReference{
+						Source: presignedURL,
+					},
+				},
+			},
+		}
+		return json.Marshal(ignData)
+	case 3:
+		ignData := &ignV3Types.Config{
+			Ignition: ignV3Types.Ignition{
+				Version: semver.String(),
+				Config: ignV3Types.IgnitionConfig{
+					Replace: ignV3Types.Resource{
+						Source: ptr.To(presignedURL),
+					},
+				},
+			},
+		}
+		return json.Marshal(ignData)
+	default:
+		return nil, fmt.Errorf("unsupported ignition version %q", ignVersion)
+	}
+}
+
+// createIgnitionData uploads the bootstrap data to the COS bucket and returns
+// a pre-signed URL that Ignition uses to fetch it.
+func (m *MachineScope) createIgnitionData(ctx context.Context, data []byte) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if len(data) == 0 {
+		return "", fmt.Errorf("user data is empty")
+	}
+
+	if m.COSClient == nil {
+		cosClient, err := m.createCOSClient(ctx)
+		if err != nil {
+			return "", err
+		}
+		m.COSClient = cosClient
+	}
+
+	bucket := m.IBMVPCCluster.Spec.Ignition.COSInstance.BucketName
+	key := m.bootstrapDataKey()
+	log.V(3).Info("Bootstrap data key name", "key", key)
+
+	if _, err := m.COSClient.PutObject(&s3.PutObjectInput{
+		Body:   aws.ReadSeekCloser(bytes.NewReader(data)),
+		Bucket: ptr.To(bucket),
+		Key:    ptr.To(key),
+	}); err != nil {
+		return "", fmt.Errorf("failed to push object to COS bucket: %w", err)
+	}
+
+	presignedURL, err := m.COSClient.PresignedURL(bucket, key, presignExpiry)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate pre-signed URL for ignition data: %w", err)
+	}
+	log.V(3).Info("Generated pre-signed Ignition URL", "bucket", bucket, "key", key)
+	return presignedURL, nil
+}
+
+// createCOSClient creates a COS client using the HMAC credentials from the Secret referenced in the cluster's Ignition spec.
+func (m *MachineScope) createCOSClient(ctx context.Context) (cos.Cos, error) {
+	cosInstance := m.IBMVPCCluster.Spec.Ignition.COSInstance
+
+	region := cosInstance.BucketRegion
+	if region == "" {
+		region = m.IBMVPCCluster.Spec.Region
+	}
+
+	secret := &corev1.Secret{}
+	if err := m.Client.Get(ctx, types.NamespacedName{
+		Namespace: m.IBMVPCCluster.Namespace,
+		Name:      cosInstance.HMACSecretName,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to fetch COS HMAC Secret %q: %w", cosInstance.HMACSecretName, err)
+	}
+
+	accessKeyID := string(secret.Data[cosHMACAccessKeyField])
+	secretAccessKey := string(secret.Data[cosHMACSecretKeyField])
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("COS HMAC Secret %q is missing %s or %s", cosInstance.HMACSecretName, cosHMACAccessKeyField, cosHMACSecretKeyField)
+	}
+
+	clientBuilder := m.ClientBuilder
+	if clientBuilder == nil {
+		clientBuilder = ProdClientBuilder{}
+	}
+	cosClient, err := clientBuilder.GetCOSClient(ctx, COSClientOptions{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		BucketRegion:    region,
+		ServiceEndpoint: m.ServiceEndpoint,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HMAC COS client: %w", err)
+	}
+	return cosClient, nil
+}
+
+// bootstrapDataKey returns the COS object key for the machine's bootstrap data.
+func (m *MachineScope) bootstrapDataKey() string {
+	role := "node"
+	if util.IsControlPlaneMachine(m.Machine) {
+		role = "control-plane"
+	}
+	return path.Join(m.IBMVPCCluster.Name, role, m.IBMVPCMachine.Name)
+}
+
+// getIgnitionVersion returns the Ignition version, defaulting to DefaultIgnitionVersion if unset.
+func (m *MachineScope) getIgnitionVersion() string {
+	if m.IBMVPCCluster.Spec.Ignition.Version == "" {
+		return infrav1.DefaultIgnitionVersion
+	}
+	return m.IBMVPCCluster.Spec.Ignition.Version
+}
+
+// useIgnition returns true if the cluster is configured to bootstrap machines using Ignition.
+func (m *MachineScope) useIgnition() bool {
+	return m.IBMVPCCluster != nil && m.IBMVPCCluster.Spec.Ignition != nil
+}
+
+func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, vpcClient vpc.Vpc) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	if key.ID == nil && key.Name == nil {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
@@ -932,7 +2416,7 @@ func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *Ma
 			listKeysOptions.Start = &start
 		}
 
-		keysList, _, err := m.IBMVPCClient.ListKeys(listKeysOptions)
+		keysList, _, err := vpcClient.ListKeys(listKeysOptions)
 		if err != nil {
 			return false, "", fmt.Errorf("failed to get keys: %w", err)
 		}
@@ -966,7 +2450,7 @@ func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *Ma
 	return nil, fmt.Errorf("sshkey does not exist - failed to find Key ID")
 }
 
-func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, vpcClient vpc.Vpc, vpcCluster *infrav1.IBMVPCCluster) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	if image.ID == nil && image.Name == nil {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
@@ -979,9 +2463,9 @@ func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, m
 	var img *vpcv1.Image
 	f := func(start string) (bool, string, error) {
 		// check for existing images
-		resourceGroupID := ptr.To(m.IBMVPCCluster.Spec.ResourceGroup)
-		if m.IBMVPCCluster.Status.ResourceGroup != nil {
-			resourceGroupID = ptr.To(m.IBMVPCCluster.Status.ResourceGroup.ID)
+		resourceGroupID := ptr.To(vpcCluster.Spec.ResourceGroup)
+		if vpcCluster.Status.ResourceGroup != nil {
+			resourceGroupID = ptr.To(vpcCluster.Status.ResourceGroup.ID)
 		}
 		listImagesOptions := &vpcv1.ListImagesOptions{
 			ResourceGroupID: resourceGroupID,
@@ -990,7 +2474,7 @@ func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, m
 			listImagesOptions.Start = &start
 		}
 
-		imagesList, _, err := m.IBMVPCClient.ListImages(listImagesOptions)
+		imagesList, _, err := vpcClient.ListImages(listImagesOptions)
 		if err != nil {
 			return false, "", fmt.Errorf("failed to get images: %w", err)
 		}
@@ -1034,6 +2518,17 @@ func (m *MachineScope) GetInstanceStatus() string {
 	return m.IBMVPCMachine.Status.InstanceStatus
 }
 
+// GetInstanceHealthState will return the Machine's Instance Health State.
+func (m *MachineScope) GetInstanceHealthState() string {
+	return m.IBMVPCMachine.Status.InstanceHealthState
+}
+
+// FailUnhealthyInstance returns whether the machine is marked as failed when its instance is faulted, which its
+// unhealthy instance policy only opts into with Fail.
+func (m *MachineScope) FailUnhealthyInstance() bool {
+	return m.IBMVPCMachine.Spec.UnhealthyInstancePolicy == infrav1.VPCUnhealthyInstancePolicyFail
+}
+
 // GetMachineInternalIP returns the machine's internal IP.
 func (m *MachineScope) GetMachineInternalIP() *string {
 	for _, address := range m.IBMVPCMachine.Status.Addresses {
@@ -1061,13 +2556,58 @@ func (m *MachineScope) SetAddresses(instance *vpcv1.Instance) {
 		Address: *instance.Name,
 	})
 
-	// Currently, only the single network interface designated by a subnet, is expected for the Instance (as its primary/internal IP).
+	// The primary network interface's IP is the first internal IP, as the Instance's primary/internal IP.
 	addresses = append(addresses, corev1.NodeAddress{
 		Type:    corev1.NodeInternalIP,
 		Address: *instance.PrimaryNetworkInterface.PrimaryIP.Address,
 	})
 
+	// Followed by the IPs of the additional network interfaces.
+	var networkInterfaces []infrav1.NetworkInterfaceStatus
+	for _, networkInterface := range instance.NetworkInterfaces {
+		if networkInterface.ID == nil || (instance.PrimaryNetworkInterface.ID != nil && *networkInterface.ID == *instance.PrimaryNetworkInterface.ID) {
+			continue
+		}
+		networkInterfaceStatus := infrav1.NetworkInterfaceStatus{
+			ID: *networkInterface.ID,
+		}
+		if networkInterface.Subnet != nil && networkInterface.Subnet.ID != nil {
+			networkInterfaceStatus.Subnet = *networkInterface.Subnet.ID
+		}
+		// The IP is not allocated while the Instance is pending.
+		if networkInterface.PrimaryIP != nil && networkInterface.PrimaryIP.Address != nil && *networkInterface.PrimaryIP.Address != "0.0.0.0" {
+			networkInterfaceStatus.IPAddress = *networkInterface.PrimaryIP.Address
+			addresses = append(addresses, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: *networkInterface.PrimaryIP.Address,
+			})
+		}
+		networkInterfaces = append(networkInterfaces, networkInterfaceStatus)
+	}
+
+	// The floating IP bound to the Instance is its external IP.
+	if m.IBMVPCMachine.Status.FloatingIP != nil && m.IBMVPCMachine.Status.FloatingIP.Address != "" {
+		addresses = append(addresses, corev1.NodeAddress{
+			Type:    corev1.NodeExternalIP,
+			Address: m.IBMVPCMachine.Status.FloatingIP.Address,
+		})
+	}
+
 	m.IBMVPCMachine.Status.Addresses = addresses
+	m.IBMVPCMachine.Status.AdditionalNetworkInterfaces = networkInterfaces
+}
+
+// SetSecurityOptions sets the effective security options of the Machine's instance.
+func (m *MachineScope) SetSecurityOptions(instance *vpcv1.Instance) {
+	securityOptions := &infrav1.VPCSecurityOptionsStatus{
+		SecureBoot: ptr.Deref(instance.EnableSecureBoot, false),
+	}
+	for mode, vpcMode := range vpcConfidentialComputeModes {
+		if vpcMode == ptr.Deref(instance.ConfidentialComputeMode, "") {
+			securityOptions.ConfidentialComputeMode = mode
+		}
+	}
+	m.IBMVPCMachine.Status.SecurityOptions = securityOptions
 }
 
 // SetFailureMessage will set the Machine's Failure Message.
@@ -1090,6 +2630,11 @@ func (m *MachineScope) SetInstanceStatus(status string) {
 	m.IBMVPCMachine.Status.InstanceStatus = status
 }
 
+// SetInstanceHealthState sets the Machine's Instance Health State.
+func (m *MachineScope) SetInstanceHealthState(state string) {
+	m.IBMVPCMachine.Status.InstanceHealthState = state
+}
+
 // SetNotReady sets the Machine Status as not ready.
 func (m *MachineScope) SetNotReady() {
 	m.IBMVPCMachine.Status.Ready = false
@@ -1099,7 +2644,7 @@ func (m *MachineScope) SetNotReady() {
 func (m *MachineScope) SetProviderID(id *string) error {
 	// Based on the ProviderIDFormat version the providerID format will be decided.
 	if options.ProviderIDFormatType(options.ProviderIDFormat) == options.ProviderIDFormatV2 {
-		accountID, err := accounts.GetAccountIDWrapper()
+		accountID, err := accounts.GetAccountIDFromAuthenticator(m.Authenticator)
 		if err != nil {
 			return fmt.Errorf("failed to get cloud account id: %w", err)
 		}
@@ -1159,6 +2704,56 @@ func (m *MachineScope) TagResource(tagName string, resourceCRN string) error {
 	return nil
 }
 
+// AdditionalTags returns the additional user tags of the machine, including the additional tags of the cluster.
+func (m *MachineScope) AdditionalTags() []string {
+	var clusterTags []string
+	if m.IBMVPCCluster != nil {
+		clusterTags = m.IBMVPCCluster.Spec.AdditionalTags
+	}
+	return mergeTags(clusterTags, m.IBMVPCMachine.Spec.AdditionalTags)
+}
+
+// ReconcileAdditionalTags attaches the additional tags to the instance, its boot and additional volumes and the
+// floating IP created for it, and detaches the tags removed from the spec, when they changed. Additional volumes and
+// floating IPs created afterwards are tagged on creation.
+func (m *MachineScope) ReconcileAdditionalTags(ctx context.Context, instance *vpcv1.Instance) error {
+	log := ctrl.LoggerFrom(ctx)
+	tags := m.AdditionalTags()
+	if slices.Equal(tags, m.IBMVPCMachine.Status.AttachedTags) {
+		return nil
+	}
+
+	resourceCRNs := []string{*instance.CRN}
+	volumeIDs := sets.New[string]()
+	if instance.BootVolumeAttachment != nil && instance.BootVolumeAttachment.Volume != nil {
+		volumeIDs.Insert(ptr.Deref(instance.BootVolumeAttachment.Volume.ID, ""))
+	}
+	if m.IBMVPCMachine.Status.V1Beta2 != nil {
+		volumeIDs.Insert(m.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs...)
+	}
+	for _, attachment := range instance.VolumeAttachments {
+		if attachment.Volume != nil && attachment.Volume.CRN != nil && volumeIDs.Has(ptr.Deref(attachment.Volume.ID, "")) {
+			resourceCRNs = append(resourceCRNs, *attachment.Volume.CRN)
+		}
+	}
+	if floatingIPStatus := m.IBMVPCMachine.Status.FloatingIP; floatingIPStatus != nil && ptr.Deref(floatingIPStatus.ControllerCreated, false) {
+		floatingIP, _, err := m.IBMVPCClient.GetFloatingIP(&vpcv1.GetFloatingIPOptions{
+			ID: ptr.To(floatingIPStatus.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("error retrieving floating IP %s for machine %s: %w", floatingIPStatus.ID, m.IBMVPCMachine.Name, err)
+		}
+		resourceCRNs = append(resourceCRNs, *floatingIP.CRN)
+	}
+
+	log.V(3).Info("Updating additional tags of machine resources", "tags", tags, "attachedTags", m.IBMVPCMachine.Status.AttachedTags, "resources", len(resourceCRNs))
+	if err := reconcileAttachedTags(m.GlobalTaggingClient, resourceCRNs, tags, m.IBMVPCMachine.Status.AttachedTags); err != nil {
+		return fmt.Errorf("error tagging resources of machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	m.IBMVPCMachine.Status.AttachedTags = tags
+	return nil
+}
+
 // APIServerPort returns the APIServerPort.
 func (m *MachineScope) APIServerPort() int32 {
 	if m.Cluster.Spec.ClusterNetwork.APIServerPort > 0 {
@@ -1209,7 +2804,7 @@ func (m *MachineScope) CreateVolume(vpcVolume *infrav1.VPCVolume) (string, error
 			Name: &vpcVolume.Profile,
 		},
 		Zone: &vpcv1.ZoneIdentity{
-			Name: &m.IBMVPCMachine.Spec.Zone,
+			Name: ptr.To(m.GetZone()),
 		},
 		Capacity: &vpcVolume.SizeGiB,
 	}
@@ -1227,6 +2822,12 @@ func (m *MachineScope) CreateVolume(vpcVolume *infrav1.VPCVolume) (string, error
 		}
 	}
 
+	// Tag the volume with the additional tags on creation, as the tags of the machine are only attached again when
+	// they change.
+	if tags := m.AdditionalTags(); len(tags) > 0 {
+		volumeOptions.VolumePrototype.(*vpcv1.VolumePrototype).UserTags = tags
+	}
+
 	volumeResult, _, err := m.IBMVPCClient.CreateVolume(&volumeOptions)
 	if err != nil {
 		return "", fmt.Errorf("error while creating volume: %w", err)
@@ -1252,3 +2853,361 @@ func (m *MachineScope) AttachVolume(deleteOnInstanceDelete bool, volumeID, volum
 	}
 	return err
 }
+
+// DetachRemovedVolumes detaches the additional volumes removed from the spec from the instance, and aligns the
+// additional volume IDs of the status with the volumes of the spec. It returns whether volumes were detached.
+// Volumes are matched to their attachments by name, so that nothing is detached while a volume of the spec is unnamed,
+// which the webhook rejects when volumes are removed.
+func (m *MachineScope) DetachRemovedVolumes(attachments []vpcv1.VolumeAttachment) (bool, error) {
+	status := m.IBMVPCMachine.Status.V1Beta2
+	volumes := m.IBMVPCMachine.Spec.AdditionalVolumes
+	names := sets.New[string]()
+	for _, volume := range volumes {
+		if volume.Name == "" {
+			if len(status.AdditionalVolumeIDs) > len(volumes) {
+				record.Warnf(m.IBMVPCMachine, "FailedDetachVolume", "Removed volumes are not detached while additional volumes are unnamed")
+			}
+			return false, nil
+		}
+		names.Insert(volume.Name)
+	}
+
+	managed := sets.New(status.AdditionalVolumeIDs...)
+	attached := sets.New[string]()
+	attachedByName := map[string]string{}
+	detached := false
+	for _, attachment := range attachments {
+		if attachment.Volume == nil || attachment.Volume.ID == nil || !managed.Has(*attachment.Volume.ID) {
+			continue
+		}
+		volumeID := *attachment.Volume.ID
+		attached.Insert(volumeID)
+		if names.Has(ptr.Deref(attachment.Name, "")) {
+			attachedByName[*attachment.Name] = volumeID
+			continue
+		}
+		if _, err := m.IBMVPCClient.DetachVolumeFromInstance(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+			InstanceID: ptr.To(m.IBMVPCMachine.Status.InstanceID),
+			ID:         attachment.ID,
+		}); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedDetachVolume", "Failed volume detachment - %v", err)
+			return detached, fmt.Errorf("error detaching volume %s from machine %s: %w", volumeID, m.IBMVPCMachine.Name, err)
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulDetachVolume", "Detached volume %q", ptr.Deref(attachment.Name, volumeID))
+		if ptr.Deref(attachment.DeleteVolumeOnInstanceDelete, false) {
+			status.RemovedVolumeIDs = append(status.RemovedVolumeIDs, volumeID)
+		}
+		detached = true
+	}
+
+	// The volumes created but not attached yet keep their order, and are assigned in that order to the volumes of the
+	// spec which are not attached. The remaining ones belong to volumes removed before being attached, which hold no
+	// data and are deleted.
+	var unattached []string
+	for _, id := range status.AdditionalVolumeIDs {
+		if id != "" && !attached.Has(id) {
+			unattached = append(unattached, id)
+		}
+	}
+	ids := make([]string, len(volumes))
+	for i, volume := range volumes {
+		if id, ok := attachedByName[volume.Name]; ok {
+			ids[i] = id
+		} else if len(unattached) > 0 && i < len(status.AdditionalVolumeIDs) && status.AdditionalVolumeIDs[i] != "" {
+			ids[i], unattached = unattached[0], unattached[1:]
+		}
+	}
+	status.AdditionalVolumeIDs = ids
+	status.RemovedVolumeIDs = append(status.RemovedVolumeIDs, unattached...)
+	return detached, nil
+}
+
+// DeleteRemovedVolumes deletes the additional volumes removed from the spec once they are detached from the instance,
+// and returns whether some are still attached.
+func (m *MachineScope) DeleteRemovedVolumes() (bool, error) {
+	status := m.IBMVPCMachine.Status.V1Beta2
+	if status == nil {
+		return false, nil
+	}
+	var pending []string
+	var errs []error
+	for _, id := range status.RemovedVolumeIDs {
+		volume, detailedResponse, err := m.IBMVPCClient.GetVolume(&vpcv1.GetVolumeOptions{
+			ID: ptr.To(id),
+		})
+		if err != nil {
+			if detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound {
+				pending = append(pending, id)
+				errs = append(errs, fmt.Errorf("error retrieving volume %s for machine %s: %w", id, m.IBMVPCMachine.Name, err))
+			}
+			continue
+		}
+		if ptr.Deref(volume.AttachmentState, "") != vpcv1.VolumeAttachmentStateUnattachedConst {
+			pending = append(pending, id)
+			continue
+		}
+		if _, err := m.IBMVPCClient.DeleteVolume(&vpcv1.DeleteVolumeOptions{
+			ID: ptr.To(id),
+		}); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedDeleteVolume", "Failed volume deletion - %v", err)
+			pending = append(pending, id)
+			errs = append(errs, fmt.Errorf("error deleting volume %s for machine %s: %w", id, m.IBMVPCMachine.Name, err))
+			continue
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteVolume", "Deleted volume %q", id)
+	}
+	status.RemovedVolumeIDs = pending
+	return len(pending) > 0, errors.Join(errs...)
+}
+
+// ReconcileVolumeUpdates updates the boot and the attached additional volumes of the instance whose size, iops or
+// profile drifted from the spec. The capacity of a volume is only ever expanded. It returns whether volumes are being
+// updated. ErrVolumeUpdateRefused is returned when a volume cannot be updated as requested by the spec.
+func (m *MachineScope) ReconcileVolumeUpdates(instance *vpcv1.Instance) (bool, error) {
+	var volumeIDs []string
+	var volumes []*infrav1.VPCVolume
+	if m.IBMVPCMachine.Spec.BootVolume != nil && instance.BootVolumeAttachment != nil && instance.BootVolumeAttachment.Volume != nil {
+		volumeIDs = append(volumeIDs, *instance.BootVolumeAttachment.Volume.ID)
+		volumes = append(volumes, m.IBMVPCMachine.Spec.BootVolume)
+	}
+	if status := m.IBMVPCMachine.Status.V1Beta2; status != nil {
+		for i, volume := range m.IBMVPCMachine.Spec.AdditionalVolumes {
+			if i < len(status.AdditionalVolumeIDs) && status.AdditionalVolumeIDs[i] != "" {
+				volumeIDs = append(volumeIDs, status.AdditionalVolumeIDs[i])
+				volumes = append(volumes, volume)
+			}
+		}
+	}
+
+	updating := false
+	var errs, refused []error
+	for i, id := range volumeIDs {
+		volume, _, err := m.IBMVPCClient.GetVolume(&vpcv1.GetVolumeOptions{
+			ID: ptr.To(id),
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error retrieving volume %s for machine %s: %w", id, m.IBMVPCMachine.Name, err))
+			continue
+		}
+		if ptr.Deref(volume.Status, "") == vpcv1.VolumeStatusUpdatingConst {
+			updating = true
+			continue
+		}
+		drift, err := volumeDrift(volumes[i], volume)
+		if err != nil {
+			refused = append(refused, fmt.Errorf("volume %s of machine %s: %w", id, m.IBMVPCMachine.Name, err))
+			continue
+		}
+		if drift == nil {
+			continue
+		}
+		// Only an available volume attached to the instance can be updated, otherwise wait for it to be.
+		if ptr.Deref(volume.Status, "") != vpcv1.VolumeStatusAvailableConst || ptr.Deref(volume.AttachmentState, "") != vpcv1.VolumeAttachmentStateAttachedConst {
+			updating = true
+			continue
+		}
+		patch, err := drift.AsPatch()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error building volume patch for machine %s: %w", m.IBMVPCMachine.Name, err))
+			continue
+		}
+		if _, detailedResponse, err := m.IBMVPCClient.UpdateVolume(&vpcv1.UpdateVolumeOptions{
+			ID:          ptr.To(id),
+			VolumePatch: patch,
+		}); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedUpdateVolume", "Failed volume update - %v", err)
+			err = fmt.Errorf("error updating volume %s for machine %s: %w", id, m.IBMVPCMachine.Name, err)
+			// A bad request is refused again until the spec changes, so that it is not retried.
+			if detailedResponse != nil && detailedResponse.StatusCode == http.StatusBadRequest {
+				refused = append(refused, err)
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulUpdateVolume", "Updated volume %q", id)
+		updating = true
+	}
+	if len(errs) == 0 && len(refused) > 0 {
+		return updating, fmt.Errorf("%w: %w", ErrVolumeUpdateRefused, errors.Join(refused...))
+	}
+	return updating, errors.Join(append(errs, refused...)...)
+}
+
+// VolumeUpdateRefused reports whether a volume could not be updated as requested by the current spec, in which case
+// the update is not retried until the spec changes.
+func (m *MachineScope) VolumeUpdateRefused() bool {
+	condition := v1beta2conditions.Get(m.IBMVPCMachine, infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition)
+	return condition != nil && condition.Reason == infrav1.IBMVPCMachineVolumesUpdateRefusedV1Beta2Reason && condition.ObservedGeneration == m.IBMVPCMachine.Generation
+}
+
+// volumeDrift returns the changes of the volume to match its size, iops and profile in the spec, or nil if it matches.
+// A size smaller than the capacity of the volume is ignored, as volumes cannot be shrunk. An error is returned when the
+// profile is changed to or from the sdp profile, which IBM Cloud cannot apply to an existing volume.
+func volumeDrift(spec *infrav1.VPCVolume, volume *vpcv1.Volume) (*vpcv1.VolumePatch, error) {
+	var patch *vpcv1.VolumePatch
+	if spec.SizeGiB > ptr.Deref(volume.Capacity, 0) {
+		patch = &vpcv1.VolumePatch{Capacity: ptr.To(spec.SizeGiB)}
+	}
+	if spec.Iops != 0 && spec.Iops != ptr.Deref(volume.Iops, 0) {
+		if patch == nil {
+			patch = &vpcv1.VolumePatch{}
+		}
+		patch.Iops = ptr.To(spec.Iops)
+	}
+	var profile string
+	if volume.Profile != nil {
+		profile = ptr.Deref(volume.Profile.Name, "")
+	}
+	if spec.Profile != "" && spec.Profile != profile {
+		if spec.Profile == "sdp" || profile == "sdp" {
+			return nil, fmt.Errorf("profile cannot be changed from %q to %q", profile, spec.Profile)
+		}
+		if patch == nil {
+			patch = &vpcv1.VolumePatch{}
+		}
+		patch.Profile = &vpcv1.VolumeProfileIdentityByName{Name: ptr.To(spec.Profile)}
+	}
+	return patch, nil
+}
+
+// InPlaceResizeAllowed reports whether the instance can be resized in place, as allowed by the InPlaceResizeAnnotation.
+func (m *MachineScope) InPlaceResizeAllowed() bool {
+	return m.IBMVPCMachine.Annotations[infrav1.InPlaceResizeAnnotation] == "true"
+}
+
+// InstanceProfileDrifted reports whether the profile of the instance differs from the profile in the spec.
+func (m *MachineScope) InstanceProfileDrifted(instance *vpcv1.Instance) bool {
+	profile := m.IBMVPCMachine.Spec.Profile
+	return profile != "" && instance.Profile != nil && ptr.Deref(instance.Profile.Name, "") != profile
+}
+
+// ReconcileInstanceProfile resizes the instance in place when its profile drifted from the spec and the resize is
+// allowed: the running instance is stopped, its profile is updated once it is stopped, and it is started again.
+// It returns whether the instance is being resized.
+func (m *MachineScope) ReconcileInstanceProfile(ctx context.Context, instance *vpcv1.Instance) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	status := ptr.Deref(instance.Status, "")
+	condition := v1beta2conditions.Get(m.IBMVPCMachine, infrav1.IBMVPCMachineProfileReadyV1Beta2Condition)
+	resizing := condition != nil && condition.Reason == infrav1.IBMVPCMachineProfileResizingV1Beta2Reason
+
+	if !m.InstanceProfileDrifted(instance) {
+		if !resizing {
+			return false, nil
+		}
+		// The profile was updated, start the instance again and wait for it to be running.
+		switch status {
+		case vpcv1.InstanceStatusStoppedConst:
+			if err := m.instanceAction(*instance.ID, vpcv1.CreateInstanceActionOptionsTypeStartConst); err != nil {
+				return false, err
+			}
+			return true, nil
+		case vpcv1.InstanceStatusStartingConst, vpcv1.InstanceStatusPendingConst:
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if !m.InPlaceResizeAllowed() {
+		log.V(3).Info("Instance profile differs from the spec, but in-place resize is not allowed", "instanceProfile", *instance.Profile.Name, "profile", m.IBMVPCMachine.Spec.Profile)
+		return false, nil
+	}
+
+	switch status {
+	case vpcv1.InstanceStatusRunningConst:
+		// Validate the profile before stopping the instance, so that it is not stopped for a resize which cannot succeed.
+		if err := m.validateInstanceProfileResize(instance); err != nil {
+			return false, err
+		}
+		log.Info("Stopping instance to resize it", "instanceID", *instance.ID, "profile", m.IBMVPCMachine.Spec.Profile)
+		if err := m.instanceAction(*instance.ID, vpcv1.CreateInstanceActionOptionsTypeStopConst); err != nil {
+			return false, err
+		}
+		return true, nil
+	case vpcv1.InstanceStatusStoppedConst:
+		if err := m.updateInstanceProfile(instance); err != nil {
+			// Start the instance again, so that it is not left stopped by a resize which failed.
+			if startErr := m.instanceAction(*instance.ID, vpcv1.CreateInstanceActionOptionsTypeStartConst); startErr != nil {
+				return false, errors.Join(err, startErr)
+			}
+			return false, err
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulResizeInstance", "Resized instance %q to profile %q", *instance.ID, m.IBMVPCMachine.Spec.Profile)
+		if err := m.instanceAction(*instance.ID, vpcv1.CreateInstanceActionOptionsTypeStartConst); err != nil {
+			return false, err
+		}
+		return true, nil
+	case vpcv1.InstanceStatusStoppingConst, vpcv1.InstanceStatusStartingConst, vpcv1.InstanceStatusPendingConst:
+		return true, nil
+	}
+	return false, nil
+}
+
+// validateInstanceProfileResize validates that the instance can be resized to the profile in the spec, which must have
+// the family and the vCPU architecture of the profile of the instance, and support the security options of the spec.
+func (m *MachineScope) validateInstanceProfileResize(instance *vpcv1.Instance) error {
+	current, _, err := m.IBMVPCClient.GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{
+		Name: instance.Profile.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("error retrieving instance profile %s: %w", *instance.Profile.Name, err)
+	}
+	profileName := m.IBMVPCMachine.Spec.Profile
+	profile, _, err := m.IBMVPCClient.GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{
+		Name: ptr.To(profileName),
+	})
+	if err != nil {
+		return fmt.Errorf("error retrieving instance profile %s: %w", profileName, err)
+	}
+
+	if family := ptr.Deref(profile.Family, ""); family != ptr.Deref(current.Family, "") {
+		return fmt.Errorf("error instance profile %s of family %s cannot replace instance profile %s of family %s", profileName, family, *instance.Profile.Name, ptr.Deref(current.Family, ""))
+	}
+	if architecture := vcpuArchitecture(profile); architecture != vcpuArchitecture(current) {
+		return fmt.Errorf("error instance profile %s with vCPU architecture %s cannot replace instance profile %s with vCPU architecture %s", profileName, architecture, *instance.Profile.Name, vcpuArchitecture(current))
+	}
+	return validateSecurityOptions(m.IBMVPCMachine.Spec.SecurityOptions, profileName, profile)
+}
+
+// vcpuArchitecture returns the vCPU architecture of the instance profile.
+func vcpuArchitecture(profile *vpcv1.InstanceProfile) string {
+	if profile.VcpuArchitecture == nil {
+		return ""
+	}
+	return ptr.Deref(profile.VcpuArchitecture.Value, "")
+}
+
+// updateInstanceProfile validates the profile in the spec and updates the stopped instance to it.
+func (m *MachineScope) updateInstanceProfile(instance *vpcv1.Instance) error {
+	if err := m.validateInstanceProfileResize(instance); err != nil {
+		return err
+	}
+	patch, err := (&vpcv1.InstancePatch{
+		Profile: &vpcv1.InstancePatchProfileInstanceProfileIdentityByName{
+			Name: ptr.To(m.IBMVPCMachine.Spec.Profile),
+		},
+	}).AsPatch()
+	if err != nil {
+		return fmt.Errorf("error building instance patch for machine %s: %w", m.IBMVPCMachine.Name, err)
+	}
+	if _, _, err := m.IBMVPCClient.UpdateInstance(&vpcv1.UpdateInstanceOptions{
+		ID:            instance.ID,
+		InstancePatch: patch,
+	}); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedResizeInstance", "Failed instance resize - %v", err)
+		return fmt.Errorf("error updating profile of instance %s for machine %s: %w", *instance.ID, m.IBMVPCMachine.Name, err)
+	}
+	return nil
+}
+
+// instanceAction requests the stop or the start of the instance.
+func (m *MachineScope) instanceAction(instanceID, actionType string) error {
+	if _, _, err := m.IBMVPCClient.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+		InstanceID: ptr.To(instanceID),
+		Type:       ptr.To(actionType),
+	}); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedInstanceAction", "Failed to %s instance - %v", actionType, err)
+		return fmt.Errorf("error requesting %s of instance %s for machine %s: %w", actionType, instanceID, m.IBMVPCMachine.Name, err)
+	}
+	return nil
+}
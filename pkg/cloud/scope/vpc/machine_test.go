@@ -19,9 +19,14 @@ package vpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -31,12 +36,16 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	cosmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	fakeservices "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/fake"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
 	. "github.com/onsi/gomega"
@@ -128,7 +137,7 @@ func TestNewMachineScope(t *testing.T) {
 	for _, tc := range testCases {
 		g := NewWithT(t)
 		t.Run(tc.name, func(_ *testing.T) {
-			_, err := NewMachineScope(tc.params)
+			_, err := NewMachineScope(ctx, tc.params)
 			// Note: only error/failure cases covered
 			// TO-DO: cover success cases
 			g.Expect(err).To(Not(BeNil()))
@@ -158,6 +167,21 @@ func TestSetVPCProviderID(t *testing.T) {
 		g.Expect(err).To(BeNil())
 	})
 
+	t.Run("Set Provider ID with the account of the cluster credentials", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		options.ProviderIDFormat = string("v2")
+		accounts.GetAccountIDFunc = func() (string, error) {
+			return "dummy-account-id", nil // Return dummy value
+		}
+		auth, err := fakeservices.NewAuthenticator()
+		g.Expect(err).To(BeNil())
+		scope.Authenticator = auth
+		err = scope.SetProviderID(ptr.To(providerID))
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Spec.ProviderID).To(Equal(fmt.Sprintf("ibm://%s///%s/%s", fakeservices.AccountID, scope.Machine.Spec.ClusterName, providerID)))
+	})
+
 	t.Run("Set Provider ID returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
@@ -170,6 +194,57 @@ func TestSetVPCProviderID(t *testing.T) {
 	})
 }
 
+func TestSetAddresses(t *testing.T) {
+	instance := &vpcv1.Instance{
+		Name: ptr.To("foo-machine"),
+		PrimaryNetworkInterface: &vpcv1.NetworkInterfaceInstanceContextReference{
+			ID:        ptr.To("primary-nic-id"),
+			PrimaryIP: &vpcv1.ReservedIPReference{Address: ptr.To("10.0.0.4")},
+		},
+		NetworkInterfaces: []vpcv1.NetworkInterfaceInstanceContextReference{
+			{
+				ID:        ptr.To("primary-nic-id"),
+				PrimaryIP: &vpcv1.ReservedIPReference{Address: ptr.To("10.0.0.4")},
+			},
+			{
+				ID:        ptr.To("storage-nic-id"),
+				PrimaryIP: &vpcv1.ReservedIPReference{Address: ptr.To("10.0.1.4")},
+				Subnet:    &vpcv1.SubnetReference{ID: ptr.To("storage-subnet-id")},
+			},
+			{
+				ID:        ptr.To("multus-nic-id"),
+				PrimaryIP: &vpcv1.ReservedIPReference{Address: ptr.To("0.0.0.0")},
+				Subnet:    &vpcv1.SubnetReference{ID: ptr.To("multus-subnet-id")},
+			},
+		},
+	}
+
+	t.Run("Should set the addresses of the primary and additional network interfaces", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		scope.SetAddresses(instance)
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(Equal([]corev1.NodeAddress{
+			{Type: corev1.NodeInternalDNS, Address: "foo-machine"},
+			{Type: corev1.NodeHostName, Address: "foo-machine"},
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.4"},
+			{Type: corev1.NodeInternalIP, Address: "10.0.1.4"},
+		}))
+		g.Expect(scope.IBMVPCMachine.Status.AdditionalNetworkInterfaces).To(Equal([]infrav1.NetworkInterfaceStatus{
+			{ID: "storage-nic-id", Subnet: "storage-subnet-id", IPAddress: "10.0.1.4"},
+			{ID: "multus-nic-id", Subnet: "multus-subnet-id"},
+		}))
+		g.Expect(*scope.GetMachineInternalIP()).To(Equal("10.0.0.4"))
+	})
+
+	t.Run("Should set the address of the floating IP as external IP", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", Address: "169.48.0.2"}
+		scope.SetAddresses(instance)
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(ContainElement(corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "169.48.0.2"}))
+	})
+}
+
 func TestCreateMachine(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
@@ -211,6 +286,73 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
+		t.Run("Should create Machine with Ignition user data", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockcos := cosmock.NewMockCos(mockController)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.COSClient = mockcos
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCCluster.Spec.Ignition = &infrav1.VPCIgnition{
+				Version: "3.4",
+				COSInstance: infrav1.VPCIgnitionCOSInstance{
+					BucketName:     "foo-bucket",
+					HMACSecretName: "foo-hmac-secret",
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockcos.EXPECT().PutObject(gomock.AssignableToTypeOf(&s3.PutObjectInput{})).Return(&s3.PutObjectOutput{}, nil)
+			mockcos.EXPECT().PresignedURL("foo-bucket", "foo-cluster/node/foo-machine", presignExpiry).Return("https://foo-bucket/presigned", nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype, ok := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(*prototype.UserData).To(ContainSubstring(`"replace":{"source":"https://foo-bucket/presigned"`))
+				g.Expect(*prototype.UserData).To(ContainSubstring(`"version":"3.4.0"`))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when uploading Ignition data", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockcos := cosmock.NewMockCos(mockController)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.COSClient = mockcos
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCCluster.Spec.Ignition = &infrav1.VPCIgnition{
+				COSInstance: infrav1.VPCIgnitionCOSInstance{
+					BucketName:     "foo-bucket",
+					HMACSecretName: "foo-hmac-secret",
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockcos.EXPECT().PutObject(gomock.AssignableToTypeOf(&s3.PutObjectInput{})).Return(nil, errors.New("failed to put object"))
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Error when Ignition HMAC secret does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCCluster.Spec.Ignition = &infrav1.VPCIgnition{
+				COSInstance: infrav1.VPCIgnitionCOSInstance{
+					BucketName:     "foo-bucket",
+					HMACSecretName: "foo-hmac-secret",
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(Not(BeNil()))
+		})
+
 		t.Run("Return existing Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -333,166 +475,648 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
-		t.Run("Create machine using network status security groups", func(t *testing.T) {
+		t.Run("Create machine with additional network interfaces", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			expectedOutput := &vpcv1.Instance{
-				Name: core.StringPtr("foo-machine"),
-			}
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
-				SecurityGroups: []infrav1.VPCResource{
-					{
-						Name: core.StringPtr("security-group-1"),
-					},
-				},
-				Subnet: testSubnetName,
+				Subnet: "subnet-name-1",
 			}
-			scope.IBMVPCCluster.Status = infrav1.IBMVPCClusterStatus{
-				Network: &infrav1.VPCNetworkStatus{
-					ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
-						testSubnetName: {
-							ID: "subnet-id",
-						},
-					},
-					SecurityGroups: map[string]*infrav1.ResourceStatus{
-						"security-group-1": {
-							ID: "security-group-id-1",
+			scope.IBMVPCMachine.Spec.AdditionalNetworkInterfaces = []infrav1.NetworkInterface{
+				{
+					Subnet: "storage-subnet-name",
+					SecurityGroups: []infrav1.VPCResource{
+						{
+							ID: ptr.To("storage-sg-id"),
 						},
 					},
 				},
 			}
-			instance := &vpcv1.Instance{
-				Name: &scope.Machine.Name,
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
 			}
 
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-			// TODO(cjschaef): Enhance the mock Options parameter to validate the Network Status Security Group ID was used.
-			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName("storage-subnet-name").Return(&vpcv1.Subnet{ID: ptr.To("storage-subnet-id")}, nil)
+			mockvpc.EXPECT().GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: ptr.To("storage-sg-id")}).Return(&vpcv1.SecurityGroup{ID: ptr.To("storage-sg-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.PrimaryNetworkInterface.Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("subnet-id-1"))
+				g.Expect(prototype.NetworkInterfaces).To(HaveLen(1))
+				g.Expect(*prototype.NetworkInterfaces[0].Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("storage-subnet-id"))
+				g.Expect(*prototype.NetworkInterfaces[0].SecurityGroups[0].(*vpcv1.SecurityGroupIdentityByID).ID).To(Equal("storage-sg-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
 
-			out, err := scope.CreateMachine(ctx)
+			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
-			require.Equal(t, expectedOutput, out)
 		})
 
-		t.Run("Create machine using name lookup security groups", func(t *testing.T) {
+		t.Run("Create machine with virtual network interfaces", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			expectedOutput := &vpcv1.Instance{
-				Name: core.StringPtr("foo-machine"),
-			}
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
-				SecurityGroups: []infrav1.VPCResource{
-					{
-						Name: core.StringPtr("security-group-1"),
-					},
+				Subnet: "subnet-name-1",
+			}
+			scope.IBMVPCMachine.Spec.AdditionalNetworkInterfaces = []infrav1.NetworkInterface{
+				{
+					Subnet: "subnet-name-1",
 				},
-				Subnet: testSubnetName,
 			}
-			instance := &vpcv1.Instance{
-				Name: &scope.Machine.Name,
+			scope.IBMVPCMachine.Spec.NetworkAttachmentMode = infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+			scope.IBMVPCMachine.Spec.VirtualNetworkInterface = &infrav1.VPCVirtualNetworkInterface{
+				AllowIPSpoofing: ptr.To(true),
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
 			}
 
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-id")}, nil)
-			mockvpc.EXPECT().GetSecurityGroupByName("security-group-1").Return(&vpcv1.SecurityGroup{ID: core.StringPtr("security-group-id-1")}, nil)
-			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PrimaryNetworkInterface).To(BeNil())
+				g.Expect(prototype.NetworkInterfaces).To(BeEmpty())
+				vni := prototype.PrimaryNetworkAttachment.VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext)
+				g.Expect(vni.Name).To(BeNil())
+				g.Expect(*vni.Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("subnet-id-1"))
+				g.Expect(*vni.AutoDelete).To(BeTrue())
+				g.Expect(*vni.AllowIPSpoofing).To(BeTrue())
+				g.Expect(prototype.NetworkAttachments).To(HaveLen(1))
+				additionalVNI := prototype.NetworkAttachments[0].VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext)
+				g.Expect(additionalVNI.Name).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
 
-			out, err := scope.CreateMachine(ctx)
+			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
-			require.Equal(t, expectedOutput, out)
 		})
 
-		t.Run("Create machine using id lookup security groups", func(t *testing.T) {
+		t.Run("Create machine reattaching its retained virtual network interface", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			expectedOutput := &vpcv1.Instance{
-				Name: core.StringPtr("foo-machine"),
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet: "subnet-name-1",
 			}
+			scope.IBMVPCMachine.Spec.NetworkAttachmentMode = infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+			scope.IBMVPCMachine.Spec.VirtualNetworkInterface = &infrav1.VPCVirtualNetworkInterface{
+				DeletionPolicy:     infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain,
+				RetainedNamePrefix: "workers",
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesByNamePrefix("workers-0-").Return([]vpcv1.VirtualNetworkInterface{
+				{
+					ID:         ptr.To("attached-vni-id"),
+					Name:       ptr.To("workers-0-x7k2p"),
+					AutoDelete: ptr.To(false),
+					Subnet:     &vpcv1.SubnetReference{ID: ptr.To("subnet-id-1")},
+					Target:     &vpcv1.VirtualNetworkInterfaceTarget{ID: ptr.To("other-attachment-id")},
+				},
+				{
+					ID:         ptr.To("other-prefix-vni-id"),
+					Name:       ptr.To("workers-0-1-x7k2p"),
+					AutoDelete: ptr.To(false),
+					Subnet:     &vpcv1.SubnetReference{ID: ptr.To("subnet-id-1")},
+				},
+				{
+					ID:         ptr.To("vni-id"),
+					Name:       ptr.To("workers-0-m4q9z"),
+					AutoDelete: ptr.To(false),
+					Subnet:     &vpcv1.SubnetReference{ID: ptr.To("subnet-id-1")},
+				},
+			}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				vni := prototype.PrimaryNetworkAttachment.VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID)
+				g.Expect(*vni.ID).To(Equal("vni-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Create machine with a new retained virtual network interface when the retained ones are attached", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
-				SecurityGroups: []infrav1.VPCResource{
-					{
-						ID: core.StringPtr("security-group-id-1"),
-					},
+				Subnet: "subnet-name-1",
+			}
+			scope.IBMVPCMachine.Spec.NetworkAttachmentMode = infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+			scope.IBMVPCMachine.Spec.VirtualNetworkInterface = &infrav1.VPCVirtualNetworkInterface{
+				DeletionPolicy:     infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain,
+				RetainedNamePrefix: "workers",
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
 				},
-				Subnet: testSubnetName,
 			}
-			instance := &vpcv1.Instance{
-				Name: &scope.Machine.Name,
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesByNamePrefix("workers-0-").Return([]vpcv1.VirtualNetworkInterface{
+				{
+					ID:     ptr.To("vni-id"),
+					Name:   ptr.To("workers-0-x7k2p"),
+					Subnet: &vpcv1.SubnetReference{ID: ptr.To("subnet-id-1")},
+					Target: &vpcv1.VirtualNetworkInterfaceTarget{ID: ptr.To("other-attachment-id")},
+				},
+			}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				vni := prototype.PrimaryNetworkAttachment.VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext)
+				g.Expect(*vni.Name).To(MatchRegexp("^workers-0-[a-z0-9]{5}$"))
+				g.Expect(*vni.AutoDelete).To(BeFalse())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Create machine with a static reserved IP", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet:     "subnet-name-1",
+				ReservedIP: &infrav1.VPCReservedIP{Address: ptr.To("10.240.0.10")},
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
 			}
 
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-id")}, nil)
-			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(&vpcv1.SecurityGroup{ID: core.StringPtr("security-group-id-1")}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetReservedIPByName("subnet-id-1", "foo-machine").Return(nil, nil)
+			mockvpc.EXPECT().CreateSubnetReservedIP(&vpcv1.CreateSubnetReservedIPOptions{
+				SubnetID:   ptr.To("subnet-id-1"),
+				Address:    ptr.To("10.240.0.10"),
+				AutoDelete: ptr.To(false),
+				Name:       ptr.To("foo-machine"),
+			}).Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id"), Address: ptr.To("10.240.0.10")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				primaryIP := prototype.PrimaryNetworkInterface.PrimaryIP.(*vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID)
+				g.Expect(*primaryIP.ID).To(Equal("reserved-ip-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
 
-			out, err := scope.CreateMachine(ctx)
+			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
-			require.Equal(t, expectedOutput, out)
+			g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(Equal(&infrav1.VPCReservedIPStatus{
+				ID:       "reserved-ip-id",
+				SubnetID: "subnet-id-1",
+				Address:  "10.240.0.10",
+			}))
 		})
 
-		t.Run("Create machine using network status vpc", func(t *testing.T) {
+		t.Run("Create machine with an existing reserved IP", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			expectedOutput := &vpcv1.Instance{
-				Name: core.StringPtr("foo-machine"),
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet:     "subnet-name-1",
+				ReservedIP: &infrav1.VPCReservedIP{ID: ptr.To("reserved-ip-id")},
+			}
+			scope.IBMVPCMachine.Spec.NetworkAttachmentMode = infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
 			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				vni := prototype.PrimaryNetworkAttachment.VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext)
+				primaryIP := vni.PrimaryIP.(*vpcv1.VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByID)
+				g.Expect(*primaryIP.ID).To(Equal("reserved-ip-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(BeNil())
+		})
+
+		t.Run("Create machine with an address claimed from an IPAM pool", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCCluster.Status = infrav1.IBMVPCClusterStatus{
-				Network: &infrav1.VPCNetworkStatus{
-					VPC: &infrav1.ResourceStatus{
-						ID: "network-vpc-id",
-					},
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet: "subnet-name-1",
+				ReservedIP: &infrav1.VPCReservedIP{
+					PoolRef: &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"},
 				},
 			}
-			instance := &vpcv1.Instance{
-				Name: &scope.Machine.Name,
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
 			}
+			g.Expect(scope.Client.Create(ctx, &ipamv1.IPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: defaultNamespace},
+				Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "foo-machine-address"}},
+			})).To(Succeed())
+			g.Expect(scope.Client.Create(ctx, &ipamv1.IPAddress{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo-machine-address", Namespace: defaultNamespace},
+				Spec:       ipamv1.IPAddressSpec{Address: "10.240.0.20"},
+			})).To(Succeed())
 
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
-			// TODO(cjschaef): Enhance the mock Options parameter to validate the Network Status VPC ID was used.
-			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetReservedIPByName("subnet-id-1", "foo-machine").Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id"), Address: ptr.To("10.240.0.20")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				primaryIP := prototype.PrimaryNetworkInterface.PrimaryIP.(*vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID)
+				g.Expect(*primaryIP.ID).To(Equal("reserved-ip-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
 
-			out, err := scope.CreateMachine(ctx)
+			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
-			require.Equal(t, expectedOutput, out)
+			g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP.Address).To(Equal("10.240.0.20"))
 		})
-	})
 
-	t.Run("Error when machine profile is empty", func(t *testing.T) {
-		g := NewWithT(t)
-		mockController, mockvpc := setup(t)
-		t.Cleanup(mockController.Finish)
-		scope := setupMachineScope(clusterName, machineName, mockvpc)
-		vpcMachine := infrav1.IBMVPCMachine{
-			Spec: infrav1.IBMVPCMachineSpec{},
-		}
-		scope.IBMVPCMachine.Spec = vpcMachine.Spec
-		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-		_, err := scope.CreateMachine(ctx)
-		g.Expect(err).To(Not(BeNil()))
-	})
+		t.Run("Error when the reserved IP of the machine is bound", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet:     "subnet-name-1",
+				ReservedIP: &infrav1.VPCReservedIP{Address: ptr.To("10.240.0.10")},
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
+			}
 
-	t.Run("Error when both SSHKeys ID and Name are nil", func(t *testing.T) {
-		g := NewWithT(t)
-		mockController, mockvpc := setup(t)
-		t.Cleanup(mockController.Finish)
-		scope := setupMachineScope(clusterName, machineName, mockvpc)
-		vpcMachine := infrav1.IBMVPCMachine{
-			Spec: infrav1.IBMVPCMachineSpec{
-				SSHKeys: []*infrav1.IBMVPCResourceReference{
-					{},
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetReservedIPByName("subnet-id-1", "foo-machine").Return(&vpcv1.ReservedIP{
+				ID:      ptr.To("reserved-ip-id"),
+				Address: ptr.To("10.240.0.10"),
+				Target:  &vpcv1.ReservedIPTarget{ID: ptr.To("other-nic-id")},
+			}, nil)
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(HaveOccurred())
+		})
+
+		t.Run("Create machine reattaching the retained virtual network interface its reserved IP is bound to", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet:     "subnet-name-1",
+				ReservedIP: &infrav1.VPCReservedIP{Address: ptr.To("10.240.0.10")},
+			}
+			scope.IBMVPCMachine.Spec.NetworkAttachmentMode = infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+			scope.IBMVPCMachine.Spec.VirtualNetworkInterface = &infrav1.VPCVirtualNetworkInterface{
+				DeletionPolicy:     infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain,
+				RetainedNamePrefix: "workers",
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetReservedIPByName("subnet-id-1", "foo-machine").Return(&vpcv1.ReservedIP{
+				ID:      ptr.To("reserved-ip-id"),
+				Address: ptr.To("10.240.0.10"),
+				Target:  &vpcv1.ReservedIPTarget{ID: ptr.To("vni-id"), Name: ptr.To("workers-0-m4q9z")},
+			}, nil)
+			mockvpc.EXPECT().ListVirtualNetworkInterfacesByNamePrefix("workers-0-").Return([]vpcv1.VirtualNetworkInterface{
+				{
+					ID:         ptr.To("other-vni-id"),
+					Name:       ptr.To("workers-0-x7k2p"),
+					AutoDelete: ptr.To(false),
+					PrimaryIP:  &vpcv1.ReservedIPReference{ID: ptr.To("other-reserved-ip-id")},
+					Subnet:     &vpcv1.SubnetReference{ID: ptr.To("subnet-id-1")},
+				},
+				{
+					ID:         ptr.To("vni-id"),
+					Name:       ptr.To("workers-0-m4q9z"),
+					AutoDelete: ptr.To(false),
+					PrimaryIP:  &vpcv1.ReservedIPReference{ID: ptr.To("reserved-ip-id")},
+					Subnet:     &vpcv1.SubnetReference{ID: ptr.To("subnet-id-1")},
+				},
+			}, nil).Times(2)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				vni := prototype.PrimaryNetworkAttachment.VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID)
+				g.Expect(*vni.ID).To(Equal("vni-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP.ID).To(Equal("reserved-ip-id"))
+		})
+
+		t.Run("Create machine in the subnet of its failure domain", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "us-south-1"
+			scope.Machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+			scope.Machine.Spec.FailureDomain = "us-south-2"
+			scope.IBMVPCCluster.Status.Network.ControlPlaneSubnets = map[string]*infrav1.ResourceStatus{
+				"subnet-name-1": {
+					ID: "subnet-id-1",
+				},
+				"subnet-name-2": {
+					ID: "subnet-id-2",
+				},
+			}
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"worker-subnet-name-2": {
+					ID: "worker-subnet-id-2",
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id-1"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id-2"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.Zone.(*vpcv1.ZoneIdentity).Name).To(Equal("us-south-2"))
+				g.Expect(*prototype.PrimaryNetworkInterface.Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("subnet-id-2"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when no subnet exists in the failure domain of the machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.Machine.Spec.FailureDomain = "us-south-3"
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = map[string]*infrav1.ResourceStatus{
+				"worker-subnet-name-1": {
+					ID: "worker-subnet-id-1",
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("worker-subnet-id-1")}).Return(&vpcv1.Subnet{ID: ptr.To("worker-subnet-id-1"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(HaveOccurred())
+		})
+
+		t.Run("Create machine using network status security groups", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				SecurityGroups: []infrav1.VPCResource{
+					{
+						Name: core.StringPtr("security-group-1"),
+					},
+				},
+				Subnet: testSubnetName,
+			}
+			scope.IBMVPCCluster.Status = infrav1.IBMVPCClusterStatus{
+				Network: &infrav1.VPCNetworkStatus{
+					ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+						testSubnetName: {
+							ID: "subnet-id",
+						},
+					},
+					SecurityGroups: map[string]*infrav1.ResourceStatus{
+						"security-group-1": {
+							ID: "security-group-id-1",
+						},
+					},
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			// TODO(cjschaef): Enhance the mock Options parameter to validate the Network Status Security Group ID was used.
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+
+			out, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
+		t.Run("Create machine using name lookup security groups", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				SecurityGroups: []infrav1.VPCResource{
+					{
+						Name: core.StringPtr("security-group-1"),
+					},
+				},
+				Subnet: testSubnetName,
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-id")}, nil)
+			mockvpc.EXPECT().GetSecurityGroupByName("security-group-1").Return(&vpcv1.SecurityGroup{ID: core.StringPtr("security-group-id-1")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+
+			out, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
+		t.Run("Create machine using id lookup security groups", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				SecurityGroups: []infrav1.VPCResource{
+					{
+						ID: core.StringPtr("security-group-id-1"),
+					},
+				},
+				Subnet: testSubnetName,
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-id")}, nil)
+			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(&vpcv1.SecurityGroup{ID: core.StringPtr("security-group-id-1")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+
+			out, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
+		t.Run("Create machine using network status vpc", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCCluster.Status = infrav1.IBMVPCClusterStatus{
+				Network: &infrav1.VPCNetworkStatus{
+					VPC: &infrav1.ResourceStatus{
+						ID: "network-vpc-id",
+					},
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
+			// TODO(cjschaef): Enhance the mock Options parameter to validate the Network Status VPC ID was used.
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+
+			out, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
+		t.Run("Create machine from a resized source snapshot", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = *vpcMachine.Spec.DeepCopy()
+			scope.IBMVPCMachine.Spec.Image = nil
+			scope.IBMVPCMachine.Spec.SourceSnapshot = &infrav1.VPCSnapshotReference{
+				CRN: ptr.To("crn:v1:bluemix:public:is:us-south-1:a/account-id::snapshot:foo-snapshot-id"),
+			}
+			scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{SizeGiB: 200, Profile: "sdp"}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
+			mockvpc.EXPECT().GetSnapshot(&vpcv1.GetSnapshotOptions{ID: ptr.To("foo-snapshot-id")}).Return(&vpcv1.Snapshot{
+				ID:              ptr.To("foo-snapshot-id"),
+				Bootable:        ptr.To(true),
+				MinimumCapacity: ptr.To(int64(100)),
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.Cond(func(options *vpcv1.CreateInstanceOptions) bool {
+				prototype, ok := options.InstancePrototype.(*vpcv1.InstancePrototypeInstanceBySourceSnapshot)
+				if !ok {
+					return false
+				}
+				volume := prototype.BootVolumeAttachment.Volume
+				return *volume.SourceSnapshot.(*vpcv1.SnapshotIdentityByID).ID == "foo-snapshot-id" &&
+					*volume.Capacity == 200 && *volume.Profile.(*vpcv1.VolumeProfileIdentity).Name == "sdp"
+			})).Return(&vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil)
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when the source snapshot is not bootable", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = *vpcMachine.Spec.DeepCopy()
+			scope.IBMVPCMachine.Spec.Image = nil
+			scope.IBMVPCMachine.Spec.SourceSnapshot = &infrav1.VPCSnapshotReference{Name: ptr.To("foo-snapshot")}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
+			mockvpc.EXPECT().GetSnapshotByName("foo-snapshot").Return(&vpcv1.Snapshot{
+				ID:       ptr.To("foo-snapshot-id"),
+				Bootable: ptr.To(false),
+			}, nil)
+
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(HaveOccurred())
+		})
+	})
+
+	t.Run("Error when machine profile is empty", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		vpcMachine := infrav1.IBMVPCMachine{
+			Spec: infrav1.IBMVPCMachineSpec{},
+		}
+		scope.IBMVPCMachine.Spec = vpcMachine.Spec
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+		_, err := scope.CreateMachine(ctx)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when both SSHKeys ID and Name are nil", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		vpcMachine := infrav1.IBMVPCMachine{
+			Spec: infrav1.IBMVPCMachineSpec{
+				SSHKeys: []*infrav1.IBMVPCResourceReference{
+					{},
 				},
 				Image: &infrav1.IBMVPCResourceReference{
 					ID: core.StringPtr("foo-image-id"),
@@ -786,29 +1410,444 @@ func TestDeleteMachine(t *testing.T) {
 			g.Expect(err).To(Not(BeNil()))
 		})
 
-		t.Run("Empty InstanceID", func(t *testing.T) {
+		t.Run("Should retain the virtual network interfaces of the Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			scope.IBMVPCMachine.Status.InstanceID = ""
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.NetworkAttachmentMode = infrav1.VPCNetworkAttachmentModeVirtualNetworkInterface
+			scope.IBMVPCMachine.Spec.VirtualNetworkInterface = &infrav1.VPCVirtualNetworkInterface{
+				DeletionPolicy:     infrav1.VPCVirtualNetworkInterfaceDeletionPolicyRetain,
+				RetainedNamePrefix: "workers",
+			}
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockgt := gtmock.NewMockGlobalTagging(mockController)
+			scope.GlobalTaggingClient = mockgt
+			mockvpc.EXPECT().GetInstance(&vpcv1.GetInstanceOptions{ID: ptr.To(testInstanceID)}).Return(&vpcv1.Instance{
+				PrimaryNetworkAttachment: &vpcv1.InstanceNetworkAttachmentReference{ID: ptr.To("primary-attachment-id")},
+				NetworkAttachments: []vpcv1.InstanceNetworkAttachmentReference{
+					{ID: ptr.To("primary-attachment-id"), VirtualNetworkInterface: &vpcv1.VirtualNetworkInterfaceReferenceAttachmentContext{ID: ptr.To("vni-id")}},
+					{ID: ptr.To("attachment-id"), VirtualNetworkInterface: &vpcv1.VirtualNetworkInterfaceReferenceAttachmentContext{ID: ptr.To("additional-vni-id")}},
+				},
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVirtualNetworkInterface(&vpcv1.GetVirtualNetworkInterfaceOptions{ID: ptr.To("vni-id")}).Return(&vpcv1.VirtualNetworkInterface{
+				ID:         ptr.To("vni-id"),
+				CRN:        ptr.To("vni-crn"),
+				Name:       ptr.To("generated-name"),
+				AutoDelete: ptr.To(true),
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().UpdateVirtualNetworkInterface(gomock.Cond(func(options *vpcv1.UpdateVirtualNetworkInterfaceOptions) bool {
+				name, ok := options.VirtualNetworkInterfacePatch["name"].(*string)
+				return *options.ID == "vni-id" && ok && isRetainedVirtualNetworkInterfaceName(*name, "workers-0-") &&
+					!*options.VirtualNetworkInterfacePatch["auto_delete"].(*bool)
+			})).Return(&vpcv1.VirtualNetworkInterface{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVirtualNetworkInterface(&vpcv1.GetVirtualNetworkInterfaceOptions{ID: ptr.To("additional-vni-id")}).Return(&vpcv1.VirtualNetworkInterface{
+				ID:         ptr.To("additional-vni-id"),
+				CRN:        ptr.To("additional-vni-crn"),
+				Name:       ptr.To("workers-1-q8w3e"),
+				AutoDelete: ptr.To(false),
+			}, &core.DetailedResponse{}, nil)
+			mockgt.EXPECT().GetTagByName(clusterName).Return(&globaltaggingv1.Tag{Name: ptr.To(clusterName)}, nil).Times(2)
+			var taggedCRNs []string
+			mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+				g.Expect(*options.TagName).To(Equal(clusterName))
+				taggedCRNs = append(taggedCRNs, *options.Resources[0].ResourceID)
+				return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+			}).Times(2)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
 			err := scope.DeleteMachine()
 			g.Expect(err).To(BeNil())
+			g.Expect(taggedCRNs).To(ConsistOf("vni-crn", "additional-vni-crn"))
 		})
-	})
-}
 
-func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
-	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
-		t.Helper()
-		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
-	}
+		t.Run("Should delete the reserved IP with the Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.VPCReservedIPStatus{ID: "reserved-ip-id", SubnetID: "subnet-id-1"}
+			mockvpc.EXPECT().UpdateSubnetReservedIP(&vpcv1.UpdateSubnetReservedIPOptions{
+				SubnetID:        ptr.To("subnet-id-1"),
+				ID:              ptr.To("reserved-ip-id"),
+				ReservedIPPatch: map[string]interface{}{"auto_delete": ptr.To(true)},
+			}).Return(&vpcv1.ReservedIP{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(BeNil())
+		})
 
-	vpcMachine := infrav1.IBMVPCMachine{
-		Spec: infrav1.IBMVPCMachineSpec{
-			Name: "foo-machine",
-		},
-		Status: infrav1.IBMVPCMachineStatus{
+		t.Run("Should delete the reserved IP of a Machine without instance", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.VPCReservedIPStatus{ID: "reserved-ip-id", SubnetID: "subnet-id-1"}
+			mockvpc.EXPECT().DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{
+				SubnetID: ptr.To("subnet-id-1"),
+				ID:       ptr.To("reserved-ip-id"),
+			}).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(BeNil())
+		})
+
+		t.Run("Empty InstanceID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Status.InstanceID = ""
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+	})
+}
+
+func TestReconcileIPAddressClaim(t *testing.T) {
+	poolRef := &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"}
+
+	t.Run("Skip when the address is not allocated from an IPAM pool", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, nil)
+		allocated, err := scope.ReconcileIPAddressClaim(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(allocated).To(BeTrue())
+	})
+
+	t.Run("Should create the IPAddressClaim of the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, nil)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.ReservedIP = &infrav1.VPCReservedIP{PoolRef: poolRef}
+		allocated, err := scope.ReconcileIPAddressClaim(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(allocated).To(BeFalse())
+
+		claim := &ipamv1.IPAddressClaim{}
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: machineName}, claim)).To(Succeed())
+		g.Expect(claim.Spec.PoolRef).To(Equal(*poolRef))
+		g.Expect(claim.OwnerReferences).To(HaveLen(1))
+		g.Expect(claim.OwnerReferences[0].Kind).To(Equal("IBMVPCMachine"))
+	})
+
+	t.Run("Should report the address allocated to the IPAddressClaim", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, nil)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.ReservedIP = &infrav1.VPCReservedIP{PoolRef: poolRef}
+		g.Expect(scope.Client.Create(ctx, &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: defaultNamespace},
+			Spec:       ipamv1.IPAddressClaimSpec{PoolRef: *poolRef},
+			Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "foo-machine-address"}},
+		})).To(Succeed())
+		allocated, err := scope.ReconcileIPAddressClaim(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(allocated).To(BeTrue())
+	})
+}
+
+func TestReconcileFloatingIP(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	instance := &vpcv1.Instance{
+		Name: ptr.To("foo-machine"),
+		PrimaryNetworkInterface: &vpcv1.NetworkInterfaceInstanceContextReference{
+			ID:        ptr.To("primary-nic-id"),
+			PrimaryIP: &vpcv1.ReservedIPReference{Address: ptr.To("10.0.0.4")},
+		},
+	}
+
+	t.Run("Should create a floating IP bound to the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockgt := gtmock.NewMockGlobalTagging(mockController)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{}
+		mockvpc.EXPECT().GetFloatingIPByName(machineName).Return(nil, nil)
+		mockvpc.EXPECT().CreateFloatingIP(gomock.AssignableToTypeOf(&vpcv1.CreateFloatingIPOptions{})).DoAndReturn(func(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+			prototype := options.FloatingIPPrototype.(*vpcv1.FloatingIPPrototypeFloatingIPByTarget)
+			g.Expect(*prototype.Name).To(Equal(machineName))
+			g.Expect(*prototype.Target.(*vpcv1.FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID).ID).To(Equal("primary-nic-id"))
+			return &vpcv1.FloatingIP{ID: ptr.To("floating-ip-id"), CRN: ptr.To("floating-ip-crn"), Name: prototype.Name, Address: ptr.To("169.48.0.2")}, &core.DetailedResponse{}, nil
+		})
+		mockgt.EXPECT().GetTagByName(clusterName).Return(&globaltaggingv1.Tag{Name: ptr.To(clusterName)}, nil)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(*options.TagName).To(Equal(clusterName))
+			g.Expect(*options.Resources[0].ResourceID).To(Equal("floating-ip-crn"))
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		})
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.FloatingIP).To(Equal(&infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", Address: "169.48.0.2", ControllerCreated: ptr.To(true)}))
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(ContainElement(corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "169.48.0.2"}))
+	})
+
+	t.Run("Should bind an existing floating IP to the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{ID: ptr.To("floating-ip-id")}
+		mockvpc.EXPECT().GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(&vpcv1.FloatingIP{ID: ptr.To("floating-ip-id"), Address: ptr.To("169.48.0.2")}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().UpdateFloatingIP(gomock.AssignableToTypeOf(&vpcv1.UpdateFloatingIPOptions{})).Return(&vpcv1.FloatingIP{ID: ptr.To("floating-ip-id"), Address: ptr.To("169.48.0.2")}, &core.DetailedResponse{}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.FloatingIP).To(Equal(&infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", Address: "169.48.0.2", ControllerCreated: ptr.To(false)}))
+	})
+
+	t.Run("Should not update a floating IP already bound to the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{}
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", ControllerCreated: ptr.To(true)}
+		mockvpc.EXPECT().GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(&vpcv1.FloatingIP{
+			ID:      ptr.To("floating-ip-id"),
+			Name:    ptr.To(machineName),
+			Address: ptr.To("169.48.0.2"),
+			Target:  &vpcv1.FloatingIPTarget{ID: ptr.To("primary-nic-id")},
+		}, &core.DetailedResponse{}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Status.FloatingIP.ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("Should not own an existing floating IP named after the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{}
+		mockvpc.EXPECT().GetFloatingIPByName(machineName).Return(&vpcv1.FloatingIP{
+			ID:      ptr.To("floating-ip-id"),
+			Name:    ptr.To(machineName),
+			Address: ptr.To("169.48.0.2"),
+			Target:  &vpcv1.FloatingIPTarget{ID: ptr.To("primary-nic-id")},
+		}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Status.FloatingIP.ControllerCreated).To(BeFalse())
+	})
+
+	t.Run("Should own an existing floating IP named after the machine tagged with the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockgt := gtmock.NewMockGlobalTagging(mockController)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{}
+		mockvpc.EXPECT().GetFloatingIPByName(machineName).Return(&vpcv1.FloatingIP{
+			ID:      ptr.To("floating-ip-id"),
+			CRN:     ptr.To("floating-ip-crn"),
+			Name:    ptr.To(machineName),
+			Address: ptr.To("169.48.0.2"),
+			Target:  &vpcv1.FloatingIPTarget{ID: ptr.To("primary-nic-id")},
+		}, nil)
+		mockgt.EXPECT().GetAttachedTags("floating-ip-crn").Return([]string{clusterName}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Status.FloatingIP.ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("Should delete the floating IP created by the controller once removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", Address: "169.48.0.2", ControllerCreated: ptr.To(true)}
+		mockvpc.EXPECT().DeleteFloatingIP(&vpcv1.DeleteFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(&core.DetailedResponse{}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.FloatingIP).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).ToNot(ContainElement(corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "169.48.0.2"}))
+	})
+
+	t.Run("Should unbind an existing floating IP once removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", Address: "169.48.0.2", ControllerCreated: ptr.To(false)}
+		mockvpc.EXPECT().RemoveInstanceNetworkInterfaceFloatingIP(&vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions{
+			NetworkInterfaceID: ptr.To("primary-nic-id"),
+			ID:                 ptr.To("floating-ip-id"),
+		}).Return(&core.DetailedResponse{}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.FloatingIP).To(BeNil())
+	})
+
+	t.Run("Should release the previous floating IP once replaced in the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{ID: ptr.To("new-floating-ip-id")}
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", ControllerCreated: ptr.To(true)}
+		mockvpc.EXPECT().GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(&vpcv1.FloatingIP{
+			ID:     ptr.To("floating-ip-id"),
+			Name:   ptr.To(machineName),
+			Target: &vpcv1.FloatingIPTarget{ID: ptr.To("primary-nic-id")},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteFloatingIP(&vpcv1.DeleteFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(&core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: ptr.To("new-floating-ip-id")}).Return(&vpcv1.FloatingIP{
+			ID:      ptr.To("new-floating-ip-id"),
+			Address: ptr.To("169.48.0.3"),
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().UpdateFloatingIP(gomock.AssignableToTypeOf(&vpcv1.UpdateFloatingIPOptions{})).Return(&vpcv1.FloatingIP{ID: ptr.To("new-floating-ip-id"), Address: ptr.To("169.48.0.3")}, &core.DetailedResponse{}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.FloatingIP).To(Equal(&infrav1.VPCMachineFloatingIPStatus{ID: "new-floating-ip-id", Address: "169.48.0.3", ControllerCreated: ptr.To(false)}))
+	})
+
+	t.Run("Error when the floating IP is bound to another resource", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{Name: ptr.To("edge-ip")}
+		mockvpc.EXPECT().GetFloatingIPByName("edge-ip").Return(&vpcv1.FloatingIP{
+			ID:     ptr.To("floating-ip-id"),
+			Target: &vpcv1.FloatingIPTarget{ID: ptr.To("other-nic-id")},
+		}, nil)
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("Error when the floating IP is not found by ID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.FloatingIP = &infrav1.VPCMachineFloatingIP{ID: ptr.To("floating-ip-id")}
+		mockvpc.EXPECT().GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("not found"))
+		err := scope.ReconcileFloatingIP(ctx, instance)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestDeleteFloatingIP(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Should release the floating IP created by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", ControllerCreated: ptr.To(true)}
+		mockvpc.EXPECT().DeleteFloatingIP(&vpcv1.DeleteFloatingIPOptions{ID: ptr.To("floating-ip-id")}).Return(&core.DetailedResponse{}, nil)
+		err := scope.DeleteFloatingIP()
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.FloatingIP).To(BeNil())
+	})
+
+	t.Run("Should keep an existing floating IP", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", ControllerCreated: ptr.To(false)}
+		err := scope.DeleteFloatingIP()
+		g.Expect(err).To(BeNil())
+	})
+}
+
+func TestDeleteMachineIgnition(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *cosmock.MockCos) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, mock.NewMockVpc(mockController), cosmock.NewMockCos(mockController)
+	}
+
+	ignition := &infrav1.VPCIgnition{
+		COSInstance: infrav1.VPCIgnitionCOSInstance{
+			BucketName:     "foo-bucket",
+			HMACSecretName: "foo-hmac-secret",
+		},
+	}
+
+	t.Run("Skip when Ignition is not configured", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		err := scope.DeleteMachineIgnition(ctx)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should delete Ignition data", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.COSClient = mockcos
+		scope.IBMVPCCluster.Spec.Ignition = ignition
+		mockcos.EXPECT().DeleteObject(&s3.DeleteObjectInput{
+			Bucket: ptr.To("foo-bucket"),
+			Key:    ptr.To("foo-cluster/node/foo-machine"),
+		}).Return(&s3.DeleteObjectOutput{}, nil)
+		err := scope.DeleteMachineIgnition(ctx)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Error when deleting Ignition data", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.COSClient = mockcos
+		scope.IBMVPCCluster.Spec.Ignition = ignition
+		mockcos.EXPECT().DeleteObject(gomock.AssignableToTypeOf(&s3.DeleteObjectInput{})).Return(nil, errors.New("failed to delete object"))
+		err := scope.DeleteMachineIgnition(ctx)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should consider missing Ignition data as deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.COSClient = mockcos
+		scope.IBMVPCCluster.Spec.Ignition = ignition
+		mockcos.EXPECT().DeleteObject(gomock.AssignableToTypeOf(&s3.DeleteObjectInput{})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil))
+		err := scope.DeleteMachineIgnition(ctx)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should skip the deletion when the HMAC secret is missing", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Ignition = ignition
+		err := scope.DeleteMachineIgnition(ctx)
+		g.Expect(err).To(BeNil())
+	})
+}
+
+func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcMachine := infrav1.IBMVPCMachine{
+		Spec: infrav1.IBMVPCMachineSpec{
+			Name: "foo-machine",
+		},
+		Status: infrav1.IBMVPCMachineStatus{
 			Addresses: []corev1.NodeAddress{
 				{
 					Type:    corev1.NodeInternalIP,
@@ -1285,3 +2324,894 @@ func TestAttachVolume(t *testing.T) {
 		g.Expect(errors.Is(err, volumeAttachmentError)).To(BeTrue())
 	})
 }
+
+func TestDetachRemovedVolumes(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	attachment := func(name, volumeID string, deleteOnInstanceDelete bool) vpcv1.VolumeAttachment {
+		return vpcv1.VolumeAttachment{
+			ID:                           ptr.To(name + "-attachment-id"),
+			Name:                         ptr.To(name),
+			DeleteVolumeOnInstanceDelete: ptr.To(deleteOnInstanceDelete),
+			Volume:                       &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: ptr.To(volumeID)},
+		}
+	}
+
+	t.Run("Should detach the volumes removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.AdditionalVolumes = []*infrav1.VPCVolume{{Name: "data-2"}}
+		scope.IBMVPCMachine.Status.InstanceID = testInstanceID
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			AdditionalVolumeIDs: []string{"volume-id-0", "volume-id-1", "volume-id-2"},
+		}
+		attachments := []vpcv1.VolumeAttachment{
+			{Name: ptr.To("boot"), Volume: &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: ptr.To("boot-volume-id")}},
+			attachment("data-0", "volume-id-0", true),
+			attachment("data-1", "volume-id-1", false),
+			attachment("data-2", "volume-id-2", true),
+		}
+		mockVPC.EXPECT().DetachVolumeFromInstance(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+			InstanceID: ptr.To(testInstanceID),
+			ID:         ptr.To("data-0-attachment-id"),
+		}).Return(&core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().DetachVolumeFromInstance(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+			InstanceID: ptr.To(testInstanceID),
+			ID:         ptr.To("data-1-attachment-id"),
+		}).Return(&core.DetailedResponse{}, nil)
+		detached, err := scope.DetachRemovedVolumes(attachments)
+		g.Expect(err).To(BeNil())
+		g.Expect(detached).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs).To(Equal([]string{"volume-id-2"}))
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.RemovedVolumeIDs).To(Equal([]string{"volume-id-0"}))
+	})
+
+	t.Run("Should keep the volumes not attached yet", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.AdditionalVolumes = []*infrav1.VPCVolume{{Name: "data-0"}, {Name: "data-1"}, {Name: "data-2"}}
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			AdditionalVolumeIDs: []string{"volume-id-0", "", "volume-id-2"},
+		}
+		detached, err := scope.DetachRemovedVolumes([]vpcv1.VolumeAttachment{attachment("data-0", "volume-id-0", true)})
+		g.Expect(err).To(BeNil())
+		g.Expect(detached).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs).To(Equal([]string{"volume-id-0", "", "volume-id-2"}))
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.RemovedVolumeIDs).To(BeEmpty())
+	})
+
+	t.Run("Should not detach volumes when a volume of the spec is unnamed", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.AdditionalVolumes = []*infrav1.VPCVolume{{SizeGiB: 10}}
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			AdditionalVolumeIDs: []string{"volume-id-0"},
+		}
+		detached, err := scope.DetachRemovedVolumes([]vpcv1.VolumeAttachment{attachment("generated-name", "volume-id-0", true)})
+		g.Expect(err).To(BeNil())
+		g.Expect(detached).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.AdditionalVolumeIDs).To(Equal([]string{"volume-id-0"}))
+	})
+}
+
+func TestDeleteRemovedVolumes(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Should delete the detached volumes and keep the attached ones", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			RemovedVolumeIDs: []string{"detached-volume-id", "attached-volume-id", "deleted-volume-id"},
+		}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("detached-volume-id")}).Return(&vpcv1.Volume{
+			AttachmentState: ptr.To(vpcv1.VolumeAttachmentStateUnattachedConst),
+		}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().DeleteVolume(&vpcv1.DeleteVolumeOptions{ID: ptr.To("detached-volume-id")}).Return(&core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("attached-volume-id")}).Return(&vpcv1.Volume{
+			AttachmentState: ptr.To(vpcv1.VolumeAttachmentStateAttachedConst),
+		}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("deleted-volume-id")}).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("volume not found"))
+		pending, err := scope.DeleteRemovedVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(pending).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.RemovedVolumeIDs).To(Equal([]string{"attached-volume-id"}))
+	})
+
+	t.Run("Error when deleting a detached volume", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			RemovedVolumeIDs: []string{"detached-volume-id"},
+		}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("detached-volume-id")}).Return(&vpcv1.Volume{
+			AttachmentState: ptr.To(vpcv1.VolumeAttachmentStateUnattachedConst),
+		}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().DeleteVolume(&vpcv1.DeleteVolumeOptions{ID: ptr.To("detached-volume-id")}).Return(&core.DetailedResponse{}, errors.New("failed volume deletion"))
+		pending, err := scope.DeleteRemovedVolumes()
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(pending).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.V1Beta2.RemovedVolumeIDs).To(Equal([]string{"detached-volume-id"}))
+	})
+}
+
+func TestReconcileVolumeUpdates(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	instance := &vpcv1.Instance{
+		BootVolumeAttachment: &vpcv1.VolumeAttachmentReferenceInstanceContext{
+			Volume: &vpcv1.VolumeReferenceVolumeAttachmentContext{
+				ID: ptr.To("boot-volume-id"),
+			},
+		},
+	}
+	volume := func(capacity, iops int64, profile, status string) *vpcv1.Volume {
+		return &vpcv1.Volume{
+			Capacity:        ptr.To(capacity),
+			Iops:            ptr.To(iops),
+			Profile:         &vpcv1.VolumeProfileReference{Name: ptr.To(profile)},
+			Status:          ptr.To(status),
+			AttachmentState: ptr.To(vpcv1.VolumeAttachmentStateAttachedConst),
+		}
+	}
+
+	t.Run("Should expand the boot volume and update the iops and profile of an additional volume", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{SizeGiB: 200, Profile: "general-purpose"}
+		scope.IBMVPCMachine.Spec.AdditionalVolumes = []*infrav1.VPCVolume{
+			{Name: "data", SizeGiB: 50, Profile: "custom", Iops: 2000},
+			{Name: "logs", SizeGiB: 10, Profile: "general-purpose"},
+			{Name: "pending"},
+		}
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			AdditionalVolumeIDs: []string{"data-volume-id", "logs-volume-id", ""},
+		}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("boot-volume-id")}).Return(volume(100, 3000, "general-purpose", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().UpdateVolume(&vpcv1.UpdateVolumeOptions{
+			ID:          ptr.To("boot-volume-id"),
+			VolumePatch: map[string]interface{}{"capacity": ptr.To(int64(200))},
+		}).Return(&vpcv1.Volume{}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("data-volume-id")}).Return(volume(50, 3000, "10iops-tier", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().UpdateVolume(&vpcv1.UpdateVolumeOptions{
+			ID: ptr.To("data-volume-id"),
+			VolumePatch: map[string]interface{}{
+				"iops":    ptr.To(int64(2000)),
+				"profile": map[string]interface{}{"name": ptr.To("custom")},
+			},
+		}).Return(&vpcv1.Volume{}, &core.DetailedResponse{}, nil)
+		// A size smaller than the capacity of the volume is ignored.
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("logs-volume-id")}).Return(volume(20, 3000, "general-purpose", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		updating, err := scope.ReconcileVolumeUpdates(instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(updating).To(BeTrue())
+	})
+
+	t.Run("Should not update volumes matching the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{Profile: "general-purpose"}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("boot-volume-id")}).Return(volume(100, 3000, "general-purpose", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		updating, err := scope.ReconcileVolumeUpdates(instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(updating).To(BeFalse())
+	})
+
+	t.Run("Should wait for a volume being updated", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{SizeGiB: 200, Profile: "general-purpose"}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("boot-volume-id")}).Return(volume(200, 3000, "general-purpose", vpcv1.VolumeStatusUpdatingConst), &core.DetailedResponse{}, nil)
+		updating, err := scope.ReconcileVolumeUpdates(instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(updating).To(BeTrue())
+	})
+
+	t.Run("Error when updating a volume", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{SizeGiB: 200, Profile: "general-purpose"}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("boot-volume-id")}).Return(volume(100, 3000, "general-purpose", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().UpdateVolume(gomock.AssignableToTypeOf(&vpcv1.UpdateVolumeOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed volume update"))
+		updating, err := scope.ReconcileVolumeUpdates(instance)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(errors.Is(err, ErrVolumeUpdateRefused)).To(BeFalse())
+		g.Expect(updating).To(BeFalse())
+	})
+
+	t.Run("Should refuse to change the profile of a volume to sdp", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{Profile: "sdp"}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("boot-volume-id")}).Return(volume(100, 3000, "general-purpose", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		updating, err := scope.ReconcileVolumeUpdates(instance)
+		g.Expect(errors.Is(err, ErrVolumeUpdateRefused)).To(BeTrue())
+		g.Expect(updating).To(BeFalse())
+	})
+
+	t.Run("Should report a volume update refused by IBM Cloud", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.BootVolume = &infrav1.VPCVolume{SizeGiB: 200, Profile: "general-purpose"}
+		mockVPC.EXPECT().GetVolume(&vpcv1.GetVolumeOptions{ID: ptr.To("boot-volume-id")}).Return(volume(100, 3000, "general-purpose", vpcv1.VolumeStatusAvailableConst), &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().UpdateVolume(gomock.AssignableToTypeOf(&vpcv1.UpdateVolumeOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusBadRequest}, errors.New("invalid volume update"))
+		updating, err := scope.ReconcileVolumeUpdates(instance)
+		g.Expect(errors.Is(err, ErrVolumeUpdateRefused)).To(BeTrue())
+		g.Expect(updating).To(BeFalse())
+	})
+}
+
+func TestVolumeUpdateRefused(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reason     string
+		generation int64
+		expected   bool
+	}{
+		{
+			name:       "Refused for the current spec",
+			reason:     infrav1.IBMVPCMachineVolumesUpdateRefusedV1Beta2Reason,
+			generation: 2,
+			expected:   true,
+		},
+		{
+			name:       "Refused for a previous spec",
+			reason:     infrav1.IBMVPCMachineVolumesUpdateRefusedV1Beta2Reason,
+			generation: 1,
+			expected:   false,
+		},
+		{
+			name:       "Failed for the current spec",
+			reason:     infrav1.IBMVPCMachineVolumesUpdateFailedV1Beta2Reason,
+			generation: 2,
+			expected:   false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+			scope.IBMVPCMachine.Generation = 2
+			scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+				Conditions: []metav1.Condition{{
+					Type:               infrav1.IBMVPCMachineVolumesReadyV1Beta2Condition,
+					Status:             metav1.ConditionFalse,
+					Reason:             tc.reason,
+					ObservedGeneration: tc.generation,
+				}},
+			}
+			g.Expect(scope.VolumeUpdateRefused()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestBuildAvailabilityPrototypes(t *testing.T) {
+	testCases := []struct {
+		name               string
+		policy             *infrav1.VPCAvailabilityPolicy
+		availability       *vpcv1.InstanceAvailabilityPrototype
+		availabilityPolicy *vpcv1.InstanceAvailabilityPolicyPrototype
+	}{
+		{
+			name: "Without availability policy",
+		},
+		{
+			name:   "Spot instance",
+			policy: &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassSpot},
+			availability: &vpcv1.InstanceAvailabilityPrototype{
+				Class: ptr.To(vpcv1.InstanceAvailabilityPrototypeClassSpotConst),
+			},
+			availabilityPolicy: &vpcv1.InstanceAvailabilityPolicyPrototype{
+				Preemption: ptr.To(vpcv1.InstanceAvailabilityPolicyPrototypePreemptionStopConst),
+			},
+		},
+		{
+			name:   "Standard instance stopped on host failure",
+			policy: &infrav1.VPCAvailabilityPolicy{Class: infrav1.VPCAvailabilityClassStandard, HostFailure: infrav1.VPCHostFailurePolicyStop},
+			availability: &vpcv1.InstanceAvailabilityPrototype{
+				Class: ptr.To(vpcv1.InstanceAvailabilityPrototypeClassStandardConst),
+			},
+			availabilityPolicy: &vpcv1.InstanceAvailabilityPolicyPrototype{
+				HostFailure: ptr.To(vpcv1.InstanceAvailabilityPolicyPrototypeHostFailureStopConst),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+			scope.IBMVPCMachine.Spec.AvailabilityPolicy = tc.policy
+			availability, availabilityPolicy := scope.buildAvailabilityPrototypes()
+			g.Expect(availability).To(Equal(tc.availability))
+			g.Expect(availabilityPolicy).To(Equal(tc.availabilityPolicy))
+		})
+	}
+}
+
+func TestConfigureReservationAffinity(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	reservation := func(status string, available int64) *vpcv1.Reservation {
+		return &vpcv1.Reservation{
+			ID:     ptr.To("reservation-id"),
+			Name:   ptr.To("reservation"),
+			Status: ptr.To(status),
+			Capacity: &vpcv1.ReservationCapacity{
+				Total:     ptr.To(int64(2)),
+				Available: ptr.To(available),
+			},
+		}
+	}
+
+	t.Run("Should use an automatic reservation affinity without a lookup", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1.VPCMachinePlacementTarget{
+			Reservation: &infrav1.VPCReservationTarget{AffinityPolicy: infrav1.VPCReservationAffinityPolicyAutomatic},
+		}
+		affinity, err := scope.configureReservationAffinity(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*affinity.Policy).To(Equal(vpcv1.InstanceReservationAffinityPrototypePolicyAutomaticConst))
+		g.Expect(affinity.Pool).To(BeEmpty())
+	})
+
+	t.Run("Should pin the instance to a reservation with available capacity", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1.VPCMachinePlacementTarget{
+			Reservation: &infrav1.VPCReservationTarget{Name: ptr.To("reservation")},
+		}
+		mockVPC.EXPECT().GetReservationByName("reservation").Return(reservation(vpcv1.ReservationStatusActiveConst, 1), nil)
+		affinity, err := scope.configureReservationAffinity(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*affinity.Policy).To(Equal(vpcv1.InstanceReservationAffinityPrototypePolicyManualConst))
+		g.Expect(affinity.Pool).To(Equal([]vpcv1.ReservationIdentityIntf{&vpcv1.ReservationIdentityByID{ID: ptr.To("reservation-id")}}))
+	})
+
+	t.Run("Should return an error when the reservation is exhausted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1.VPCMachinePlacementTarget{
+			Reservation: &infrav1.VPCReservationTarget{ID: ptr.To("reservation-id")},
+		}
+		mockVPC.EXPECT().GetReservation(gomock.AssignableToTypeOf(&vpcv1.GetReservationOptions{})).Return(reservation(vpcv1.ReservationStatusActiveConst, 0), &core.DetailedResponse{}, nil)
+		_, err := scope.configureReservationAffinity(ctx)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("Should return an error when the reservation is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1.VPCMachinePlacementTarget{
+			Reservation: &infrav1.VPCReservationTarget{Name: ptr.To("reservation")},
+		}
+		mockVPC.EXPECT().GetReservationByName("reservation").Return(nil, nil)
+		_, err := scope.configureReservationAffinity(ctx)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestBuildMetadataPrototypes(t *testing.T) {
+	t.Run("Should not configure the metadata service by default", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		metadataService, defaultTrustedProfile := scope.buildMetadataPrototypes()
+		g.Expect(metadataService).To(BeNil())
+		g.Expect(defaultTrustedProfile).To(BeNil())
+	})
+
+	t.Run("Should enable the metadata service serving the tokens of the trusted profile", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		scope.IBMVPCMachine.Spec.MetadataService = &infrav1.VPCMetadataService{
+			Enabled:          true,
+			Protocol:         infrav1.VPCMetadataServiceProtocolHTTPS,
+			ResponseHopLimit: 2,
+		}
+		scope.IBMVPCMachine.Spec.DefaultTrustedProfile = &infrav1.VPCTrustedProfileReference{
+			CRN:      ptr.To("crn:v1:bluemix:public:iam-identity::a/account-id::profile:Profile-id"),
+			AutoLink: ptr.To(false),
+		}
+		metadataService, defaultTrustedProfile := scope.buildMetadataPrototypes()
+		g.Expect(metadataService).To(Equal(&vpcv1.InstanceMetadataServicePrototype{
+			Enabled:          ptr.To(true),
+			Protocol:         ptr.To(vpcv1.InstanceMetadataServicePrototypeProtocolHTTPSConst),
+			ResponseHopLimit: ptr.To(int64(2)),
+		}))
+		g.Expect(defaultTrustedProfile).To(Equal(&vpcv1.InstanceDefaultTrustedProfilePrototype{
+			AutoLink: ptr.To(false),
+			Target:   &vpcv1.TrustedProfileIdentityByCRN{CRN: ptr.To("crn:v1:bluemix:public:iam-identity::a/account-id::profile:Profile-id")},
+		}))
+	})
+}
+
+func TestConfigureSecurityOptions(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	profile := &vpcv1.InstanceProfile{
+		Name:                     ptr.To("bx3dc-2x10"),
+		SecureBootModes:          &vpcv1.InstanceProfileSupportedSecureBootModes{Values: []bool{false, true}},
+		ConfidentialComputeModes: &vpcv1.InstanceProfileSupportedConfidentialComputeModes{Values: []string{"disabled", "sgx"}},
+	}
+
+	t.Run("Should not look up the profile without security options", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		enableSecureBoot, confidentialComputeMode, err := scope.configureSecurityOptions(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(enableSecureBoot).To(BeNil())
+		g.Expect(confidentialComputeMode).To(BeNil())
+	})
+
+	t.Run("Should enable secure boot and SGX supported by the profile", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.Profile = "bx3dc-2x10"
+		scope.IBMVPCMachine.Spec.SecurityOptions = &infrav1.VPCSecurityOptions{
+			SecureBoot:              ptr.To(true),
+			ConfidentialComputeMode: infrav1.VPCConfidentialComputeModeSGX,
+		}
+		mockVPC.EXPECT().GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: ptr.To("bx3dc-2x10")}).Return(profile, &core.DetailedResponse{}, nil)
+		enableSecureBoot, confidentialComputeMode, err := scope.configureSecurityOptions(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*enableSecureBoot).To(BeTrue())
+		g.Expect(*confidentialComputeMode).To(Equal(vpcv1.InstanceConfidentialComputeModeSgxConst))
+	})
+
+	t.Run("Should return an error when the confidential compute mode is not supported by the profile", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.Profile = "bx3dc-2x10"
+		scope.IBMVPCMachine.Spec.SecurityOptions = &infrav1.VPCSecurityOptions{ConfidentialComputeMode: infrav1.VPCConfidentialComputeModeTDX}
+		mockVPC.EXPECT().GetInstanceProfile(gomock.Any()).Return(profile, &core.DetailedResponse{}, nil)
+		_, _, err := scope.configureSecurityOptions(ctx)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("Should report the effective security options of the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.SetSecurityOptions(&vpcv1.Instance{
+			EnableSecureBoot:        ptr.To(true),
+			ConfidentialComputeMode: ptr.To(vpcv1.InstanceConfidentialComputeModeTdxConst),
+		})
+		g.Expect(scope.IBMVPCMachine.Status.SecurityOptions).To(Equal(&infrav1.VPCSecurityOptionsStatus{
+			SecureBoot:              true,
+			ConfidentialComputeMode: infrav1.VPCConfidentialComputeModeTDX,
+		}))
+	})
+}
+
+func TestReconcileInstanceProfile(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	instance := func(status, profile string) *vpcv1.Instance {
+		return &vpcv1.Instance{
+			ID:      ptr.To("instance-id"),
+			Status:  ptr.To(status),
+			Profile: &vpcv1.InstanceProfileReference{Name: ptr.To(profile)},
+		}
+	}
+	resizeAllowed := map[string]string{infrav1.InPlaceResizeAnnotation: "true"}
+	actionType := func(actionType string) gomock.Matcher {
+		return gomock.Cond(func(options *vpcv1.CreateInstanceActionOptions) bool {
+			return *options.InstanceID == "instance-id" && *options.Type == actionType
+		})
+	}
+	profile := func(name, family, architecture string) *vpcv1.InstanceProfile {
+		return &vpcv1.InstanceProfile{
+			Name:             ptr.To(name),
+			Family:           ptr.To(family),
+			VcpuArchitecture: &vpcv1.InstanceProfileVcpuArchitecture{Value: ptr.To(architecture)},
+			SecureBootModes:  &vpcv1.InstanceProfileSupportedSecureBootModes{Values: []bool{false}},
+		}
+	}
+	expectProfiles := func(mockVPC *mock.MockVpc, profiles ...*vpcv1.InstanceProfile) {
+		for _, profile := range profiles {
+			mockVPC.EXPECT().GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: profile.Name}).Return(profile, &core.DetailedResponse{}, nil)
+		}
+	}
+
+	t.Run("Should not resize an instance matching the profile", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resizing).To(BeFalse())
+	})
+
+	t.Run("Should not resize an instance without the in-place resize annotation", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resizing).To(BeFalse())
+		g.Expect(scope.InstanceProfileDrifted(instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))).To(BeTrue())
+	})
+
+	t.Run("Should stop a running instance to resize it", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("bx2-4x16", "balanced", "amd64"))
+		mockVPC.EXPECT().CreateInstanceAction(actionType(vpcv1.CreateInstanceActionOptionsTypeStopConst)).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil)
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resizing).To(BeTrue())
+	})
+
+	t.Run("Should not stop an instance to resize it to a profile of another family", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "gx2-8x64x1v100"
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("gx2-8x64x1v100", "gpu", "amd64"))
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resizing).To(BeFalse())
+	})
+
+	t.Run("Should not stop an instance to resize it to a profile of another architecture", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bz2-4x16"
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("bz2-4x16", "balanced", "s390x"))
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resizing).To(BeFalse())
+	})
+
+	t.Run("Should not stop an instance to resize it to a profile not supporting its security options", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		scope.IBMVPCMachine.Spec.SecurityOptions = &infrav1.VPCSecurityOptions{SecureBoot: ptr.To(true)}
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("bx2-4x16", "balanced", "amd64"))
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusRunningConst, "bx2-2x8"))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resizing).To(BeFalse())
+	})
+
+	t.Run("Should update the profile of a stopped instance and start it", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("bx2-4x16", "balanced", "amd64"))
+		gomock.InOrder(
+			mockVPC.EXPECT().UpdateInstance(gomock.Cond(func(options *vpcv1.UpdateInstanceOptions) bool {
+				profile, ok := options.InstancePatch["profile"].(map[string]interface{})
+				return ok && ptr.Deref(profile["name"].(*string), "") == "bx2-4x16"
+			})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil),
+			mockVPC.EXPECT().CreateInstanceAction(actionType(vpcv1.CreateInstanceActionOptionsTypeStartConst)).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil),
+		)
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusStoppedConst, "bx2-2x8"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resizing).To(BeTrue())
+	})
+
+	t.Run("Should start a resized instance that is still stopped", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{
+			Conditions: []metav1.Condition{{
+				Type:   infrav1.IBMVPCMachineProfileReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.IBMVPCMachineProfileResizingV1Beta2Reason,
+			}},
+		}
+		mockVPC.EXPECT().CreateInstanceAction(actionType(vpcv1.CreateInstanceActionOptionsTypeStartConst)).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil)
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusStoppedConst, "bx2-4x16"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resizing).To(BeTrue())
+	})
+
+	t.Run("Should return an error and start the instance when the profile update fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("bx2-4x16", "balanced", "amd64"))
+		gomock.InOrder(
+			mockVPC.EXPECT().UpdateInstance(gomock.AssignableToTypeOf(&vpcv1.UpdateInstanceOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to update instance")),
+			mockVPC.EXPECT().CreateInstanceAction(actionType(vpcv1.CreateInstanceActionOptionsTypeStartConst)).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil),
+		)
+		resizing, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusStoppedConst, "bx2-2x8"))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resizing).To(BeFalse())
+	})
+
+	t.Run("Should start a stopped instance which cannot be resized to the profile", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Annotations = resizeAllowed
+		scope.IBMVPCMachine.Spec.Profile = "bz2-4x16"
+		expectProfiles(mockVPC, profile("bx2-2x8", "balanced", "amd64"), profile("bz2-4x16", "balanced", "s390x"))
+		mockVPC.EXPECT().CreateInstanceAction(actionType(vpcv1.CreateInstanceActionOptionsTypeStartConst)).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil)
+		_, err := scope.ReconcileInstanceProfile(ctx, instance(vpcv1.InstanceStatusStoppedConst, "bx2-2x8"))
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestReconcileAdditionalTags(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, mock.NewMockVpc(mockController), gtmock.NewMockGlobalTagging(mockController)
+	}
+
+	instance := &vpcv1.Instance{
+		CRN: ptr.To("instance-crn"),
+		BootVolumeAttachment: &vpcv1.VolumeAttachmentReferenceInstanceContext{
+			Volume: &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: ptr.To("boot-volume-id")},
+		},
+		VolumeAttachments: []vpcv1.VolumeAttachmentReferenceInstanceContext{
+			{Volume: &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: ptr.To("boot-volume-id"), CRN: ptr.To("boot-volume-crn")}},
+			{Volume: &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: ptr.To("data-volume-id"), CRN: ptr.To("data-volume-crn")}},
+			{Volume: &vpcv1.VolumeReferenceVolumeAttachmentContext{ID: ptr.To("foreign-volume-id"), CRN: ptr.To("foreign-volume-crn")}},
+		},
+	}
+	resourceIDs := func(resources []globaltaggingv1.Resource) []string {
+		ids := make([]string, 0, len(resources))
+		for _, resource := range resources {
+			ids = append(ids, *resource.ResourceID)
+		}
+		return ids
+	}
+
+	t.Run("Should not tag resources when the attached tags are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCMachine.Spec.AdditionalTags = []string{"env:test"}
+		scope.IBMVPCMachine.Status.AttachedTags = []string{"env:test"}
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instance)).To(Succeed())
+	})
+
+	t.Run("Should attach cluster and machine tags to the instance and its volumes", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCCluster.Spec.AdditionalTags = []string{"team:infra"}
+		scope.IBMVPCMachine.Spec.AdditionalTags = []string{"env:test"}
+		scope.IBMVPCMachine.Status.V1Beta2 = &infrav1.IBMVPCMachineV1Beta2Status{AdditionalVolumeIDs: []string{"data-volume-id"}}
+		mockGT.EXPECT().AttachTag(gomock.Cond(func(options *globaltaggingv1.AttachTagOptions) bool {
+			return g.Expect(options.TagNames).To(Equal([]string{"env:test", "team:infra"})) &&
+				g.Expect(resourceIDs(options.Resources)).To(Equal([]string{"instance-crn", "boot-volume-crn", "data-volume-crn"}))
+		})).Return(&globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil)
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instance)).To(Succeed())
+		g.Expect(scope.IBMVPCMachine.Status.AttachedTags).To(Equal([]string{"env:test", "team:infra"}))
+	})
+
+	t.Run("Should detach tags removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCMachine.Status.AttachedTags = []string{"env:test"}
+		mockGT.EXPECT().DetachTag(gomock.Cond(func(options *globaltaggingv1.DetachTagOptions) bool {
+			return g.Expect(options.TagNames).To(Equal([]string{"env:test"})) &&
+				g.Expect(resourceIDs(options.Resources)).To(Equal([]string{"instance-crn", "boot-volume-crn"}))
+		})).Return(&globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil)
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instance)).To(Succeed())
+		g.Expect(scope.IBMVPCMachine.Status.AttachedTags).To(BeEmpty())
+	})
+
+	t.Run("Should include the floating IP created for the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCMachine.Spec.AdditionalTags = []string{"env:test"}
+		scope.IBMVPCMachine.Status.FloatingIP = &infrav1.VPCMachineFloatingIPStatus{ID: "floating-ip-id", ControllerCreated: ptr.To(true)}
+		mockVPC.EXPECT().GetFloatingIP(gomock.Any()).Return(&vpcv1.FloatingIP{CRN: ptr.To("floating-ip-crn")}, &core.DetailedResponse{}, nil)
+		mockGT.EXPECT().AttachTag(gomock.Cond(func(options *globaltaggingv1.AttachTagOptions) bool {
+			return g.Expect(resourceIDs(options.Resources)).To(ContainElement("floating-ip-crn"))
+		})).Return(nil, nil, errors.New("failed to attach tags"))
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instance)).ToNot(Succeed())
+		g.Expect(scope.IBMVPCMachine.Status.AttachedTags).To(BeEmpty())
+	})
+}
+
+func TestReconcileFileShareMounts(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	instance := &vpcv1.Instance{
+		ID:   ptr.To("instance-id"),
+		Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")},
+		PrimaryNetworkAttachment: &vpcv1.InstanceNetworkAttachmentReference{
+			Subnet: &vpcv1.SubnetReference{ID: ptr.To("subnet-id")},
+		},
+	}
+	setupFileShare := func(scope *MachineScope, mode infrav1.VPCFileShareAccessControlMode, ready bool) {
+		scope.IBMVPCCluster.Spec.FileShares = []infrav1.VPCFileShare{{Name: "share", Size: 10, Zone: "us-south-1", AccessControlMode: mode}}
+		scope.IBMVPCCluster.Status.FileShares = map[string]*infrav1.VPCFileShareStatus{
+			"share": {ID: "share-id", AccessControlMode: mode, MountPath: "fsf-us-south-1.adn.networklayer.com:/share-id", Ready: ready},
+		}
+		scope.IBMVPCMachine.Spec.FileShareMounts = []infrav1.VPCFileShareMount{{Share: "share"}}
+	}
+
+	t.Run("Should wait for the file share of the cluster to be ready", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		setupFileShare(scope, infrav1.VPCFileShareAccessControlModeSecurityGroup, false)
+		pending, err := scope.ReconcileFileShareMounts(ctx, instance)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.FileShareMounts).To(Equal([]infrav1.VPCFileShareMountStatus{{Share: "share"}}))
+	})
+
+	t.Run("Should create a mount target in the subnet of the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		setupFileShare(scope, infrav1.VPCFileShareAccessControlModeSecurityGroup, true)
+		mockVPC.EXPECT().GetShareMountTargetByName("share-id", machineName).Return(nil, nil)
+		mockVPC.EXPECT().CreateShareMountTarget(gomock.Cond(func(options *vpcv1.CreateShareMountTargetOptions) bool {
+			prototype := options.ShareMountTargetPrototype.(*vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup)
+			vni := prototype.VirtualNetworkInterface.(*vpcv1.ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext)
+			return *options.ShareID == "share-id" && *prototype.Name == machineName && *vni.Subnet.(*vpcv1.SubnetIdentityByID).ID == "subnet-id"
+		})).Return(&vpcv1.ShareMountTarget{
+			ID:             ptr.To("mount-target-id"),
+			LifecycleState: ptr.To(vpcv1.ShareMountTargetLifecycleStatePendingConst),
+		}, &core.DetailedResponse{}, nil)
+		pending, err := scope.ReconcileFileShareMounts(ctx, instance)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.FileShareMounts).To(Equal([]infrav1.VPCFileShareMountStatus{{Share: "share", ShareID: "share-id", MountTargetID: "mount-target-id"}}))
+	})
+
+	t.Run("Should report the mount path of a stable mount target", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		setupFileShare(scope, infrav1.VPCFileShareAccessControlModeSecurityGroup, true)
+		scope.IBMVPCMachine.Status.FileShareMounts = []infrav1.VPCFileShareMountStatus{{Share: "share", ShareID: "share-id", MountTargetID: "mount-target-id"}}
+		mockVPC.EXPECT().GetShareMountTarget(gomock.Any()).Return(&vpcv1.ShareMountTarget{
+			ID:             ptr.To("mount-target-id"),
+			LifecycleState: ptr.To(vpcv1.ShareMountTargetLifecycleStateStableConst),
+			MountPath:      ptr.To("10.240.0.4:/share-id"),
+		}, &core.DetailedResponse{}, nil)
+		pending, err := scope.ReconcileFileShareMounts(ctx, instance)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.FileShareMounts[0].MountPath).To(Equal("10.240.0.4:/share-id"))
+		g.Expect(scope.IBMVPCMachine.Status.FileShareMounts[0].Ready).To(BeTrue())
+	})
+
+	t.Run("Should use the mount path of the cluster for a file share with VPC access", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		setupFileShare(scope, infrav1.VPCFileShareAccessControlModeVPC, true)
+		pending, err := scope.ReconcileFileShareMounts(ctx, instance)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.FileShareMounts).To(Equal([]infrav1.VPCFileShareMountStatus{
+			{Share: "share", ShareID: "share-id", MountPath: "fsf-us-south-1.adn.networklayer.com:/share-id", Ready: true},
+		}))
+	})
+
+	t.Run("Should delete the mount targets of the file shares removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		scope.IBMVPCMachine.Status.FileShareMounts = []infrav1.VPCFileShareMountStatus{{Share: "share", ShareID: "share-id", MountTargetID: "mount-target-id", Ready: true}}
+		mockVPC.EXPECT().DeleteShareMountTarget(gomock.Cond(func(options *vpcv1.DeleteShareMountTargetOptions) bool {
+			return *options.ShareID == "share-id" && *options.ID == "mount-target-id"
+		})).Return(&vpcv1.ShareMountTarget{}, &core.DetailedResponse{}, nil)
+		pending, err := scope.ReconcileFileShareMounts(ctx, instance)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.FileShareMounts).To(BeEmpty())
+	})
+
+	t.Run("Should return an error when the file share is in another zone", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockVPC)
+		setupFileShare(scope, infrav1.VPCFileShareAccessControlModeSecurityGroup, true)
+		scope.IBMVPCCluster.Spec.FileShares[0].Zone = "us-south-2"
+		_, err := scope.ReconcileFileShareMounts(ctx, instance)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
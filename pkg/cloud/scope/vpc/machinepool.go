@@ -0,0 +1,801 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/util/record"
+)
+
+const (
+	// instanceTemplateHashLength is the length of the spec hash suffix used in instance template names.
+	instanceTemplateHashLength = 8
+
+	// instanceGroupMembershipHealthy is the status of a healthy instance group membership.
+	instanceGroupMembershipHealthy = "healthy"
+)
+
+// MachinePoolScopeParams defines the input parameters used to create a new MachinePoolScope.
+type MachinePoolScopeParams struct {
+	IBMVPCClient        vpc.Vpc
+	GlobalTaggingClient globaltagging.GlobalTagging
+	Client              client.Client
+	Logger              logr.Logger
+	Cluster             *clusterv1.Cluster
+	MachinePool         *clusterv1.MachinePool
+	IBMVPCCluster       *infrav1.IBMVPCCluster
+	IBMVPCMachinePool   *infrav1.IBMVPCMachinePool
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// ClientBuilder constructs the IBM Cloud clients if IBMVPCClient or GlobalTaggingClient is not set, ProdClientBuilder
+	// is used if not set.
+	ClientBuilder ClientBuilder
+}
+
+// MachinePoolScope defines a scope defined around a machine pool and its cluster.
+type MachinePoolScope struct {
+	Client      client.Client
+	patchHelper *v1beta1patch.Helper
+
+	IBMVPCClient        vpc.Vpc
+	GlobalTaggingClient globaltagging.GlobalTagging
+	Cluster             *clusterv1.Cluster
+	MachinePool         *clusterv1.MachinePool
+	IBMVPCCluster       *infrav1.IBMVPCCluster
+	IBMVPCMachinePool   *infrav1.IBMVPCMachinePool
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// Authenticator is the authenticator used to build the IBM Cloud clients, from the credentials of the cluster.
+	Authenticator core.Authenticator
+}
+
+// NewMachinePoolScope creates a new MachinePoolScope from the supplied parameters.
+func NewMachinePoolScope(ctx context.Context, params MachinePoolScopeParams) (*MachinePoolScope, error) {
+	if params.MachinePool == nil {
+		return nil, errors.New("failed to generate new scope from nil MachinePool")
+	}
+	if params.IBMVPCMachinePool == nil {
+		return nil, errors.New("failed to generate new scope from nil IBMVPCMachinePool")
+	}
+	if params.IBMVPCCluster == nil {
+		return nil, errors.New("failed to generate new scope from nil IBMVPCCluster")
+	}
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klog.Background()
+	}
+
+	helper, err := v1beta1patch.NewHelper(params.IBMVPCMachinePool, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	var auth core.Authenticator
+	vpcClient := params.IBMVPCClient
+	globalTaggingClient := params.GlobalTaggingClient
+	if vpcClient == nil || globalTaggingClient == nil {
+		if params.ClientBuilder == nil {
+			params.ClientBuilder = ProdClientBuilder{}
+		}
+		auth, err = params.ClientBuilder.GetAuthenticator(ctx, AuthenticatorOptions{Client: params.Client, IBMVPCCluster: params.IBMVPCCluster})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
+		clientOptions := ClientOptions{
+			Authenticator:   auth,
+			Region:          params.IBMVPCCluster.Spec.Region,
+			ServiceEndpoint: params.ServiceEndpoint,
+		}
+		if vpcClient == nil {
+			vpcClient, err = params.ClientBuilder.GetVPCClient(ctx, clientOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
+			}
+		}
+		if globalTaggingClient == nil {
+			globalTaggingClient, err = params.ClientBuilder.GetGlobalTaggingClient(ctx, clientOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create global tagging client: %w", err)
+			}
+		}
+	}
+
+	if params.Logger.V(DEBUGLEVEL).Enabled() {
+		core.SetLoggingLevel(core.LevelDebug)
+	}
+
+	return &MachinePoolScope{
+		Client:              params.Client,
+		patchHelper:         helper,
+		IBMVPCClient:        vpcClient,
+		GlobalTaggingClient: globalTaggingClient,
+		Cluster:             params.Cluster,
+		MachinePool:         params.MachinePool,
+		IBMVPCCluster:       params.IBMVPCCluster,
+		IBMVPCMachinePool:   params.IBMVPCMachinePool,
+		ServiceEndpoint:     params.ServiceEndpoint,
+		Authenticator:       auth,
+	}, nil
+}
+
+// PatchObject persists the machine pool configuration and status.
+func (m *MachinePoolScope) PatchObject() error {
+	return m.patchHelper.Patch(context.TODO(), m.IBMVPCMachinePool)
+}
+
+// Close closes the current scope persisting the machine pool configuration and status.
+func (m *MachinePoolScope) Close() error {
+	return m.PatchObject()
+}
+
+// Name returns the IBMVPCMachinePool name.
+func (m *MachinePoolScope) Name() string {
+	return m.IBMVPCMachinePool.Name
+}
+
+// resourceName returns the name of the instance group of the machine pool, which prefixes the names of its instance
+// templates. It includes the name of the cluster, as the machine pools of different clusters may have the same name.
+func (m *MachinePoolScope) resourceName() string {
+	return fmt.Sprintf("%s-%s", m.IBMVPCCluster.Name, m.Name())
+}
+
+// DesiredReplicas returns the number of replicas requested by the MachinePool.
+func (m *MachinePoolScope) DesiredReplicas() int64 {
+	return int64(ptr.Deref(m.MachinePool.Spec.Replicas, 1))
+}
+
+// GetBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+func (m *MachinePoolScope) GetBootstrapData(ctx context.Context) (string, error) {
+	if m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		return "", errors.New("error retrieving bootstrap data: linked MachinePool's bootstrap.dataSecretName is nil")
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: m.MachinePool.Namespace, Name: *m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName}
+	if err := m.Client.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to retrieve bootstrap data secret for IBMVPCMachinePool %s/%s: %w", m.MachinePool.Namespace, m.MachinePool.Name, err)
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+	return string(value), nil
+}
+
+// instanceTemplateName returns the name of the instance template for the current spec and bootstrap data secret.
+// Since VPC instance templates are immutable, any change of the spec or of the name of the bootstrap data secret, e.g.
+// on a change of the bootstrap config, results in a new template name. The content of the secret is left out of the
+// name, so that its rotation, e.g. of the bootstrap token, does not roll the members of the instance group.
+func (m *MachinePoolScope) instanceTemplateName() (string, error) {
+	// ProviderIDList is populated by the controller and must not affect the template.
+	spec := m.IBMVPCMachinePool.Spec.DeepCopy()
+	spec.ProviderIDList = nil
+	specData, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal IBMVPCMachinePool spec: %w", err)
+	}
+	hash := sha256.New()
+	hash.Write(specData)
+	hash.Write([]byte(ptr.Deref(m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName, "")))
+	return fmt.Sprintf("%s-%s", m.resourceName(), hex.EncodeToString(hash.Sum(nil))[:instanceTemplateHashLength]), nil
+}
+
+// ReconcileInstanceTemplate ensures an instance template matching the current spec exists and records it in the status.
+func (m *MachinePoolScope) ReconcileInstanceTemplate(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	templateName, err := m.instanceTemplateName()
+	if err != nil {
+		return err
+	}
+
+	if m.IBMVPCMachinePool.Status.InstanceTemplate != nil && ptr.Deref(m.IBMVPCMachinePool.Status.InstanceTemplate.Name, "") == templateName {
+		return nil
+	}
+
+	template, err := m.IBMVPCClient.GetInstanceTemplateByName(templateName)
+	if err != nil {
+		return fmt.Errorf("failed to get instance template %s: %w", templateName, err)
+	}
+	if template != nil {
+		log.V(3).Info("Instance template found", "name", templateName, "id", *template.ID)
+		if err := m.tagInstanceTemplate(template); err != nil {
+			return err
+		}
+		m.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{
+			ID:   template.ID,
+			Name: template.Name,
+		}
+		return nil
+	}
+
+	bootstrapData, err := m.GetBootstrapData(ctx)
+	if err != nil {
+		return err
+	}
+
+	prototype, err := m.buildInstanceTemplatePrototype(ctx, templateName, bootstrapData)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Creating instance template", "name", templateName)
+	result, _, err := m.IBMVPCClient.CreateInstanceTemplate(&vpcv1.CreateInstanceTemplateOptions{
+		InstanceTemplatePrototype: prototype,
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachinePool, "FailedCreateInstanceTemplate", "Failed instance template creation - %v", err)
+		return fmt.Errorf("failed to create instance template: %w", err)
+	}
+	created, ok := result.(*vpcv1.InstanceTemplate)
+	if !ok || created.ID == nil {
+		return fmt.Errorf("unexpected instance template returned for %s", templateName)
+	}
+	record.Eventf(m.IBMVPCMachinePool, "SuccessfulCreateInstanceTemplate", "Created instance template %q", templateName)
+	if err := m.tagInstanceTemplate(created); err != nil {
+		return err
+	}
+	if tags := m.AdditionalTags(); len(tags) > 0 && created.CRN != nil {
+		if err := reconcileAttachedTags(m.GlobalTaggingClient, []string{*created.CRN}, tags, nil); err != nil {
+			// Attach the additional tags to all the resources of the machine pool again on the next reconciliation.
+			record.Warnf(m.IBMVPCMachinePool, "FailedTagInstanceTemplate", "Failed instance template tagging - %v", err)
+			m.IBMVPCMachinePool.Status.AttachedTags = nil
+		}
+	}
+
+	m.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{
+		ID:   created.ID,
+		Name: ptr.To(templateName),
+	}
+	return nil
+}
+
+// tagInstanceTemplate attaches the tag of the cluster to the instance template, which records that it was created for
+// the machine pool, so that it is deleted once outdated. As the template is recorded in the status only once tagged,
+// the tag is attached again when the template is found by name on the next reconciliation.
+func (m *MachinePoolScope) tagInstanceTemplate(template *vpcv1.InstanceTemplate) error {
+	if template.CRN == nil {
+		return nil
+	}
+	if err := reconcileAttachedTags(m.GlobalTaggingClient, []string{*template.CRN}, []string{m.IBMVPCCluster.Name}, nil); err != nil {
+		record.Warnf(m.IBMVPCMachinePool, "FailedTagInstanceTemplate", "Failed instance template tagging - %v", err)
+		return fmt.Errorf("failed to tag instance template %s: %w", ptr.Deref(template.Name, *template.ID), err)
+	}
+	return nil
+}
+
+// buildInstanceTemplatePrototype builds the instance template prototype from the IBMVPCMachinePool spec.
+func (m *MachinePoolScope) buildInstanceTemplatePrototype(ctx context.Context, name, bootstrapData string) (*vpcv1.InstanceTemplatePrototypeInstanceTemplateByImage, error) {
+	spec := m.IBMVPCMachinePool.Spec
+	if spec.Profile == "" {
+		return nil, fmt.Errorf("error profile is empty for machine pool %s", m.Name())
+	}
+	if spec.Image == nil {
+		return nil, fmt.Errorf("error no image provided for machine pool %s", m.Name())
+	}
+
+	imageID, err := fetchImageID(ctx, spec.Image, m.IBMVPCClient, m.IBMVPCCluster)
+	if err != nil {
+		record.Warnf(m.IBMVPCMachinePool, "FailedRetrieveImage", "Failed image retrieval - %v", err)
+		return nil, fmt.Errorf("error while fetching image ID: %w", err)
+	}
+
+	subnetIDs, err := m.GetSubnetIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	primaryNetworkInterface := &vpcv1.NetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentityByID{
+			ID: ptr.To(subnetIDs[0]),
+		},
+	}
+	if len(spec.SecurityGroups) > 0 {
+		securityGroups := make([]vpcv1.SecurityGroupIdentityIntf, 0, len(spec.SecurityGroups))
+		for _, sg := range spec.SecurityGroups {
+			sgID, err := m.getSecurityGroupID(sg)
+			if err != nil {
+				return nil, err
+			}
+			securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
+				ID: sgID,
+			})
+		}
+		primaryNetworkInterface.SecurityGroups = securityGroups
+	}
+
+	prototype := &vpcv1.InstanceTemplatePrototypeInstanceTemplateByImage{
+		Name: ptr.To(name),
+		Profile: &vpcv1.InstanceProfileIdentity{
+			Name: ptr.To(spec.Profile),
+		},
+		Image: &vpcv1.ImageIdentity{
+			ID: imageID,
+		},
+		PrimaryNetworkInterface: primaryNetworkInterface,
+		ResourceGroup: &vpcv1.ResourceGroupIdentity{
+			ID: ptr.To(m.getResourceGroupID()),
+		},
+		UserData: ptr.To(bootstrapData),
+		Zone: &vpcv1.ZoneIdentity{
+			Name: ptr.To(spec.Zone),
+		},
+	}
+	if m.IBMVPCCluster.Status.Network != nil && m.IBMVPCCluster.Status.Network.VPC != nil {
+		prototype.VPC = &vpcv1.VPCIdentityByID{
+			ID: ptr.To(m.IBMVPCCluster.Status.Network.VPC.ID),
+		}
+	}
+
+	if len(spec.SSHKeys) > 0 {
+		sshKeys := make([]vpcv1.KeyIdentityIntf, 0, len(spec.SSHKeys))
+		for _, sshKey := range spec.SSHKeys {
+			keyID, err := fetchKeyID(ctx, sshKey, m.IBMVPCClient)
+			if err != nil {
+				return nil, fmt.Errorf("error while fetching SSHKey: %v error: %w", sshKey, err)
+			}
+			sshKeys = append(sshKeys, &vpcv1.KeyIdentity{
+				ID: keyID,
+			})
+		}
+		prototype.Keys = sshKeys
+	}
+
+	if spec.BootVolume != nil {
+		prototype.BootVolumeAttachment = volumeToVPCVolumeAttachment(ctx, spec.BootVolume)
+	}
+	return prototype, nil
+}
+
+// GetSubnetIDs returns the IDs of the subnets the instance group places the instances in.
+func (m *MachinePoolScope) GetSubnetIDs() ([]string, error) {
+	subnetIDs := make([]string, 0, len(m.IBMVPCMachinePool.Spec.Subnets))
+	for _, subnet := range m.IBMVPCMachinePool.Spec.Subnets {
+		if subnet.ID != nil {
+			subnetIDs = append(subnetIDs, *subnet.ID)
+			continue
+		}
+		if subnet.Name == nil {
+			return nil, fmt.Errorf("error no name or id provided for subnet for machine pool %s", m.Name())
+		}
+		// If Network Status is available, attempt to retrieve subnet ID from there.
+		if m.IBMVPCCluster.Status.Network != nil {
+			if subnetStatus, ok := m.IBMVPCCluster.Status.Network.WorkerSubnets[*subnet.Name]; ok {
+				subnetIDs = append(subnetIDs, subnetStatus.ID)
+				continue
+			}
+			if subnetStatus, ok := m.IBMVPCCluster.Status.Network.ControlPlaneSubnets[*subnet.Name]; ok {
+				subnetIDs = append(subnetIDs, subnetStatus.ID)
+				continue
+			}
+		}
+		subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(*subnet.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving subnet %s for machine pool %s: %w", *subnet.Name, m.Name(), err)
+		} else if subnetDetails == nil {
+			return nil, fmt.Errorf("error cannot find subnet %s for machine pool %s", *subnet.Name, m.Name())
+		}
+		subnetIDs = append(subnetIDs, *subnetDetails.ID)
+	}
+	if len(subnetIDs) == 0 {
+		return nil, fmt.Errorf("error no subnets provided for machine pool %s", m.Name())
+	}
+	return subnetIDs, nil
+}
+
+// getSecurityGroupID returns the ID of the security group, looking it up by name if the ID isn't provided.
+func (m *MachinePoolScope) getSecurityGroupID(sg infrav1.VPCResource) (*string, error) {
+	if sg.ID != nil {
+		return sg.ID, nil
+	}
+	if sg.Name == nil {
+		return nil, fmt.Errorf("error no name or id provided for security group for machine pool %s", m.Name())
+	}
+	if m.IBMVPCCluster.Status.Network != nil {
+		if sgStatus, ok := m.IBMVPCCluster.Status.Network.SecurityGroups[*sg.Name]; ok {
+			return ptr.To(sgStatus.ID), nil
+		}
+	}
+	sgDetails, err := m.IBMVPCClient.GetSecurityGroupByName(*sg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving security group id with name %s for machine pool %s: %w", *sg.Name, m.Name(), err)
+	} else if sgDetails == nil {
+		return nil, fmt.Errorf("error cannot find security group %s for machine pool %s", *sg.Name, m.Name())
+	}
+	return sgDetails.ID, nil
+}
+
+// getResourceGroupID returns the resource group ID of the cluster.
+func (m *MachinePoolScope) getResourceGroupID() string {
+	if m.IBMVPCCluster.Status.ResourceGroup != nil {
+		return m.IBMVPCCluster.Status.ResourceGroup.ID
+	}
+	return m.IBMVPCCluster.Spec.ResourceGroup
+}
+
+// ReconcileInstanceGroup ensures the instance group exists, uses the current instance template and
+// has the desired number of members.
+func (m *MachinePoolScope) ReconcileInstanceGroup(ctx context.Context) (*vpcv1.InstanceGroup, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if m.IBMVPCMachinePool.Status.InstanceTemplate == nil {
+		return nil, fmt.Errorf("instance template is not yet reconciled for machine pool %s", m.Name())
+	}
+	templateID := ptr.Deref(m.IBMVPCMachinePool.Status.InstanceTemplate.ID, "")
+
+	instanceGroup, err := m.getInstanceGroup()
+	if err != nil {
+		return nil, err
+	}
+
+	if instanceGroup == nil {
+		subnetIDs, err := m.GetSubnetIDs()
+		if err != nil {
+			return nil, err
+		}
+		subnets := make([]vpcv1.SubnetIdentityIntf, 0, len(subnetIDs))
+		for _, id := range subnetIDs {
+			subnets = append(subnets, &vpcv1.SubnetIdentityByID{
+				ID: ptr.To(id),
+			})
+		}
+
+		log.Info("Creating instance group", "name", m.resourceName(), "replicas", m.DesiredReplicas())
+		instanceGroup, _, err = m.IBMVPCClient.CreateInstanceGroup(&vpcv1.CreateInstanceGroupOptions{
+			Name: ptr.To(m.resourceName()),
+			InstanceTemplate: &vpcv1.InstanceTemplateIdentityByID{
+				ID: ptr.To(templateID),
+			},
+			Subnets:         subnets,
+			MembershipCount: ptr.To(m.DesiredReplicas()),
+			ResourceGroup: &vpcv1.ResourceGroupIdentityByID{
+				ID: ptr.To(m.getResourceGroupID()),
+			},
+		})
+		if err != nil {
+			record.Warnf(m.IBMVPCMachinePool, "FailedCreateInstanceGroup", "Failed instance group creation - %v", err)
+			return nil, fmt.Errorf("failed to create instance group: %w", err)
+		}
+		record.Eventf(m.IBMVPCMachinePool, "SuccessfulCreateInstanceGroup", "Created instance group %q", m.resourceName())
+		m.IBMVPCMachinePool.Status.InstanceGroupID = *instanceGroup.ID
+		return instanceGroup, nil
+	}
+	m.IBMVPCMachinePool.Status.InstanceGroupID = *instanceGroup.ID
+
+	patch := &vpcv1.InstanceGroupPatch{}
+	needsUpdate := false
+	if instanceGroup.InstanceTemplate == nil || ptr.Deref(instanceGroup.InstanceTemplate.ID, "") != templateID {
+		patch.InstanceTemplate = &vpcv1.InstanceTemplateIdentityByID{
+			ID: ptr.To(templateID),
+		}
+		needsUpdate = true
+	}
+	if ptr.Deref(instanceGroup.MembershipCount, 0) != m.DesiredReplicas() {
+		patch.MembershipCount = ptr.To(m.DesiredReplicas())
+		needsUpdate = true
+	}
+	if !needsUpdate {
+		return instanceGroup, nil
+	}
+
+	patchMap, err := patch.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build instance group patch: %w", err)
+	}
+	log.Info("Updating instance group", "name", m.resourceName(), "replicas", m.DesiredReplicas(), "instanceTemplateID", templateID)
+	instanceGroup, _, err = m.IBMVPCClient.UpdateInstanceGroup(&vpcv1.UpdateInstanceGroupOptions{
+		ID:                 instanceGroup.ID,
+		InstanceGroupPatch: patchMap,
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachinePool, "FailedUpdateInstanceGroup", "Failed instance group update - %v", err)
+		return nil, fmt.Errorf("failed to update instance group: %w", err)
+	}
+	record.Eventf(m.IBMVPCMachinePool, "SuccessfulUpdateInstanceGroup", "Updated instance group %q", m.resourceName())
+	return instanceGroup, nil
+}
+
+// AdditionalTags returns the additional user tags of the cluster attached to the resources of the machine pool.
+func (m *MachinePoolScope) AdditionalTags() []string {
+	return mergeTags(m.IBMVPCCluster.Spec.AdditionalTags)
+}
+
+// ReconcileAdditionalTags attaches the additional tags to the instance group, its instance template and its
+// instances, and detaches the tags removed from the spec, when they changed. The instances which joined the instance
+// group since the last reconciliation are tagged as well, while instance templates are tagged on creation.
+func (m *MachinePoolScope) ReconcileAdditionalTags(ctx context.Context, instanceGroup *vpcv1.InstanceGroup) error {
+	log := ctrl.LoggerFrom(ctx)
+	tags := m.AdditionalTags()
+	attachedTags := m.IBMVPCMachinePool.Status.AttachedTags
+	if len(tags) == 0 && len(attachedTags) == 0 {
+		return nil
+	}
+	changed := !slices.Equal(tags, attachedTags)
+
+	var resourceCRNs []string
+	if changed {
+		resourceCRNs = append(resourceCRNs, ptr.Deref(instanceGroup.CRN, ""))
+		if template := m.IBMVPCMachinePool.Status.InstanceTemplate; template != nil && template.Name != nil {
+			instanceTemplate, err := m.IBMVPCClient.GetInstanceTemplateByName(*template.Name)
+			if err != nil {
+				return fmt.Errorf("failed to get instance template %s: %w", *template.Name, err)
+			}
+			if instanceTemplate != nil {
+				resourceCRNs = append(resourceCRNs, ptr.Deref(instanceTemplate.CRN, ""))
+			}
+		}
+	}
+
+	// The instances already listed in the providerIDList were tagged when they joined the instance group.
+	memberships, err := m.IBMVPCClient.ListInstanceGroupMemberships(*instanceGroup.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list instance group memberships: %w", err)
+	}
+	knownInstanceIDs := sets.New[string]()
+	for _, providerID := range m.IBMVPCMachinePool.Spec.ProviderIDList {
+		knownInstanceIDs.Insert(providerID[strings.LastIndex(providerID, "/")+1:])
+	}
+	for _, membership := range memberships {
+		if membership.Instance == nil || membership.Instance.CRN == nil {
+			continue
+		}
+		if changed || !knownInstanceIDs.Has(ptr.Deref(membership.Instance.ID, "")) {
+			resourceCRNs = append(resourceCRNs, *membership.Instance.CRN)
+		}
+	}
+	resourceCRNs = slices.DeleteFunc(resourceCRNs, func(crn string) bool { return crn == "" })
+
+	log.V(3).Info("Updating additional tags of machine pool resources", "tags", tags, "attachedTags", attachedTags, "resources", len(resourceCRNs))
+	if err := reconcileAttachedTags(m.GlobalTaggingClient, resourceCRNs, tags, attachedTags); err != nil {
+		return fmt.Errorf("failed to tag resources of machine pool %s: %w", m.Name(), err)
+	}
+	m.IBMVPCMachinePool.Status.AttachedTags = tags
+	return nil
+}
+
+// getInstanceGroup returns the instance group from the ID in status, falling back to a lookup by name.
+func (m *MachinePoolScope) getInstanceGroup() (*vpcv1.InstanceGroup, error) {
+	if m.IBMVPCMachinePool.Status.InstanceGroupID != "" {
+		instanceGroup, response, err := m.IBMVPCClient.GetInstanceGroup(&vpcv1.GetInstanceGroupOptions{
+			ID: ptr.To(m.IBMVPCMachinePool.Status.InstanceGroupID),
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to get instance group %s: %w", m.IBMVPCMachinePool.Status.InstanceGroupID, err)
+		}
+		return instanceGroup, nil
+	}
+
+	instanceGroup, err := m.IBMVPCClient.GetInstanceGroupByName(m.resourceName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance group %s: %w", m.resourceName(), err)
+	}
+	return instanceGroup, nil
+}
+
+// ReconcileMemberships updates the providerIDList and replicas from the instance group memberships and performs a
+// rolling replacement of the members created from an outdated instance template.
+// It returns true when all the members are healthy and up to date.
+func (m *MachinePoolScope) ReconcileMemberships(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	memberships, err := m.IBMVPCClient.ListInstanceGroupMemberships(m.IBMVPCMachinePool.Status.InstanceGroupID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list instance group memberships: %w", err)
+	}
+
+	providerIDList := make([]string, 0, len(memberships))
+	readyReplicas := int32(0)
+	allHealthy := true
+	var outdated *vpcv1.InstanceGroupMembership
+	for i, membership := range memberships {
+		if membership.Instance != nil && membership.Instance.ID != nil {
+			providerID, err := m.providerID(*membership.Instance.ID)
+			if err != nil {
+				return false, err
+			}
+			providerIDList = append(providerIDList, providerID)
+		}
+		if ptr.Deref(membership.Status, "") != instanceGroupMembershipHealthy {
+			allHealthy = false
+			continue
+		}
+		readyReplicas++
+		if outdated == nil && (membership.InstanceTemplate == nil || ptr.Deref(membership.InstanceTemplate.ID, "") != ptr.Deref(m.IBMVPCMachinePool.Status.InstanceTemplate.ID, "")) {
+			outdated = &memberships[i]
+		}
+	}
+	m.IBMVPCMachinePool.Spec.ProviderIDList = providerIDList
+	m.IBMVPCMachinePool.Status.Replicas = readyReplicas
+
+	if !allHealthy || int64(len(memberships)) != m.DesiredReplicas() {
+		return false, nil
+	}
+	if outdated == nil {
+		return true, nil
+	}
+
+	// Replace one outdated member at a time, the instance group recreates it from the current instance template.
+	log.Info("Replacing instance group member with outdated instance template", "membershipID", *outdated.ID)
+	if _, err := m.IBMVPCClient.DeleteInstanceGroupMembership(&vpcv1.DeleteInstanceGroupMembershipOptions{
+		InstanceGroupID: ptr.To(m.IBMVPCMachinePool.Status.InstanceGroupID),
+		ID:              outdated.ID,
+	}); err != nil {
+		record.Warnf(m.IBMVPCMachinePool, "FailedDeleteInstanceGroupMembership", "Failed instance group membership deletion - %v", err)
+		return false, fmt.Errorf("failed to delete instance group membership %s: %w", *outdated.ID, err)
+	}
+	record.Eventf(m.IBMVPCMachinePool, "SuccessfulDeleteInstanceGroupMembership", "Replacing outdated instance group membership %q", *outdated.Name)
+	return false, nil
+}
+
+// providerID returns the provider ID of the instance.
+func (m *MachinePoolScope) providerID(instanceID string) (string, error) {
+	if options.ProviderIDFormatType(options.ProviderIDFormat) != options.ProviderIDFormatV2 {
+		return "", fmt.Errorf("invalid value for ProviderIDFormat")
+	}
+	accountID, err := accounts.GetAccountIDFromAuthenticator(m.Authenticator)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cloud account id: %w", err)
+	}
+	return fmt.Sprintf("ibm://%s///%s/%s", accountID, m.MachinePool.Spec.ClusterName, instanceID), nil
+}
+
+// DeleteOutdatedInstanceTemplates deletes the instance templates of the machine pool other than the one in status.
+// The instance templates of the machine pool are named after it, are in the resource group of the cluster and are
+// tagged with the cluster.
+func (m *MachinePoolScope) DeleteOutdatedInstanceTemplates(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	currentID := ""
+	if m.IBMVPCMachinePool.Status.InstanceTemplate != nil {
+		currentID = ptr.Deref(m.IBMVPCMachinePool.Status.InstanceTemplate.ID, "")
+	}
+
+	templates, _, err := m.IBMVPCClient.ListInstanceTemplates(&vpcv1.ListInstanceTemplatesOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list instance templates: %w", err)
+	}
+	if templates == nil {
+		return nil
+	}
+
+	for _, t := range templates.Templates {
+		template, ok := t.(*vpcv1.InstanceTemplate)
+		if !ok || template.ID == nil || template.Name == nil {
+			continue
+		}
+		if *template.ID == currentID || !m.isOwnedInstanceTemplate(*template.Name) {
+			continue
+		}
+		if resourceGroupID := m.getResourceGroupID(); resourceGroupID != "" && template.ResourceGroup != nil && ptr.Deref(template.ResourceGroup.ID, "") != resourceGroupID {
+			continue
+		}
+		if template.CRN == nil {
+			continue
+		}
+		tags, err := m.GlobalTaggingClient.GetAttachedTags(*template.CRN)
+		if err != nil {
+			return fmt.Errorf("failed to get tags of instance template %s: %w", *template.Name, err)
+		}
+		if !slices.Contains(tags, m.IBMVPCCluster.Name) {
+			continue
+		}
+		log.Info("Deleting instance template", "name", *template.Name, "id", *template.ID)
+		if _, err := m.IBMVPCClient.DeleteInstanceTemplate(&vpcv1.DeleteInstanceTemplateOptions{
+			ID: template.ID,
+		}); err != nil {
+			record.Warnf(m.IBMVPCMachinePool, "FailedDeleteInstanceTemplate", "Failed instance template deletion - %v", err)
+			return fmt.Errorf("failed to delete instance template %s: %w", *template.Name, err)
+		}
+		record.Eventf(m.IBMVPCMachinePool, "SuccessfulDeleteInstanceTemplate", "Deleted instance template %q", *template.Name)
+	}
+	return nil
+}
+
+// isOwnedInstanceTemplate returns true if the instance template name was generated for the machine pool.
+func (m *MachinePoolScope) isOwnedInstanceTemplate(name string) bool {
+	prefix := m.resourceName() + "-"
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	suffix := strings.TrimPrefix(name, prefix)
+	if len(suffix) != instanceTemplateHashLength {
+		return false
+	}
+	_, err := hex.DecodeString(suffix)
+	return err == nil
+}
+
+// DeleteInstanceGroup scales the instance group down to zero members and deletes it.
+// It returns true once the instance group no longer exists.
+func (m *MachinePoolScope) DeleteInstanceGroup(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	instanceGroup, err := m.getInstanceGroup()
+	if err != nil {
+		return false, err
+	}
+	if instanceGroup == nil {
+		return true, nil
+	}
+	if ptr.Deref(instanceGroup.LifecycleState, "") == vpcv1.InstanceGroupLifecycleStateDeletingConst {
+		return false, nil
+	}
+
+	if ptr.Deref(instanceGroup.MembershipCount, 0) > 0 {
+		patchMap, err := (&vpcv1.InstanceGroupPatch{MembershipCount: ptr.To(int64(0))}).AsPatch()
+		if err != nil {
+			return false, fmt.Errorf("failed to build instance group patch: %w", err)
+		}
+		log.Info("Scaling down instance group before deletion", "name", m.resourceName())
+		if _, _, err := m.IBMVPCClient.UpdateInstanceGroup(&vpcv1.UpdateInstanceGroupOptions{
+			ID:                 instanceGroup.ID,
+			InstanceGroupPatch: patchMap,
+		}); err != nil {
+			return false, fmt.Errorf("failed to scale down instance group: %w", err)
+		}
+		return false, nil
+	}
+
+	memberships, err := m.IBMVPCClient.ListInstanceGroupMemberships(*instanceGroup.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list instance group memberships: %w", err)
+	}
+	if len(memberships) > 0 {
+		log.V(3).Info("Waiting for instance group members to be deleted", "name", m.resourceName(), "members", len(memberships))
+		return false, nil
+	}
+
+	if _, err := m.IBMVPCClient.DeleteInstanceGroup(&vpcv1.DeleteInstanceGroupOptions{
+		ID: instanceGroup.ID,
+	}); err != nil {
+		record.Warnf(m.IBMVPCMachinePool, "FailedDeleteInstanceGroup", "Failed instance group deletion - %v", err)
+		return false, fmt.Errorf("failed to delete instance group: %w", err)
+	}
+	record.Eventf(m.IBMVPCMachinePool, "SuccessfulDeleteInstanceGroup", "Deleted instance group %q", m.resourceName())
+	return false, nil
+}
@@ -0,0 +1,626 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	machinePoolName        = "foo-machine-pool"
+	testInstanceGroupID    = "foo-instance-group-id"
+	testInstanceTemplateID = "foo-instance-template-id"
+)
+
+func newMachinePool(clusterName, machinePoolName string) *clusterv1.MachinePool {
+	return &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      machinePoolName,
+			Namespace: defaultNamespace,
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			ClusterName: clusterName,
+			Replicas:    ptr.To[int32](2),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: core.StringPtr(machinePoolName),
+					},
+				},
+			},
+		},
+	}
+}
+
+func newVPCMachinePool(clusterName, machinePoolName string) *infrav1.IBMVPCMachinePool {
+	return &infrav1.IBMVPCMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: clusterName,
+			},
+			Name:      machinePoolName,
+			Namespace: defaultNamespace,
+		},
+		Spec: infrav1.IBMVPCMachinePoolSpec{
+			Image: &infrav1.IBMVPCResourceReference{
+				ID: core.StringPtr("foo-image-id"),
+			},
+			Zone:    "foo-zone",
+			Profile: testMachineProfile,
+			Subnets: []infrav1.VPCResource{
+				{
+					ID: core.StringPtr("foo-subnet-id"),
+				},
+			},
+		},
+	}
+}
+
+func setupMachinePoolScope(clusterName string, machinePoolName string, mockvpc *mock.MockVpc) *MachinePoolScope {
+	cluster := newCluster(clusterName)
+	machinePool := newMachinePool(clusterName, machinePoolName)
+	secret := newBootstrapSecret(clusterName, machinePoolName)
+	vpcMachinePool := newVPCMachinePool(clusterName, machinePoolName)
+	vpcCluster := newVPCCluster(clusterName)
+	vpcCluster.Status = infrav1.IBMVPCClusterStatus{
+		Network: &infrav1.VPCNetworkStatus{
+			VPC: &infrav1.ResourceStatus{
+				ID: "vpc-id",
+			},
+		},
+		ResourceGroup: &infrav1.ResourceStatus{
+			ID: "resource-group-id",
+		},
+	}
+
+	initObjects := []client.Object{
+		cluster, machinePool, secret, vpcCluster, vpcMachinePool,
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(initObjects...).Build()
+	return &MachinePoolScope{
+		Client:            client,
+		IBMVPCClient:      mockvpc,
+		Cluster:           cluster,
+		MachinePool:       machinePool,
+		IBMVPCCluster:     vpcCluster,
+		IBMVPCMachinePool: vpcMachinePool,
+	}
+}
+
+func TestNewMachinePoolScope(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params MachinePoolScopeParams
+	}{
+		{
+			name: "Error when MachinePool is nil",
+			params: MachinePoolScopeParams{
+				MachinePool: nil,
+			},
+		},
+		{
+			name: "Error when IBMVPCMachinePool is nil",
+			params: MachinePoolScopeParams{
+				MachinePool:       newMachinePool(clusterName, machinePoolName),
+				IBMVPCMachinePool: nil,
+			},
+		},
+		{
+			name: "Error when IBMVPCCluster is nil",
+			params: MachinePoolScopeParams{
+				MachinePool:       newMachinePool(clusterName, machinePoolName),
+				IBMVPCMachinePool: newVPCMachinePool(clusterName, machinePoolName),
+				IBMVPCCluster:     nil,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			_, err := NewMachinePoolScope(ctx, tc.params)
+			g.Expect(err).To(Not(BeNil()))
+		})
+	}
+}
+
+func TestReconcileInstanceTemplate(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Should create instance template", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		mockgt := gtmock.NewMockGlobalTagging(mockController)
+		scope.GlobalTaggingClient = mockgt
+		mockvpc.EXPECT().GetInstanceTemplateByName(gomock.Any()).Return(nil, nil)
+		mockvpc.EXPECT().CreateInstanceTemplate(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceTemplateOptions{})).DoAndReturn(
+			func(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+				prototype := options.InstanceTemplatePrototype.(*vpcv1.InstanceTemplatePrototypeInstanceTemplateByImage)
+				g.Expect(*prototype.UserData).To(Equal("user data"))
+				g.Expect(*prototype.VPC.(*vpcv1.VPCIdentityByID).ID).To(Equal("vpc-id"))
+				return &vpcv1.InstanceTemplate{ID: core.StringPtr(testInstanceTemplateID), CRN: core.StringPtr("instance-template-crn"), Name: prototype.Name}, &core.DetailedResponse{}, nil
+			})
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(
+			func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+				g.Expect(options.TagNames).To(Equal([]string{clusterName}))
+				g.Expect(*options.Resources[0].ResourceID).To(Equal("instance-template-crn"))
+				return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+			})
+		err := scope.ReconcileInstanceTemplate(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachinePool.Status.InstanceTemplate.ID).To(Equal(testInstanceTemplateID))
+		g.Expect(scope.isOwnedInstanceTemplate(*scope.IBMVPCMachinePool.Status.InstanceTemplate.Name)).To(BeTrue())
+	})
+
+	t.Run("Should reuse existing instance template", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		mockvpc.EXPECT().GetInstanceTemplateByName(gomock.Any()).Return(&vpcv1.InstanceTemplate{ID: core.StringPtr(testInstanceTemplateID), Name: core.StringPtr("foo")}, nil)
+		err := scope.ReconcileInstanceTemplate(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachinePool.Status.InstanceTemplate.ID).To(Equal(testInstanceTemplateID))
+	})
+
+	t.Run("Should not look up instance template when spec is unchanged", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		name, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID), Name: ptr.To(name)}
+		err = scope.ReconcileInstanceTemplate(ctx)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should keep the instance template when the bootstrap data is rotated", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		name, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID), Name: ptr.To(name)}
+		secret := &corev1.Secret{}
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: scope.MachinePool.Namespace, Name: *scope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName}, secret)).To(Succeed())
+		secret.Data["value"] = []byte("rotated user data")
+		g.Expect(scope.Client.Update(ctx, secret)).To(Succeed())
+		err = scope.ReconcileInstanceTemplate(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachinePool.Status.InstanceTemplate.Name).To(Equal(name))
+	})
+
+	t.Run("Should use a new instance template when the spec changes", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		name, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		scope.IBMVPCMachinePool.Spec.Profile = "bx2-4x16"
+		newName, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		g.Expect(newName).ToNot(Equal(name))
+	})
+
+	t.Run("Should use a new instance template when the bootstrap data secret changes", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		name, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		scope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = ptr.To(machinePoolName + "-new")
+		newName, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		g.Expect(newName).ToNot(Equal(name))
+	})
+
+	t.Run("Should use the instance template names of the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		otherScope := setupMachinePoolScope("other-cluster", machinePoolName, mockvpc)
+		name, err := scope.instanceTemplateName()
+		g.Expect(err).To(BeNil())
+		g.Expect(name).To(HavePrefix(clusterName + "-" + machinePoolName + "-"))
+		g.Expect(otherScope.isOwnedInstanceTemplate(name)).To(BeFalse())
+	})
+
+	t.Run("Should return error when CreateInstanceTemplate fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		mockvpc.EXPECT().GetInstanceTemplateByName(gomock.Any()).Return(nil, nil)
+		mockvpc.EXPECT().CreateInstanceTemplate(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceTemplateOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create instance template"))
+		err := scope.ReconcileInstanceTemplate(ctx)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should return error when bootstrap data is missing", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = nil
+		mockvpc.EXPECT().GetInstanceTemplateByName(gomock.Any()).Return(nil, nil)
+		err := scope.ReconcileInstanceTemplate(ctx)
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestReconcileInstanceGroup(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Should create instance group", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+		mockvpc.EXPECT().GetInstanceGroupByName(clusterName+"-"+machinePoolName).Return(nil, nil)
+		mockvpc.EXPECT().CreateInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceGroupOptions{})).DoAndReturn(
+			func(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+				g.Expect(*options.MembershipCount).To(Equal(int64(2)))
+				return &vpcv1.InstanceGroup{ID: core.StringPtr(testInstanceGroupID)}, &core.DetailedResponse{}, nil
+			})
+		_, err := scope.ReconcileInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachinePool.Status.InstanceGroupID).To(Equal(testInstanceGroupID))
+	})
+
+	t.Run("Should update instance group template and membership count", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+		scope.IBMVPCMachinePool.Status.InstanceGroupID = testInstanceGroupID
+		instanceGroup := &vpcv1.InstanceGroup{
+			ID:               core.StringPtr(testInstanceGroupID),
+			InstanceTemplate: &vpcv1.InstanceTemplateReference{ID: core.StringPtr("old-template-id")},
+			MembershipCount:  ptr.To(int64(1)),
+		}
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(instanceGroup, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().UpdateInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.UpdateInstanceGroupOptions{})).DoAndReturn(
+			func(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+				g.Expect(options.InstanceGroupPatch).To(HaveKeyWithValue("membership_count", ptr.To(int64(2))))
+				g.Expect(options.InstanceGroupPatch).To(HaveKey("instance_template"))
+				return instanceGroup, &core.DetailedResponse{}, nil
+			})
+		_, err := scope.ReconcileInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should not update instance group when up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+		mockvpc.EXPECT().GetInstanceGroupByName(clusterName+"-"+machinePoolName).Return(&vpcv1.InstanceGroup{
+			ID:               core.StringPtr(testInstanceGroupID),
+			InstanceTemplate: &vpcv1.InstanceTemplateReference{ID: core.StringPtr(testInstanceTemplateID)},
+			MembershipCount:  ptr.To(int64(2)),
+		}, nil)
+		_, err := scope.ReconcileInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachinePool.Status.InstanceGroupID).To(Equal(testInstanceGroupID))
+	})
+
+	t.Run("Should return error when instance template is not reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		_, err := scope.ReconcileInstanceGroup(ctx)
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestReconcileMemberships(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	membership := func(id, status, templateID string) vpcv1.InstanceGroupMembership {
+		return vpcv1.InstanceGroupMembership{
+			ID:               core.StringPtr(id),
+			Name:             core.StringPtr(id),
+			Instance:         &vpcv1.InstanceReference{ID: core.StringPtr(id + "-instance")},
+			InstanceTemplate: &vpcv1.InstanceTemplateReference{ID: core.StringPtr(templateID)},
+			Status:           core.StringPtr(status),
+		}
+	}
+
+	options.ProviderIDFormat = string("v2")
+	accounts.GetAccountIDFunc = func() (string, error) {
+		return "dummy-account-id", nil
+	}
+
+	t.Run("Should set providerIDList and replicas", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+		scope.IBMVPCMachinePool.Status.InstanceGroupID = testInstanceGroupID
+		mockvpc.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{
+			membership("member-1", "healthy", testInstanceTemplateID),
+			membership("member-2", "pending", testInstanceTemplateID),
+		}, nil)
+		upToDate, err := scope.ReconcileMemberships(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(upToDate).To(BeFalse())
+		g.Expect(scope.IBMVPCMachinePool.Status.Replicas).To(Equal(int32(1)))
+		g.Expect(scope.IBMVPCMachinePool.Spec.ProviderIDList).To(ConsistOf(
+			"ibm://dummy-account-id///"+clusterName+"/member-1-instance",
+			"ibm://dummy-account-id///"+clusterName+"/member-2-instance",
+		))
+	})
+
+	t.Run("Should return true when all members are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+		scope.IBMVPCMachinePool.Status.InstanceGroupID = testInstanceGroupID
+		mockvpc.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{
+			membership("member-1", "healthy", testInstanceTemplateID),
+			membership("member-2", "healthy", testInstanceTemplateID),
+		}, nil)
+		upToDate, err := scope.ReconcileMemberships(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(upToDate).To(BeTrue())
+		g.Expect(scope.IBMVPCMachinePool.Status.Replicas).To(Equal(int32(2)))
+	})
+
+	t.Run("Should replace an outdated member", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+		scope.IBMVPCMachinePool.Status.InstanceGroupID = testInstanceGroupID
+		mockvpc.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{
+			membership("member-1", "healthy", testInstanceTemplateID),
+			membership("member-2", "healthy", "old-template-id"),
+		}, nil)
+		mockvpc.EXPECT().DeleteInstanceGroupMembership(&vpcv1.DeleteInstanceGroupMembershipOptions{
+			InstanceGroupID: ptr.To(testInstanceGroupID),
+			ID:              core.StringPtr("member-2"),
+		}).Return(&core.DetailedResponse{}, nil)
+		upToDate, err := scope.ReconcileMemberships(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(upToDate).To(BeFalse())
+	})
+}
+
+func TestDeleteOutdatedInstanceTemplates(t *testing.T) {
+	g := NewWithT(t)
+	mockController := gomock.NewController(t)
+	t.Cleanup(mockController.Finish)
+	mockvpc := mock.NewMockVpc(mockController)
+	mockgt := gtmock.NewMockGlobalTagging(mockController)
+	scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+	scope.GlobalTaggingClient = mockgt
+	scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID)}
+	templateName := func(suffix string) *string {
+		return core.StringPtr(clusterName + "-" + machinePoolName + "-" + suffix)
+	}
+	mockvpc.EXPECT().ListInstanceTemplates(gomock.AssignableToTypeOf(&vpcv1.ListInstanceTemplatesOptions{})).Return(&vpcv1.InstanceTemplateCollection{
+		Templates: []vpcv1.InstanceTemplateIntf{
+			&vpcv1.InstanceTemplate{ID: core.StringPtr(testInstanceTemplateID), CRN: core.StringPtr("current-template-crn"), Name: templateName("0123abcd")},
+			&vpcv1.InstanceTemplate{ID: core.StringPtr("old-template-id"), CRN: core.StringPtr("old-template-crn"), Name: templateName("abcd0123")},
+			&vpcv1.InstanceTemplate{ID: core.StringPtr("other-cluster-template-id"), CRN: core.StringPtr("other-cluster-template-crn"), Name: templateName("ab01cd23")},
+			&vpcv1.InstanceTemplate{ID: core.StringPtr("other-resource-group-template-id"), CRN: core.StringPtr("other-resource-group-template-crn"), Name: templateName("01ab23cd"),
+				ResourceGroup: &vpcv1.ResourceGroupReference{ID: core.StringPtr("other-resource-group-id")}},
+			&vpcv1.InstanceTemplate{ID: core.StringPtr("other-pool-template-id"), CRN: core.StringPtr("other-pool-template-crn"), Name: core.StringPtr(machinePoolName + "-abcd0123")},
+			&vpcv1.InstanceTemplate{ID: core.StringPtr("other-template-id"), Name: core.StringPtr("other-template")},
+		},
+	}, &core.DetailedResponse{}, nil)
+	mockgt.EXPECT().GetAttachedTags("old-template-crn").Return([]string{clusterName}, nil)
+	mockgt.EXPECT().GetAttachedTags("other-cluster-template-crn").Return([]string{"other-cluster"}, nil)
+	mockvpc.EXPECT().DeleteInstanceTemplate(&vpcv1.DeleteInstanceTemplateOptions{ID: core.StringPtr("old-template-id")}).Return(&core.DetailedResponse{}, nil)
+	err := scope.DeleteOutdatedInstanceTemplates(ctx)
+	g.Expect(err).To(BeNil())
+}
+
+func TestDeleteInstanceGroup(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Should return true when instance group does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		scope.IBMVPCMachinePool.Status.InstanceGroupID = testInstanceGroupID
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		deleted, err := scope.DeleteInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(deleted).To(BeTrue())
+	})
+
+	t.Run("Should scale down instance group before deletion", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroupByName(clusterName+"-"+machinePoolName).Return(&vpcv1.InstanceGroup{
+			ID:              core.StringPtr(testInstanceGroupID),
+			MembershipCount: ptr.To(int64(2)),
+		}, nil)
+		mockvpc.EXPECT().UpdateInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.UpdateInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{}, &core.DetailedResponse{}, nil)
+		deleted, err := scope.DeleteInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(deleted).To(BeFalse())
+	})
+
+	t.Run("Should wait for instance group members to be deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroupByName(clusterName+"-"+machinePoolName).Return(&vpcv1.InstanceGroup{
+			ID:              core.StringPtr(testInstanceGroupID),
+			MembershipCount: ptr.To(int64(0)),
+		}, nil)
+		mockvpc.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{{ID: core.StringPtr("member-1")}}, nil)
+		deleted, err := scope.DeleteInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(deleted).To(BeFalse())
+	})
+
+	t.Run("Should delete instance group", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroupByName(clusterName+"-"+machinePoolName).Return(&vpcv1.InstanceGroup{
+			ID:              core.StringPtr(testInstanceGroupID),
+			MembershipCount: ptr.To(int64(0)),
+		}, nil)
+		mockvpc.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return(nil, nil)
+		mockvpc.EXPECT().DeleteInstanceGroup(&vpcv1.DeleteInstanceGroupOptions{ID: core.StringPtr(testInstanceGroupID)}).Return(&core.DetailedResponse{}, nil)
+		_, err := scope.DeleteInstanceGroup(ctx)
+		g.Expect(err).To(BeNil())
+	})
+}
+
+func TestMachinePoolReconcileAdditionalTags(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, mock.NewMockVpc(mockController), gtmock.NewMockGlobalTagging(mockController)
+	}
+
+	instanceGroup := &vpcv1.InstanceGroup{
+		ID:  core.StringPtr(testInstanceGroupID),
+		CRN: core.StringPtr("instance-group-crn"),
+	}
+	membership := func(id string) vpcv1.InstanceGroupMembership {
+		return vpcv1.InstanceGroupMembership{
+			ID:       core.StringPtr(id),
+			Instance: &vpcv1.InstanceReference{ID: core.StringPtr(id + "-instance"), CRN: core.StringPtr(id + "-instance-crn")},
+		}
+	}
+	resourceIDs := func(resources []globaltaggingv1.Resource) []string {
+		ids := make([]string, 0, len(resources))
+		for _, resource := range resources {
+			ids = append(ids, *resource.ResourceID)
+		}
+		return ids
+	}
+
+	t.Run("Should not tag resources without additional tags", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instanceGroup)).To(Succeed())
+	})
+
+	t.Run("Should attach the tags to the instance group, its instance template and its instances", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCCluster.Spec.AdditionalTags = []string{"env:test"}
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.VPCResource{ID: core.StringPtr(testInstanceTemplateID), Name: core.StringPtr("template")}
+		mockVPC.EXPECT().GetInstanceTemplateByName("template").Return(&vpcv1.InstanceTemplate{CRN: core.StringPtr("template-crn")}, nil)
+		mockVPC.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{membership("member-1")}, nil)
+		mockGT.EXPECT().AttachTag(gomock.Cond(func(options *globaltaggingv1.AttachTagOptions) bool {
+			return g.Expect(options.TagNames).To(Equal([]string{"env:test"})) &&
+				g.Expect(resourceIDs(options.Resources)).To(Equal([]string{"instance-group-crn", "template-crn", "member-1-instance-crn"}))
+		})).Return(&globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil)
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instanceGroup)).To(Succeed())
+		g.Expect(scope.IBMVPCMachinePool.Status.AttachedTags).To(Equal([]string{"env:test"}))
+	})
+
+	t.Run("Should only tag the instances which joined the instance group when the tags are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCCluster.Spec.AdditionalTags = []string{"env:test"}
+		scope.IBMVPCMachinePool.Status.AttachedTags = []string{"env:test"}
+		scope.IBMVPCMachinePool.Spec.ProviderIDList = []string{"ibm://dummy-account-id///" + clusterName + "/member-1-instance"}
+		mockVPC.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{membership("member-1"), membership("member-2")}, nil)
+		mockGT.EXPECT().AttachTag(gomock.Cond(func(options *globaltaggingv1.AttachTagOptions) bool {
+			return g.Expect(resourceIDs(options.Resources)).To(Equal([]string{"member-2-instance-crn"}))
+		})).Return(&globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil)
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instanceGroup)).To(Succeed())
+	})
+
+	t.Run("Should detach the tags removed from the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockVPC, mockGT := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachinePoolScope(clusterName, machinePoolName, mockVPC)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCMachinePool.Status.AttachedTags = []string{"env:test"}
+		mockVPC.EXPECT().ListInstanceGroupMemberships(testInstanceGroupID).Return([]vpcv1.InstanceGroupMembership{membership("member-1")}, nil)
+		mockGT.EXPECT().DetachTag(gomock.Cond(func(options *globaltaggingv1.DetachTagOptions) bool {
+			return g.Expect(options.TagNames).To(Equal([]string{"env:test"})) &&
+				g.Expect(resourceIDs(options.Resources)).To(Equal([]string{"instance-group-crn", "member-1-instance-crn"}))
+		})).Return(&globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil)
+		g.Expect(scope.ReconcileAdditionalTags(ctx, instanceGroup)).To(Succeed())
+		g.Expect(scope.IBMVPCMachinePool.Status.AttachedTags).To(BeEmpty())
+	})
+}
@@ -17,10 +17,23 @@ limitations under the License.
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 )
 
 // CRN is a local duplicate of IBM Cloud CRN for parsing and references.
@@ -90,3 +103,136 @@ func normalizedVPCSecurityGroupRulePrototype(prototype *infrav1.VPCSecurityGroup
 	normalized.Protocol = infrav1.VPCSecurityGroupRuleProtocolIcmpTCPUDP
 	return normalized
 }
+
+// GetReservation returns the capacity reservation identified by the ID or the name of the target.
+func GetReservation(vpcClient vpc.Vpc, target *infrav1.VPCReservationTarget) (*vpcv1.Reservation, error) {
+	if target.ID != nil {
+		reservation, _, err := vpcClient.GetReservation(&vpcv1.GetReservationOptions{
+			ID: target.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving reservation %s: %w", *target.ID, err)
+		}
+		return reservation, nil
+	}
+	if target.Name != nil {
+		reservation, err := vpcClient.GetReservationByName(*target.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving reservation %s: %w", *target.Name, err)
+		} else if reservation == nil {
+			return nil, fmt.Errorf("error no reservation found with name %s", *target.Name)
+		}
+		return reservation, nil
+	}
+	return nil, fmt.Errorf("error reservation id or name must be specified")
+}
+
+// ReservationStatus returns the observed state of a capacity reservation, which is exhausted when it is not active or
+// has no available capacity left.
+func ReservationStatus(reservation *vpcv1.Reservation) *infrav1.VPCReservationStatus {
+	status := &infrav1.VPCReservationStatus{
+		ID:     ptr.Deref(reservation.ID, ""),
+		Status: ptr.Deref(reservation.Status, ""),
+	}
+	if reservation.Capacity != nil {
+		status.Total = ptr.Deref(reservation.Capacity.Total, 0)
+		status.Available = ptr.Deref(reservation.Capacity.Available, 0)
+	}
+	status.Exhausted = status.Status != vpcv1.ReservationStatusActiveConst || status.Available <= 0
+	return status
+}
+
+// GetSnapshot returns the block storage snapshot identified by the ID, the name or the CRN of the reference, which
+// must be bootable.
+func GetSnapshot(vpcClient vpc.Vpc, ref *infrav1.VPCSnapshotReference) (*vpcv1.Snapshot, error) {
+	var snapshotID *string
+	if ref.ID != nil {
+		snapshotID = ref.ID
+	} else if ref.CRN != nil {
+		// Parse the supplied Snapshot CRN for Id, to perform snapshot lookup.
+		snapshotCRN, err := parseCRN(*ref.CRN)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing snapshot crn: %w", err)
+		} else if snapshotCRN == nil || snapshotCRN.Resource == "" {
+			return nil, fmt.Errorf("error parsing snapshot crn, missing resource id")
+		}
+		snapshotID = ptr.To(snapshotCRN.Resource)
+	}
+
+	var snapshot *vpcv1.Snapshot
+	var err error
+	if snapshotID != nil {
+		snapshot, _, err = vpcClient.GetSnapshot(&vpcv1.GetSnapshotOptions{
+			ID: snapshotID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving snapshot %s: %w", *snapshotID, err)
+		}
+	} else if ref.Name != nil {
+		snapshot, err = vpcClient.GetSnapshotByName(*ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving snapshot %s: %w", *ref.Name, err)
+		} else if snapshot == nil {
+			return nil, fmt.Errorf("error no snapshot found with name %s", *ref.Name)
+		}
+	} else {
+		return nil, fmt.Errorf("error snapshot id, name or crn must be specified")
+	}
+	if !ptr.Deref(snapshot.Bootable, false) {
+		return nil, fmt.Errorf("error snapshot %s is not bootable", ptr.Deref(snapshot.ID, ""))
+	}
+	return snapshot, nil
+}
+
+// getAuthenticator returns the authenticator for the IBM Cloud clients of the cluster. The credentials of the
+// IBMCloudClusterIdentity referenced by the cluster are used if set, otherwise the default env/file credentials.
+func getAuthenticator(ctx context.Context, c client.Client, cluster *infrav1.IBMVPCCluster) (core.Authenticator, error) {
+	if cluster == nil || cluster.Spec.IdentityRef == nil {
+		return authenticator.GetAuthenticator()
+	}
+	return authenticator.GetAuthenticatorFromIdentity(ctx, c, cluster.Spec.IdentityRef.Name, cluster.Namespace)
+}
+
+// mergeTags returns the sorted union of the lists of tags.
+func mergeTags(tagLists ...[]string) []string {
+	tags := sets.New[string]()
+	for _, list := range tagLists {
+		tags.Insert(list...)
+	}
+	return sets.List(tags)
+}
+
+// reconcileAttachedTags attaches the user tags to the resources, and detaches the previously attached tags which were
+// removed from them.
+func reconcileAttachedTags(client globaltagging.GlobalTagging, resourceCRNs, tags, attachedTags []string) error {
+	if len(resourceCRNs) == 0 {
+		return nil
+	}
+	resources := make([]globaltaggingv1.Resource, 0, len(resourceCRNs))
+	for _, crn := range resourceCRNs {
+		resources = append(resources, globaltaggingv1.Resource{
+			ResourceID: ptr.To(crn),
+		})
+	}
+
+	if len(tags) > 0 {
+		tagOptions := &globaltaggingv1.AttachTagOptions{}
+		tagOptions.SetResources(resources)
+		tagOptions.SetTagNames(tags)
+		tagOptions.SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+		if _, _, err := client.AttachTag(tagOptions); err != nil {
+			return fmt.Errorf("failure tagging resources: %w", err)
+		}
+	}
+
+	if removed := sets.List(sets.New(attachedTags...).Difference(sets.New(tags...))); len(removed) > 0 {
+		detachOptions := &globaltaggingv1.DetachTagOptions{}
+		detachOptions.SetResources(resources)
+		detachOptions.SetTagNames(removed)
+		detachOptions.SetTagType(globaltaggingv1.DetachTagOptionsTagTypeUserConst)
+		if _, _, err := client.DetachTag(detachOptions); err != nil {
+			return fmt.Errorf("failure detaching tags from resources: %w", err)
+		}
+	}
+	return nil
+}
@@ -51,3 +51,14 @@ func TestNormalizedVPCSecurityGroupRulePrototype(t *testing.T) {
 		g.Expect(prototype.Protocol).To(Equal(infrav1.VPCSecurityGroupRuleProtocolAll))
 	})
 }
+
+func TestMergeTags(t *testing.T) {
+	t.Run("When there are no tags", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(mergeTags(nil, nil)).To(BeEmpty())
+	})
+	t.Run("When tags are duplicated across lists, the sorted union is returned", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(mergeTags([]string{"cluster-name", "team:infra"}, []string{"env:test", "team:infra"})).To(Equal([]string{"cluster-name", "env:test", "team:infra"}))
+	})
+}
@@ -62,6 +62,15 @@ func GetAccountIDWrapper() (string, error) {
 	return GetAccountIDFunc() // Call the function that GetAccountIDFunc points to
 }
 
+// GetAccountIDFromAuthenticator parses and returns the cloud account ID of the authenticator, e.g. of the
+// IBMCloudClusterIdentity of a cluster. The account of the default credentials is returned when it is nil.
+func GetAccountIDFromAuthenticator(auth core.Authenticator) (string, error) {
+	if auth == nil {
+		return GetAccountIDWrapper()
+	}
+	return GetAccount(auth)
+}
+
 // GetAccountID will parse and returns user cloud account ID.
 func GetAccountID() (string, error) {
 	auth, err := authenticator.GetAuthenticator()
@@ -17,15 +17,41 @@ limitations under the License.
 package authenticator
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	identityv1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/identity/v1beta2"
 )
 
 const (
 	serviceIBMCloud = "IBMCLOUD"
 )
 
+var (
+	defaultAuthenticatorMu sync.Mutex
+	defaultAuthenticator   core.Authenticator
+
+	identityAuthenticatorsMu sync.Mutex
+	// identityAuthenticators are the authenticators built from the credentials of the IBMCloudClusterIdentities, keyed
+	// by identity name.
+	identityAuthenticators = map[string]identityAuthenticator{}
+)
+
+// identityAuthenticator is an authenticator built from the credentials in a secret, at a given resource version.
+type identityAuthenticator struct {
+	secret          client.ObjectKey
+	resourceVersion string
+	auth            core.Authenticator
+}
+
 // This expects the credential file in the following search order:
 // 1) ${IBM_CREDENTIALS_FILE}
 // 2) <user-home-dir>/ibm-credentials.env
@@ -36,9 +62,53 @@ const (
 // IBMCLOUD_AUTH_TYPE=iam
 // IBMCLOUD_APIKEY=xxxxxxxxxxxxx
 // IBMCLOUD_AUTH_URL=https://iam.cloud.ibm.com
+//
+// To authenticate as a trusted profile with a compute resource token, i.e. a projected service account token,
+// instead of an API key the format is:
+// $ cat ibm-credentials.env
+// IBMCLOUD_AUTH_TYPE=container
+// IBMCLOUD_IAM_PROFILE_ID=Profile-xxxxxxxx
+// IBMCLOUD_CR_TOKEN_FILENAME=/var/run/secrets/tokens/sa-token
+// IBMCLOUD_AUTH_URL=https://iam.cloud.ibm.com
+//
+// IBMCLOUD_IAM_PROFILE_NAME may be used instead of IBMCLOUD_IAM_PROFILE_ID. The token file is read again
+// whenever a new IAM access token is requested, so the rotation of the projected token is transparent.
 
 // GetAuthenticator will get the authenticator for ibmcloud.
+// The authenticator is created once and shared by all the service clients, so that the IAM access token
+// is requested and refreshed in a single place instead of once per client.
 func GetAuthenticator() (core.Authenticator, error) {
+	defaultAuthenticatorMu.Lock()
+	defer defaultAuthenticatorMu.Unlock()
+
+	if defaultAuthenticator != nil {
+		return defaultAuthenticator, nil
+	}
+
+	auth, err := newAuthenticatorFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	defaultAuthenticator = auth
+	return auth, nil
+}
+
+// ReloadAuthenticator rebuilds the authenticator for ibmcloud from the environment and atomically swaps it with the
+// one returned by GetAuthenticator, so that the service clients created afterwards use the new credentials.
+// The current authenticator is kept if the new one can't be built.
+func ReloadAuthenticator() error {
+	auth, err := newAuthenticatorFromEnvironment()
+	if err != nil {
+		return err
+	}
+
+	defaultAuthenticatorMu.Lock()
+	defer defaultAuthenticatorMu.Unlock()
+	defaultAuthenticator = auth
+	return nil
+}
+
+func newAuthenticatorFromEnvironment() (core.Authenticator, error) {
 	auth, err := core.GetAuthenticatorFromEnvironment(serviceIBMCloud)
 	if err != nil {
 		return nil, err
@@ -49,6 +119,17 @@ func GetAuthenticator() (core.Authenticator, error) {
 	return auth, nil
 }
 
+// Verify checks that the authenticator is able to authenticate a request, which requires obtaining an IAM access
+// token for the token based authenticators. The token is cached by the authenticator, so it is cheap to call
+// Verify before using the authenticator.
+func Verify(auth core.Authenticator) error {
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://cloud.ibm.com", http.NoBody)
+	if err != nil {
+		return err
+	}
+	return auth.Authenticate(req)
+}
+
 // GetProperties returns a map containing configuration properties for the specified service that are retrieved from external configuration sources.
 func GetProperties() (map[string]string, error) {
 	properties, err := core.GetServiceProperties(serviceIBMCloud)
@@ -76,3 +157,69 @@ func GetIAMAuthenticator() (*core.IamAuthenticator, error) {
 
 	return auth, nil
 }
+
+// GetAuthenticatorFromIdentity will get the authenticator for ibmcloud from the credentials referenced by the
+// IBMCloudClusterIdentity with the given name, if clusters in the namespace are allowed to use it.
+// The authenticator is cached per identity until its secret changes, so that the IAM access token is shared by the
+// reconciliations of all the objects using the identity instead of being requested for each of them.
+func GetAuthenticatorFromIdentity(ctx context.Context, c client.Client, name, namespace string) (core.Authenticator, error) {
+	identity := &identityv1.IBMCloudClusterIdentity{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, identity); err != nil {
+		return nil, fmt.Errorf("failed to get IBMCloudClusterIdentity %s: %w", name, err)
+	}
+	if !identity.IsNamespaceAllowed(namespace) {
+		return nil, fmt.Errorf("namespace %s is not allowed to use IBMCloudClusterIdentity %s", namespace, name)
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: identity.Spec.SecretRef.Namespace, Name: identity.Spec.SecretRef.Name}
+	if err := c.Get(ctx, secretKey, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s for IBMCloudClusterIdentity %s: %w", secretKey, name, err)
+	}
+
+	identityAuthenticatorsMu.Lock()
+	defer identityAuthenticatorsMu.Unlock()
+	if cached, ok := identityAuthenticators[name]; ok && cached.secret == secretKey && cached.resourceVersion == secret.ResourceVersion {
+		return cached.auth, nil
+	}
+	auth, err := GetAuthenticatorFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	identityAuthenticators[name] = identityAuthenticator{secret: secretKey, resourceVersion: secret.ResourceVersion, auth: auth}
+	return auth, nil
+}
+
+// GetAuthenticatorFromSecret will get the authenticator for ibmcloud from the credentials in the secret.
+// An IAM assume authenticator is returned when the secret references a trusted profile, an IAM authenticator otherwise.
+func GetAuthenticatorFromSecret(secret *corev1.Secret) (core.Authenticator, error) {
+	apiKey := string(secret.Data[identityv1.IdentityAPIKeySecretKey])
+	if apiKey == "" {
+		return nil, fmt.Errorf("secret %s/%s does not contain the %s key", secret.Namespace, secret.Name, identityv1.IdentityAPIKeySecretKey)
+	}
+	authURL := string(secret.Data[identityv1.IdentityAuthURLSecretKey])
+
+	profileID := string(secret.Data[identityv1.IdentityTrustedProfileIDSecretKey])
+	profileName := string(secret.Data[identityv1.IdentityTrustedProfileNameSecretKey])
+	profileCRN := string(secret.Data[identityv1.IdentityTrustedProfileCRNSecretKey])
+	if profileID == "" && profileName == "" && profileCRN == "" {
+		auth, err := core.NewIamAuthenticatorBuilder().SetApiKey(apiKey).SetURL(authURL).Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create IAM authenticator from secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		return auth, nil
+	}
+
+	auth, err := core.NewIamAssumeAuthenticatorBuilder().
+		SetApiKey(apiKey).
+		SetIAMProfileID(profileID).
+		SetIAMProfileName(profileName).
+		SetIAMProfileCRN(profileCRN).
+		SetIAMAccountID(string(secret.Data[identityv1.IdentityAccountIDSecretKey])).
+		SetURL(authURL).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IAM assume authenticator from secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+	return auth, nil
+}
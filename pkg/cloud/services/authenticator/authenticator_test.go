@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	identityv1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/identity/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetAuthenticator(t *testing.T) {
+	t.Run("Should return a container authenticator for a trusted profile", func(t *testing.T) {
+		g := NewWithT(t)
+		defaultAuthenticator = nil
+		t.Cleanup(func() { defaultAuthenticator = nil })
+		t.Setenv("IBMCLOUD_AUTH_TYPE", core.AUTHTYPE_CONTAINER)
+		t.Setenv("IBMCLOUD_IAM_PROFILE_ID", "profile-id")
+		t.Setenv("IBMCLOUD_CR_TOKEN_FILENAME", "/var/run/secrets/tokens/sa-token")
+
+		auth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(auth.AuthenticationType()).To(Equal(core.AUTHTYPE_CONTAINER))
+
+		sharedAuth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(sharedAuth).To(BeIdenticalTo(auth))
+	})
+	t.Run("Should fail if the trusted profile is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		defaultAuthenticator = nil
+		t.Cleanup(func() { defaultAuthenticator = nil })
+		t.Setenv("IBMCLOUD_AUTH_TYPE", core.AUTHTYPE_CONTAINER)
+
+		_, err := GetAuthenticator()
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestGetAuthenticatorFromIdentity(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = identityv1.AddToScheme(scheme)
+
+	newIdentity := func(allowedNamespaces ...string) *identityv1.IBMCloudClusterIdentity {
+		return &identityv1.IBMCloudClusterIdentity{
+			ObjectMeta: metav1.ObjectMeta{Name: "identity"},
+			Spec: identityv1.IBMCloudClusterIdentitySpec{
+				SecretRef: identityv1.IBMCloudClusterIdentitySecretReference{
+					Name:      "credentials",
+					Namespace: "capi-ibmcloud-system",
+				},
+				AllowedNamespaces: allowedNamespaces,
+			},
+		}
+	}
+	newSecret := func(data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "capi-ibmcloud-system"},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return secret
+	}
+
+	testCases := []struct {
+		name         string
+		objects      []client.Object
+		namespace    string
+		expectErr    bool
+		expectedType string
+	}{
+		{
+			name:      "Should fail if the identity does not exist",
+			namespace: "default",
+			expectErr: true,
+		},
+		{
+			name:      "Should fail if the namespace is not allowed",
+			objects:   []client.Object{newIdentity("team-a"), newSecret(map[string]string{"apiKey": "key"})},
+			namespace: "team-b",
+			expectErr: true,
+		},
+		{
+			name:      "Should fail if the secret does not exist",
+			objects:   []client.Object{newIdentity()},
+			namespace: "default",
+			expectErr: true,
+		},
+		{
+			name:      "Should fail if the secret does not contain the api key",
+			objects:   []client.Object{newIdentity(), newSecret(map[string]string{})},
+			namespace: "default",
+			expectErr: true,
+		},
+		{
+			name:      "Should fail if the trusted profile name is set without the account ID",
+			objects:   []client.Object{newIdentity(), newSecret(map[string]string{"apiKey": "key", "trustedProfileName": "profile"})},
+			namespace: "default",
+			expectErr: true,
+		},
+		{
+			name:         "Should return an IAM authenticator",
+			objects:      []client.Object{newIdentity("default"), newSecret(map[string]string{"apiKey": "key"})},
+			namespace:    "default",
+			expectedType: core.AUTHTYPE_IAM,
+		},
+		{
+			name:         "Should return an IAM assume authenticator for a trusted profile",
+			objects:      []client.Object{newIdentity(), newSecret(map[string]string{"apiKey": "key", "trustedProfileID": "profile-id"})},
+			namespace:    "default",
+			expectedType: core.AUTHTYPE_IAM_ASSUME,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			identityAuthenticators = map[string]identityAuthenticator{}
+			t.Cleanup(func() { identityAuthenticators = map[string]identityAuthenticator{} })
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+			auth, err := GetAuthenticatorFromIdentity(context.Background(), c, "identity", tc.namespace)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(auth.AuthenticationType()).To(Equal(tc.expectedType))
+		})
+	}
+
+	t.Run("Should share the authenticator until the secret changes", func(t *testing.T) {
+		g := NewWithT(t)
+		identityAuthenticators = map[string]identityAuthenticator{}
+		t.Cleanup(func() { identityAuthenticators = map[string]identityAuthenticator{} })
+		secret := newSecret(map[string]string{"apiKey": "key"})
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newIdentity(), secret).Build()
+
+		auth, err := GetAuthenticatorFromIdentity(context.Background(), c, "identity", "default")
+		g.Expect(err).ToNot(HaveOccurred())
+		sharedAuth, err := GetAuthenticatorFromIdentity(context.Background(), c, "identity", "default")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(sharedAuth).To(BeIdenticalTo(auth))
+
+		g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+		secret.Data["apiKey"] = []byte("rotated-key")
+		g.Expect(c.Update(context.Background(), secret)).To(Succeed())
+		rotatedAuth, err := GetAuthenticatorFromIdentity(context.Background(), c, "identity", "default")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rotatedAuth).ToNot(BeIdenticalTo(auth))
+		g.Expect(rotatedAuth.(*core.IamAuthenticator).ApiKey).To(Equal("rotated-key"))
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// credentialsFileEnvName is the environment variable holding the path of the credentials file.
+const credentialsFileEnvName = "IBM_CREDENTIALS_FILE"
+
+// CredentialsWatcher watches the credentials file referenced by the IBM_CREDENTIALS_FILE environment variable and
+// reloads the authenticator for ibmcloud when its content changes, e.g. when the Secret it is mounted from is rotated.
+// It implements the controller-runtime manager.Runnable interface.
+type CredentialsWatcher struct {
+	// Interval is the interval at which the credentials file is checked for changes.
+	Interval time.Duration
+
+	path     string
+	checksum []byte
+}
+
+// NeedLeaderElection returns false, as every replica has to reload its own credentials.
+func (w *CredentialsWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start checks the credentials file for changes until the context is cancelled.
+func (w *CredentialsWatcher) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("credentials-watcher")
+
+	w.path = os.Getenv(credentialsFileEnvName)
+	if w.path == "" {
+		log.Info("Credentials file is not set, credentials will not be reloaded", "env", credentialsFileEnvName)
+		return nil
+	}
+
+	var err error
+	if w.checksum, err = fileChecksum(w.path); err != nil {
+		log.Error(err, "Failed to read credentials file", "path", w.path)
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+// check reloads the authenticator if the content of the credentials file changed since the last check.
+func (w *CredentialsWatcher) check(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("credentials-watcher")
+
+	checksum, err := fileChecksum(w.path)
+	if err != nil {
+		log.Error(err, "Failed to read credentials file", "path", w.path)
+		return
+	}
+	if bytes.Equal(checksum, w.checksum) {
+		return
+	}
+
+	log.Info("Credentials file changed, reloading authenticator", "path", w.path)
+	if err := ReloadAuthenticator(); err != nil {
+		// Keep the previous checksum so that the reload is retried on the next check.
+		log.Error(err, "Failed to reload authenticator, keeping the current credentials")
+		return
+	}
+	w.checksum = checksum
+	log.Info("Reloaded authenticator")
+}
+
+func fileChecksum(path string) ([]byte, error) {
+	content, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(content)
+	return checksum[:], nil
+}
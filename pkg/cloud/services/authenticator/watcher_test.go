@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCredentialsWatcher(t *testing.T) {
+	setup := func(t *testing.T) (string, *CredentialsWatcher) {
+		t.Helper()
+		defaultAuthenticator = nil
+		t.Cleanup(func() { defaultAuthenticator = nil })
+
+		path := filepath.Join(t.TempDir(), "ibm-credentials.env")
+		writeCredentials(t, path, "IBMCLOUD_AUTH_TYPE=iam\nIBMCLOUD_APIKEY=old-key\n")
+		t.Setenv(credentialsFileEnvName, path)
+
+		checksum, err := fileChecksum(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return path, &CredentialsWatcher{path: path, checksum: checksum}
+	}
+
+	t.Run("Should reload the authenticator when the credentials file changes", func(t *testing.T) {
+		g := NewWithT(t)
+		path, watcher := setup(t)
+
+		auth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(auth.(*core.IamAuthenticator).ApiKey).To(Equal("old-key"))
+
+		writeCredentials(t, path, "IBMCLOUD_AUTH_TYPE=iam\nIBMCLOUD_APIKEY=new-key\n")
+		watcher.check(context.Background())
+
+		auth, err = GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(auth.(*core.IamAuthenticator).ApiKey).To(Equal("new-key"))
+	})
+	t.Run("Should keep the current authenticator when the new credentials are invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		path, watcher := setup(t)
+
+		auth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		writeCredentials(t, path, "IBMCLOUD_AUTH_TYPE=iam\n")
+		watcher.check(context.Background())
+
+		currentAuth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(currentAuth).To(BeIdenticalTo(auth))
+	})
+	t.Run("Should not reload the authenticator when the credentials file is unchanged", func(t *testing.T) {
+		g := NewWithT(t)
+		_, watcher := setup(t)
+
+		auth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		watcher.check(context.Background())
+
+		currentAuth, err := GetAuthenticator()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(currentAuth).To(BeIdenticalTo(auth))
+	})
+}
+
+func writeCredentials(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
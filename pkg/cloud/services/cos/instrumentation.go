@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the Cos API calls spans and metrics.
+const instrumentationServiceName = "cos"
+
+// instrumentedService rate limits and retries the calls to the wrapped Cos and records their spans and metrics.
+type instrumentedService struct {
+	Cos
+
+	// phases holds the span of the reconciliation phase issuing the API calls, the parent of their spans.
+	phases *tracing.Phases
+}
+
+// newInstrumentedService returns a Cos rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span of the reconciliation phase issuing them.
+func newInstrumentedService(ctx context.Context, service Cos) Cos {
+	return &instrumentedService{Cos: service, phases: tracing.PhasesFrom(ctx)}
+}
+
+// WithContext returns the Cos recording the spans of the API calls as children of the phases of the flow in ctx.
+func (s *instrumentedService) WithContext(ctx context.Context) Cos {
+	return newInstrumentedService(ctx, s.Cos)
+}
+
+// GetBucketByName calls GetBucketByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetBucketByName(name string) (result *s3.HeadBucketOutput, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetBucketByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetBucketByName")
+		result, err = s.Cos.GetBucketByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetBucketByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateBucket calls CreateBucket with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateBucket(input *s3.CreateBucketInput) (result *s3.CreateBucketOutput, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateBucket", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateBucket")
+		result, err = s.Cos.CreateBucket(input)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateBucket", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateBucketWithContext calls CreateBucketWithContext with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateBucketWithContext(ctx aws.Context, input *s3.CreateBucketInput, opts ...request.Option) (result *s3.CreateBucketOutput, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "CreateBucketWithContext", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateBucketWithContext")
+		result, err = s.Cos.CreateBucketWithContext(ctx, input, opts...)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateBucketWithContext", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// PutObject calls PutObject with rate limiting and retries and records the span and metrics of each attempt.
+// The body of the object is rewound before each attempt, as it is consumed by the previous one.
+func (s *instrumentedService) PutObject(input *s3.PutObjectInput) (result *s3.PutObjectOutput, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	var offset int64
+	if input.Body != nil {
+		if offset, err = input.Body.Seek(0, io.SeekCurrent); err != nil {
+			return nil, fmt.Errorf("failed to get the offset of the object body: %w", err)
+		}
+	}
+	err = retry.Do(ctx, instrumentationServiceName, "PutObject", func() (*core.DetailedResponse, error) {
+		if input.Body != nil {
+			if _, err := input.Body.Seek(offset, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("failed to rewind the object body: %w", err)
+			}
+		}
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "PutObject")
+		result, err = s.Cos.PutObject(input)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "PutObject", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// ListObjects calls ListObjects with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListObjects(input *s3.ListObjectsInput) (result *s3.ListObjectsOutput, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListObjects", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListObjects")
+		result, err = s.Cos.ListObjects(input)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListObjects", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteObject calls DeleteObject with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteObject(input *s3.DeleteObjectInput) (result *s3.DeleteObjectOutput, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteObject", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteObject")
+		result, err = s.Cos.DeleteObject(input)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteObject", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// PutPublicAccessBlock calls PutPublicAccessBlock with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) PutPublicAccessBlock(input *s3.PutPublicAccessBlockInput) (result *s3.PutPublicAccessBlockOutput, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "PutPublicAccessBlock", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "PutPublicAccessBlock")
+		result, err = s.Cos.PutPublicAccessBlock(input)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "PutPublicAccessBlock", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
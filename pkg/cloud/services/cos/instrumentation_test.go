@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cos
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"go.uber.org/mock/gomock"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+// serverError is a COS error with the status code of a server error.
+type serverError struct{}
+
+func (serverError) Error() string   { return "internal server error" }
+func (serverError) StatusCode() int { return http.StatusInternalServerError }
+
+func TestInstrumentedServicePutObject(t *testing.T) {
+	t.Run("Should upload the whole body of the object on retry", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController := gomock.NewController(t)
+		mockCos := mock.NewMockCos(mockController)
+		service := newInstrumentedService(context.Background(), mockCos)
+
+		data := []byte("ignition data")
+		var uploaded [][]byte
+		mockCos.EXPECT().PutObject(gomock.Any()).DoAndReturn(func(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+			body, err := io.ReadAll(input.Body)
+			g.Expect(err).To(BeNil())
+			uploaded = append(uploaded, body)
+			if len(uploaded) == 1 {
+				return nil, serverError{}
+			}
+			return &s3.PutObjectOutput{}, nil
+		}).Times(2)
+
+		_, err := service.PutObject(&s3.PutObjectInput{Body: bytes.NewReader(data)})
+		g.Expect(err).To(BeNil())
+		g.Expect(uploaded).To(Equal([][]byte{data, data}))
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package cos
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -25,9 +26,11 @@ import (
 
 	"golang.org/x/net/http/httpproxy"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
@@ -37,8 +40,18 @@ import (
 const (
 	iamEndpoint  = "https://iam.cloud.ibm.com/identity/token"
 	cosURLDomain = "cloud-object-storage.appdomain.cloud"
+
+	// authenticatorTokenLifetime is how long a token obtained from a core.Authenticator is used before asking the
+	// authenticator again. The authenticator caches the token and refreshes it ahead of its actual expiry.
+	authenticatorTokenLifetime = 5 * time.Minute
 )
 
+// tokenAuthenticator is implemented by the go-sdk-core authenticators that obtain IAM access tokens,
+// such as the IAM, IAM assume and container authenticators.
+type tokenAuthenticator interface {
+	GetToken() (string, error)
+}
+
 // Service holds the IBM Cloud Resource Controller Service specific information.
 type Service struct {
 	client *s3.S3
@@ -108,23 +121,9 @@ func (s *Service) PutPublicAccessBlock(input *s3.PutPublicAccessBlockInput) (*s3
 	return s.client.PutPublicAccessBlock(input)
 }
 
-// NewServiceFunc is a variable that will hold the function reference.
-var NewServiceFunc = NewService // Default to the original function
-
-// NewServiceWrapper returns a new service for the IBM Cloud COS api client, useful in unit testing.
-func NewServiceWrapper(options ServiceOptions, apikey, serviceInstance string) (Cos, error) {
-	return NewServiceFunc(options, apikey, serviceInstance)
-}
-
-// NewServiceWithHMAC returns a COS client that authenticates via AWS SigV4 HMAC credentials
-// (access_key_id / secret_access_key) rather than an IBM IAM API key. The resulting client
-// supports pre-signed URL generation via PresignedURL().
-func NewServiceWithHMAC(options ServiceOptions, accessKeyID, secretAccessKey string) (Cos, error) {
-	if options.Options == nil {
-		options.Options = &cosSession.Options{}
-	}
-	options.Config.S3ForcePathStyle = aws.Bool(true)
-	options.Config.HTTPClient = &http.Client{
+// newHTTPClient returns the HTTP client used by the COS sessions, honouring the proxy environment variables.
+func newHTTPClient() *http.Client {
+	return &http.Client{
 		Transport: &http.Transport{
 			Proxy: func(req *http.Request) (*url.URL, error) {
 				return httpproxy.FromEnvironment().ProxyFunc()(req.URL)
@@ -141,6 +140,27 @@ func NewServiceWithHMAC(options ServiceOptions, accessKeyID, secretAccessKey str
 			ExpectContinueTimeout: 1 * time.Second,
 		},
 	}
+}
+
+// NewServiceFunc is a variable that will hold the function reference.
+var NewServiceFunc = NewService // Default to the original function
+
+// NewServiceWrapper returns a new service for the IBM Cloud COS api client, useful in unit testing.
+func NewServiceWrapper(ctx context.Context, options ServiceOptions, apikey, serviceInstance string) (Cos, error) {
+	return NewServiceFunc(ctx, options, apikey, serviceInstance)
+}
+
+// NewServiceWithHMAC returns a COS client that authenticates via AWS SigV4 HMAC credentials
+// (access_key_id / secret_access_key) rather than an IBM IAM API key. The resulting client
+// supports pre-signed URL generation via PresignedURL().
+func NewServiceWithHMAC(ctx context.Context, options ServiceOptions, accessKeyID, secretAccessKey string) (Cos, error) {
+	if options.Options == nil {
+		options.Options = &cosSession.Options{}
+	}
+	options.Config.S3ForcePathStyle = aws.Bool(true)
+	options.Config.HTTPClient = newHTTPClient()
+	// The calls are only retried by the instrumented service.
+	options.Config.MaxRetries = aws.Int(0)
 	// Use plain AWS static credentials — this selects the SigV4 signer (ProviderType ""),
 	// not the IBM IAM OAuth signer, enabling req.Presign() to embed the signature as query
 	// parameters rather than an Authorization header.
@@ -150,41 +170,64 @@ func NewServiceWithHMAC(options ServiceOptions, accessKeyID, secretAccessKey str
 	if err != nil {
 		return nil, err
 	}
-	return &Service{
+	return newInstrumentedService(ctx, &Service{
 		client: s3.New(sess),
-	}, nil
+	}), nil
 }
 
 // NewService returns a new service for the IBM Cloud Resource Controller api client.
-func NewService(options ServiceOptions, apikey, serviceInstance string) (Cos, error) {
+func NewService(ctx context.Context, options ServiceOptions, apikey, serviceInstance string) (Cos, error) {
 	if options.Options == nil {
 		options.Options = &cosSession.Options{}
 	}
 	options.Config.S3ForcePathStyle = aws.Bool(true)
-	options.Config.HTTPClient = &http.Client{
-		Transport: &http.Transport{
-			Proxy: func(req *http.Request) (*url.URL, error) {
-				return httpproxy.FromEnvironment().ProxyFunc()(req.URL)
-			},
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			ForceAttemptHTTP2:     true,
-			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-		},
-	}
+	options.Config.HTTPClient = newHTTPClient()
+	// The calls are only retried by the instrumented service.
+	options.Config.MaxRetries = aws.Int(0)
 	options.Config.Credentials = ibmiam.NewStaticCredentials(aws.NewConfig(), iamEndpoint, apikey, serviceInstance)
 
 	sess, err := cosSession.NewSessionWithOptions(*options.Options)
 	if err != nil {
 		return nil, err
 	}
-	return &Service{
+	return newInstrumentedService(ctx, &Service{
+		client: s3.New(sess),
+	}), nil
+}
+
+// NewServiceWithAuthenticator returns a new service for the IBM Cloud COS api client that obtains its IAM access
+// tokens from the authenticator, e.g. a trusted profile authenticator using a compute resource token, instead of
+// exchanging an API key.
+func NewServiceWithAuthenticator(ctx context.Context, options ServiceOptions, auth core.Authenticator, serviceInstance string) (Cos, error) {
+	tokenAuth, ok := auth.(tokenAuthenticator)
+	if !ok {
+		return nil, fmt.Errorf("authenticator of type %s does not provide IAM access tokens", auth.AuthenticationType())
+	}
+	if options.Options == nil {
+		options.Options = &cosSession.Options{}
+	}
+	options.Config.S3ForcePathStyle = aws.Bool(true)
+	options.Config.HTTPClient = newHTTPClient()
+	// The calls are only retried by the instrumented service.
+	options.Config.MaxRetries = aws.Int(0)
+	options.Config.Credentials = ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), func() (*token.Token, error) {
+		accessToken, err := tokenAuth.GetToken()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get IAM access token: %w", err)
+		}
+		return &token.Token{
+			AccessToken: accessToken,
+			TokenType:   "Bearer",
+			ExpiresIn:   int64(authenticatorTokenLifetime.Seconds()),
+			Expiration:  time.Now().Add(authenticatorTokenLifetime).Unix(),
+		}, nil
+	}, iamEndpoint, serviceInstance)
+
+	sess, err := cosSession.NewSessionWithOptions(*options.Options)
+	if err != nil {
+		return nil, err
+	}
+	return newInstrumentedService(ctx, &Service{
 		client: s3.New(sess),
-	}, nil
+	}), nil
 }
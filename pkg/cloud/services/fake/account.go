@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// AccountID is the ID of the IBM Cloud account of the backend.
+const AccountID = "fake-account"
+
+// NewAuthenticator returns an authenticator setting a bearer token of the account of the backend, whose
+// account ID can be read from the token claims as from an IAM token.
+func NewAuthenticator() (core.Authenticator, error) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"account": map[string]any{"bss": AccountID},
+	}).SignedString([]byte(AccountID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign fake token: %w", err)
+	}
+	return core.NewBearerTokenAuthenticator(token)
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultProvisioningDelay is the default time the resources take to get ready after being created, and to be
+// removed after being deleted.
+const DefaultProvisioningDelay = 2 * time.Second
+
+// Backend is a stateful in-memory IBM Cloud backend, shared by the fake clients of all the services.
+// The resources are created in a pending state and become ready once the provisioning delay elapsed,
+// the deleted resources are kept in a deleting state for the same delay before being removed.
+type Backend struct {
+	mu sync.Mutex
+
+	now               func() time.Time
+	provisioningDelay time.Duration
+	region            string
+
+	nextID int
+	tables map[string]*table
+}
+
+// Option configures a Backend.
+type Option func(*Backend)
+
+// WithProvisioningDelay sets the time the resources take to get ready after being created and to be removed
+// after being deleted.
+func WithProvisioningDelay(delay time.Duration) Option {
+	return func(b *Backend) {
+		b.provisioningDelay = delay
+	}
+}
+
+// WithClock sets the function returning the current time of the backend, to control the state transitions in tests.
+func WithClock(now func() time.Time) Option {
+	return func(b *Backend) {
+		b.now = now
+	}
+}
+
+// WithRegion sets the region of the backend, whose zones are returned by the VPC client.
+func WithRegion(region string) Option {
+	return func(b *Backend) {
+		b.region = region
+	}
+}
+
+// NewBackend returns an in-memory IBM Cloud backend holding the default resource group, named Default.
+func NewBackend(opts ...Option) *Backend {
+	b := &Backend{
+		now:               time.Now,
+		provisioningDelay: DefaultProvisioningDelay,
+		region:            "us-south",
+		tables:            map[string]*table{},
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	b.AddResourceGroup("Default")
+	return b
+}
+
+// object is the JSON representation of a resource stored in the backend. The fields prefixed with an underscore
+// are internal to the backend and are ignored when the object is converted to the model of a client.
+type object map[string]any
+
+// str returns the string at the path of nested fields of the object, or "" if unset.
+func (o object) str(path ...string) string {
+	var v any = map[string]any(o)
+	for _, key := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			if m, ok = v.(object); !ok {
+				return ""
+			}
+		}
+		v = m[key]
+	}
+	s, _ := v.(string)
+	return s
+}
+
+// record is a resource stored in the backend.
+type record struct {
+	obj object
+
+	// readyAt is the time at which onReady is called to complete a pending state transition, zero if none is pending.
+	readyAt time.Time
+	onReady func(object)
+
+	// removeAt is the time at which the resource being deleted is removed, zero if the resource is not deleted.
+	removeAt time.Time
+}
+
+// table holds the resources of a kind in their creation order.
+type table struct {
+	records map[string]*record
+	ids     []string
+}
+
+// lock locks the backend and applies the state transitions due since the last access.
+func (b *Backend) lock() {
+	b.mu.Lock()
+	now := b.now()
+	for _, t := range b.tables {
+		ids := t.ids[:0]
+		for _, id := range t.ids {
+			r := t.records[id]
+			if !r.readyAt.IsZero() && !now.Before(r.readyAt) {
+				r.readyAt = time.Time{}
+				if r.onReady != nil {
+					r.onReady(r.obj)
+				}
+			}
+			if !r.removeAt.IsZero() && !now.Before(r.removeAt) {
+				delete(t.records, id)
+				continue
+			}
+			ids = append(ids, id)
+		}
+		t.ids = ids
+	}
+}
+
+func (b *Backend) unlock() {
+	b.mu.Unlock()
+}
+
+// newID returns a new unique resource ID with the given prefix.
+func (b *Backend) newID(prefix string) string {
+	b.nextID++
+	return fmt.Sprintf("%s-%08d", prefix, b.nextID)
+}
+
+func (b *Backend) table(kind string) *table {
+	t, ok := b.tables[kind]
+	if !ok {
+		t = &table{records: map[string]*record{}}
+		b.tables[kind] = t
+	}
+	return t
+}
+
+// insert stores a resource, onReady is called to mark it ready once the provisioning delay elapsed.
+func (b *Backend) insert(kind, id string, obj object, onReady func(object)) {
+	t := b.table(kind)
+	t.records[id] = &record{obj: obj}
+	t.ids = append(t.ids, id)
+	if onReady != nil {
+		b.transition(kind, id, onReady)
+	}
+}
+
+// transition schedules onReady to be called once the provisioning delay elapsed, to complete a state transition
+// of a resource, e.g. an update.
+func (b *Backend) transition(kind, id string, onReady func(object)) {
+	if r, ok := b.table(kind).records[id]; ok && r.removeAt.IsZero() {
+		r.readyAt = b.now().Add(b.provisioningDelay)
+		r.onReady = onReady
+	}
+}
+
+// get returns a stored resource.
+func (b *Backend) get(kind, id string) (object, bool) {
+	r, ok := b.table(kind).records[id]
+	if !ok {
+		return nil, false
+	}
+	return r.obj, true
+}
+
+// find returns the stored resources of a kind matching the filter, if set, in their creation order.
+func (b *Backend) find(kind string, filter func(object) bool) []object {
+	t := b.table(kind)
+	objs := make([]object, 0, len(t.ids))
+	for _, id := range t.ids {
+		if obj := t.records[id].obj; filter == nil || filter(obj) {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// findOne returns the first stored resource of a kind matching the filter.
+func (b *Backend) findOne(kind string, filter func(object) bool) (object, bool) {
+	objs := b.find(kind, filter)
+	if len(objs) == 0 {
+		return nil, false
+	}
+	return objs[0], true
+}
+
+// remove starts the deletion of a resource, onDeleting is called to mark it as being deleted.
+// It returns false if the resource does not exist.
+func (b *Backend) remove(kind, id string, onDeleting func(object)) bool {
+	r, ok := b.table(kind).records[id]
+	if !ok {
+		return false
+	}
+	if !r.removeAt.IsZero() {
+		return true
+	}
+	r.readyAt = time.Time{}
+	r.removeAt = b.now().Add(b.provisioningDelay)
+	if onDeleting != nil {
+		onDeleting(r.obj)
+	}
+	return true
+}
+
+// removeNow removes a resource immediately, for the resources deleted synchronously by IBM Cloud.
+// It returns false if the resource does not exist.
+func (b *Backend) removeNow(kind, id string) bool {
+	t := b.table(kind)
+	if _, ok := t.records[id]; !ok {
+		return false
+	}
+	delete(t.records, id)
+	for i, tid := range t.ids {
+		if tid == id {
+			t.ids = append(t.ids[:i:i], t.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// withName returns a filter matching the resources with the given name, or all the resources if name is empty.
+func withName(name string) func(object) bool {
+	return func(o object) bool {
+		return name == "" || o.str("name") == name
+	}
+}
+
+// NotFoundError is returned by the fake clients when a resource does not exist.
+type NotFoundError struct {
+	Kind string
+	ID   string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %q not found", e.Kind, e.ID)
+}
+
+// Code returns the HTTP status code of the error, as the errors of the PowerVS client.
+func (e *NotFoundError) Code() int {
+	return http.StatusNotFound
+}
+
+// notFound returns the response and the error of a call on a resource which does not exist.
+func notFound(kind, id string) (*core.DetailedResponse, error) {
+	return &core.DetailedResponse{StatusCode: http.StatusNotFound}, &NotFoundError{Kind: kind, ID: id}
+}
+
+// response returns the response of a successful call.
+func response(code int) *core.DetailedResponse {
+	return &core.DetailedResponse{StatusCode: code}
+}
+
+// toObject returns the JSON representation of a request body or of a model, e.g. to read the fields of the
+// prototype interfaces of the SDKs whatever their concrete type.
+func toObject(src any) object {
+	o := object{}
+	if data, err := json.Marshal(src); err == nil {
+		_ = json.Unmarshal(data, &o)
+	}
+	return o
+}
+
+// toModel converts an object to a model of the IBM Cloud SDKs using its unmarshaller, which resolves the
+// concrete types of the interface fields. result must be a pointer to a model pointer or to a model interface.
+func toModel(o object, unmarshal func(map[string]json.RawMessage, any) error, result any) error {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	return core.UnmarshalModel(m, "", result, unmarshal)
+}
+
+// toJSONModel converts an object to a model which does not need an unmarshaller, e.g. of the PowerVS client.
+func toJSONModel(o object, result any) error {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
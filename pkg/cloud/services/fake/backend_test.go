@@ -0,0 +1,570 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+
+	. "github.com/onsi/gomega"
+)
+
+// testClock is a clock advanced by the tests to complete the state transitions of the backend.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance() {
+	c.now = c.now.Add(DefaultProvisioningDelay)
+}
+
+func setupBackend() (*Backend, *testClock) {
+	clock := &testClock{now: time.Now()}
+	return NewBackend(WithClock(clock.Now)), clock
+}
+
+func createVPCWithSubnet(g *WithT, b *Backend) (*vpcv1.VPC, *vpcv1.Subnet) {
+	client := b.VPCClient()
+	vpc, _, err := client.CreateVPC(&vpcv1.CreateVPCOptions{Name: ptr.To("vpc")})
+	g.Expect(err).ToNot(HaveOccurred())
+	subnet, _, err := client.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototype{
+			Name: ptr.To("subnet"),
+			VPC:  &vpcv1.VPCIdentityByID{ID: vpc.ID},
+			Zone: &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	return vpc, subnet
+}
+
+func TestVPCClient(t *testing.T) {
+	t.Run("Should provision a VPC after the provisioning delay", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+
+		vpc, _, err := client.CreateVPC(&vpcv1.CreateVPCOptions{Name: ptr.To("vpc")})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*vpc.Status).To(Equal("pending"))
+		g.Expect(*vpc.ResourceGroup.Name).To(Equal("Default"))
+
+		clock.Advance()
+		vpc, _, err = client.GetVPC(&vpcv1.GetVPCOptions{ID: vpc.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*vpc.Status).To(Equal("available"))
+		g.Expect(vpc.DefaultSecurityGroup).ToNot(BeNil())
+	})
+	t.Run("Should keep a deleted VPC until the provisioning delay elapsed", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+
+		vpc, _, err := client.CreateVPC(&vpcv1.CreateVPCOptions{Name: ptr.To("vpc")})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.DeleteVPC(&vpcv1.DeleteVPCOptions{ID: vpc.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		vpc, _, err = client.GetVPC(&vpcv1.GetVPCOptions{ID: vpc.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*vpc.Status).To(Equal("deleting"))
+
+		clock.Advance()
+		_, resp, err := client.GetVPC(&vpcv1.GetVPCOptions{ID: vpc.ID})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		vpcByName, err := client.GetVPCByName("vpc")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(vpcByName).To(BeNil())
+	})
+	t.Run("Should not delete a subnet used by an instance", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+
+		instance, _, err := client.CreateInstance(&vpcv1.CreateInstanceOptions{
+			InstancePrototype: &vpcv1.InstancePrototypeInstanceByImage{
+				Name:                    ptr.To("instance"),
+				Image:                   &vpcv1.ImageIdentityByID{ID: ptr.To(b.AddVPCImage("image"))},
+				Profile:                 &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")},
+				Zone:                    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+				PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.PrimaryNetworkInterface.PrimaryIP.Address).To(Equal("10.240.0.4"))
+		g.Expect(*instance.Vcpu.Count).To(BeEquivalentTo(4))
+
+		resp, err := client.DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: subnet.ID})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+	})
+	t.Run("Should boot an instance from a bootable snapshot no larger than its boot volume", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+		snapshotID := b.AddVPCSnapshot("snapshot", 100, true)
+		options := func(capacity int64) *vpcv1.CreateInstanceOptions {
+			return &vpcv1.CreateInstanceOptions{
+				InstancePrototype: &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
+					Name: ptr.To("instance"),
+					BootVolumeAttachment: &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
+						Volume: &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{
+							Capacity:       ptr.To(capacity),
+							Profile:        &vpcv1.VolumeProfileIdentityByName{Name: ptr.To("general-purpose")},
+							SourceSnapshot: &vpcv1.SnapshotIdentityByID{ID: ptr.To(snapshotID)},
+						},
+					},
+					Profile:                 &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")},
+					Zone:                    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+					PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+				},
+			}
+		}
+		_, resp, err := client.CreateInstance(options(50))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+		instance, _, err := client.CreateInstance(options(150))
+		g.Expect(err).ToNot(HaveOccurred())
+		volume, _, err := client.GetVolume(&vpcv1.GetVolumeOptions{ID: instance.BootVolumeAttachment.Volume.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*volume.Capacity).To(BeEquivalentTo(150))
+		g.Expect(*volume.SourceSnapshot.ID).To(Equal(snapshotID))
+	})
+	t.Run("Should only create a confidential instance with a confidential computing profile", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+		image := b.AddVPCImage("image")
+		options := func(profile string) *vpcv1.CreateInstanceOptions {
+			return &vpcv1.CreateInstanceOptions{
+				InstancePrototype: &vpcv1.InstancePrototypeInstanceByImage{
+					Name:                    ptr.To("instance"),
+					Image:                   &vpcv1.ImageIdentityByID{ID: ptr.To(image)},
+					Profile:                 &vpcv1.InstanceProfileIdentityByName{Name: ptr.To(profile)},
+					Zone:                    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+					PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+					EnableSecureBoot:        ptr.To(true),
+					ConfidentialComputeMode: ptr.To(vpcv1.InstanceConfidentialComputeModeTdxConst),
+				},
+			}
+		}
+		_, resp, err := client.CreateInstance(options("bx2-2x8"))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+		instance, _, err := client.CreateInstance(options("bx3dc-2x10"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.EnableSecureBoot).To(BeTrue())
+		g.Expect(*instance.ConfidentialComputeMode).To(Equal(vpcv1.InstanceConfidentialComputeModeTdxConst))
+	})
+	t.Run("Should keep the address of a retained virtual network interface attached to a new instance", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+		image := b.AddVPCImage("image")
+		prototype := func(vni vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceIntf) *vpcv1.CreateInstanceOptions {
+			return &vpcv1.CreateInstanceOptions{
+				InstancePrototype: &vpcv1.InstancePrototypeInstanceByImage{
+					Name:                     ptr.To("instance"),
+					Image:                    &vpcv1.ImageIdentityByID{ID: ptr.To(image)},
+					Profile:                  &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")},
+					Zone:                     &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+					PrimaryNetworkAttachment: &vpcv1.InstanceNetworkAttachmentPrototype{VirtualNetworkInterface: vni},
+				},
+			}
+		}
+
+		instance, _, err := client.CreateInstance(prototype(&vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext{
+			Name:       ptr.To("vni"),
+			AutoDelete: ptr.To(false),
+			Subnet:     &vpcv1.SubnetIdentityByID{ID: subnet.ID},
+		}))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.PrimaryNetworkInterface.PrimaryIP.Address).To(Equal("10.240.0.4"))
+		_, err = client.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: instance.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		vnis, err := client.ListVirtualNetworkInterfacesByNamePrefix("vni")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(vnis).To(HaveLen(1))
+		vni := vnis[0]
+		g.Expect(vni.Target).To(BeNil())
+		instance, _, err = client.CreateInstance(prototype(&vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID{
+			ID: vni.ID,
+		}))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID).To(Equal(*vni.ID))
+		g.Expect(*instance.PrimaryNetworkInterface.PrimaryIP.Address).To(Equal("10.240.0.4"))
+
+		_, resp, err := client.CreateInstance(prototype(&vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID{
+			ID: vni.ID,
+		}))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+	})
+	t.Run("Should bind a reserved IP to an instance and delete it with the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+
+		reservedIP, _, err := client.CreateSubnetReservedIP(&vpcv1.CreateSubnetReservedIPOptions{
+			SubnetID:   subnet.ID,
+			Address:    ptr.To("10.240.0.10"),
+			AutoDelete: ptr.To(false),
+			Name:       ptr.To("instance"),
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		instance, _, err := client.CreateInstance(&vpcv1.CreateInstanceOptions{
+			InstancePrototype: &vpcv1.InstancePrototypeInstanceByImage{
+				Name:    ptr.To("instance"),
+				Image:   &vpcv1.ImageIdentityByID{ID: ptr.To(b.AddVPCImage("image"))},
+				Profile: &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")},
+				Zone:    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+				PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{
+					Subnet:    &vpcv1.SubnetIdentityByID{ID: subnet.ID},
+					PrimaryIP: &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID{ID: reservedIP.ID},
+				},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.PrimaryNetworkInterface.PrimaryIP.Address).To(Equal("10.240.0.10"))
+
+		resp, err := client.DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{SubnetID: subnet.ID, ID: reservedIP.ID})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+		_, _, err = client.UpdateSubnetReservedIP(&vpcv1.UpdateSubnetReservedIPOptions{
+			SubnetID:        subnet.ID,
+			ID:              reservedIP.ID,
+			ReservedIPPatch: map[string]interface{}{"auto_delete": true},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: instance.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		clock.Advance()
+		reservedIP, err = client.GetSubnetReservedIPByName(*subnet.ID, "instance")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(reservedIP).To(BeNil())
+	})
+	t.Run("Should stop a preempted spot instance", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+
+		instance, _, err := client.CreateInstance(&vpcv1.CreateInstanceOptions{
+			InstancePrototype: &vpcv1.InstancePrototypeInstanceByImage{
+				Name:                    ptr.To("instance"),
+				Image:                   &vpcv1.ImageIdentityByID{ID: ptr.To(b.AddVPCImage("image"))},
+				Profile:                 &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")},
+				Zone:                    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+				PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+				Availability:            &vpcv1.InstanceAvailabilityPrototype{Class: ptr.To("spot")},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.Availability.Class).To(Equal("spot"))
+		g.Expect(*instance.AvailabilityPolicy.Preemption).To(Equal("stop"))
+		g.Expect(b.PreemptVPCInstance(*instance.ID)).To(BeFalse())
+
+		clock.Advance()
+		g.Expect(b.PreemptVPCInstance(*instance.ID)).To(BeTrue())
+		instance, _, err = client.GetInstance(&vpcv1.GetInstanceOptions{ID: instance.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.Status).To(Equal(vpcv1.InstanceStatusStoppedConst))
+		g.Expect(*instance.StatusReasons[0].Code).To(Equal(vpcv1.InstanceStatusReasonCodeStoppedByPreemptionConst))
+	})
+	t.Run("Should only resize a stopped instance", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+
+		instance, _, err := client.CreateInstance(&vpcv1.CreateInstanceOptions{
+			InstancePrototype: &vpcv1.InstancePrototypeInstanceByImage{
+				Name:                    ptr.To("instance"),
+				Image:                   &vpcv1.ImageIdentityByID{ID: ptr.To(b.AddVPCImage("image"))},
+				Profile:                 &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-2x8")},
+				Zone:                    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+				PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		clock.Advance()
+		patch, err := (&vpcv1.InstancePatch{Profile: &vpcv1.InstancePatchProfileInstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")}}).AsPatch()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, resp, err := client.UpdateInstance(&vpcv1.UpdateInstanceOptions{ID: instance.ID, InstancePatch: patch})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+		_, _, err = client.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{InstanceID: instance.ID, Type: ptr.To(vpcv1.CreateInstanceActionOptionsTypeStopConst)})
+		g.Expect(err).ToNot(HaveOccurred())
+		clock.Advance()
+		instance, _, err = client.UpdateInstance(&vpcv1.UpdateInstanceOptions{ID: instance.ID, InstancePatch: patch})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.Profile.Name).To(Equal("bx2-4x16"))
+		g.Expect(*instance.Vcpu.Count).To(Equal(int64(4)))
+		g.Expect(*instance.Memory).To(Equal(int64(16)))
+
+		_, _, err = client.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{InstanceID: instance.ID, Type: ptr.To(vpcv1.CreateInstanceActionOptionsTypeStartConst)})
+		g.Expect(err).ToNot(HaveOccurred())
+		clock.Advance()
+		instance, _, err = client.GetInstance(&vpcv1.GetInstanceOptions{ID: instance.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.Status).To(Equal(vpcv1.InstanceStatusRunningConst))
+	})
+	t.Run("Should consume and release the capacity of a manual reservation", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+		reservationID := b.AddReservation("reservation", "bx2-4x16", "us-south-1", 1, vpcv1.ReservationAffinityPolicyRestrictedConst)
+
+		prototype := func(name string) *vpcv1.InstancePrototypeInstanceByImage {
+			return &vpcv1.InstancePrototypeInstanceByImage{
+				Name:                    ptr.To(name),
+				Image:                   &vpcv1.ImageIdentityByID{ID: ptr.To(b.AddVPCImage(name))},
+				Profile:                 &vpcv1.InstanceProfileIdentityByName{Name: ptr.To("bx2-4x16")},
+				Zone:                    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+				PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+				ReservationAffinity: &vpcv1.InstanceReservationAffinityPrototype{
+					Policy: ptr.To(vpcv1.InstanceReservationAffinityPrototypePolicyManualConst),
+					Pool:   []vpcv1.ReservationIdentityIntf{&vpcv1.ReservationIdentityByID{ID: ptr.To(reservationID)}},
+				},
+			}
+		}
+		instance, _, err := client.CreateInstance(&vpcv1.CreateInstanceOptions{InstancePrototype: prototype("instance-1")})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.Reservation.ID).To(Equal(reservationID))
+		reservation, err := client.GetReservationByName("reservation")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*reservation.Capacity.Available).To(BeZero())
+
+		_, resp, err := client.CreateInstance(&vpcv1.CreateInstanceOptions{InstancePrototype: prototype("instance-2")})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+		_, err = client.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: instance.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		clock.Advance()
+		reservation, _, err = client.GetReservation(&vpcv1.GetReservationOptions{ID: ptr.To(reservationID)})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*reservation.Capacity.Available).To(Equal(int64(1)))
+	})
+	t.Run("Should mount a stable file share through a mount target in its zone", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+		share, _, err := client.CreateShare(&vpcv1.CreateShareOptions{
+			SharePrototype: &vpcv1.SharePrototypeShareBySize{
+				Name:    ptr.To("share"),
+				Size:    ptr.To[int64](10),
+				Profile: &vpcv1.ShareProfileIdentityByName{Name: ptr.To("dp2")},
+				Zone:    &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*share.LifecycleState).To(Equal(vpcv1.ShareLifecycleStatePendingConst))
+
+		options := &vpcv1.CreateShareMountTargetOptions{
+			ShareID: share.ID,
+			ShareMountTargetPrototype: &vpcv1.ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup{
+				Name:              ptr.To("mount-target"),
+				TransitEncryption: ptr.To("none"),
+				VirtualNetworkInterface: &vpcv1.ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext{
+					Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID},
+				},
+			},
+		}
+		_, resp, err := client.CreateShareMountTarget(options)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+		clock.Advance()
+		mountTarget, _, err := client.CreateShareMountTarget(options)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(mountTarget.MountPath).To(BeNil())
+		_, err = client.DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: subnet.ID})
+		g.Expect(err).To(HaveOccurred())
+
+		clock.Advance()
+		mountTarget, err = client.GetShareMountTargetByName(*share.ID, "mount-target")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*mountTarget.LifecycleState).To(Equal(vpcv1.ShareMountTargetLifecycleStateStableConst))
+		g.Expect(*mountTarget.MountPath).To(Equal("10.240.0.4:/" + *share.ID))
+		share, _, err = client.GetShare(&vpcv1.GetShareOptions{ID: share.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(share.MountTargets).To(HaveLen(1))
+
+		_, _, err = client.DeleteShareMountTarget(&vpcv1.DeleteShareMountTargetOptions{ShareID: share.ID, ID: mountTarget.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: subnet.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+	t.Run("Should only add a load balancer pool member when the load balancer is active", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		client := b.VPCClient()
+		_, subnet := createVPCWithSubnet(g, b)
+
+		lb, _, err := client.CreateLoadBalancer(&vpcv1.CreateLoadBalancerOptions{
+			Name:     ptr.To("lb"),
+			IsPublic: ptr.To(true),
+			Subnets:  []vpcv1.SubnetIdentityIntf{&vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+			Pools:    []vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext{{Name: ptr.To("pool"), Algorithm: ptr.To("round_robin"), Protocol: ptr.To("tcp")}},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		pool, err := client.GetLoadBalancerPoolByName(*lb.ID, "pool")
+		g.Expect(err).ToNot(HaveOccurred())
+		options := &vpcv1.CreateLoadBalancerPoolMemberOptions{
+			LoadBalancerID: lb.ID,
+			PoolID:         pool.ID,
+			Port:           ptr.To(int64(6443)),
+			Target:         &vpcv1.LoadBalancerPoolMemberTargetPrototypeIP{Address: ptr.To("10.240.0.4")},
+		}
+
+		_, resp, err := client.CreateLoadBalancerPoolMember(options)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+		clock.Advance()
+		_, _, err = client.CreateLoadBalancerPoolMember(options)
+		g.Expect(err).ToNot(HaveOccurred())
+		lb, _, err = client.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: lb.ID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*lb.ProvisioningStatus).To(Equal(vpcv1.LoadBalancerProvisioningStatusUpdatePendingConst))
+	})
+}
+
+func TestPowerVSClient(t *testing.T) {
+	t.Run("Should lease the address of an instance in a DHCP network", func(t *testing.T) {
+		g := NewWithT(t)
+		b, clock := setupBackend()
+		ctx := context.Background()
+		workspaceID := b.AddPowerVSWorkspace("workspace", "dal10")
+		client := b.PowerVSClient(workspaceID)
+
+		server, err := client.CreateDHCPServer(ctx, &models.DHCPServerCreate{Name: ptr.To("cluster")})
+		g.Expect(err).ToNot(HaveOccurred())
+		instances, err := client.CreateInstance(ctx, &models.PVMInstanceCreate{
+			ServerName: ptr.To("instance"),
+			ImageID:    ptr.To(b.AddPowerVSImage(workspaceID, "image")),
+			Networks:   []*models.PVMInstanceAddNetwork{{NetworkID: server.Network.ID}},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.Advance()
+		instance, err := client.GetInstance(ctx, *(*instances)[0].PvmInstanceID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*instance.Status).To(Equal("ACTIVE"))
+		g.Expect(instance.Networks).To(HaveLen(1))
+
+		details, err := client.GetDHCPServer(ctx, *server.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*details.Status).To(Equal("ACTIVE"))
+		g.Expect(details.Leases).To(HaveLen(1))
+		g.Expect(*details.Leases[0].InstanceMacAddress).To(Equal(instance.Networks[0].MacAddress))
+		g.Expect(*details.Leases[0].InstanceIP).To(Equal(instance.Networks[0].IPAddress))
+	})
+	t.Run("Should not return the resources of another workspace", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		imageID := b.AddPowerVSImage(b.AddPowerVSWorkspace("workspace", "dal10"), "image")
+
+		_, err := b.PowerVSClient(b.AddPowerVSWorkspace("other", "dal10")).GetImage(context.Background(), imageID)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.(*NotFoundError).Code()).To(Equal(http.StatusNotFound))
+	})
+}
+
+func TestResourceControllerClient(t *testing.T) {
+	t.Run("Should find a workspace by name and zone", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		workspaceID := b.AddPowerVSWorkspace("workspace", "dal10")
+		b.AddPowerVSWorkspace("workspace", "wdc06")
+
+		workspace, err := b.ResourceControllerClient().GetResourceInstanceByFilter(resourcecontroller.InstanceFilter{
+			Name:           "workspace",
+			ResourceID:     resourcecontroller.PowerVSResourceID,
+			ResourcePlanID: resourcecontroller.PowerVSResourcePlanID,
+			Zone:           ptr.To("dal10"),
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*workspace.GUID).To(Equal(workspaceID))
+		g.Expect(*workspace.State).To(Equal("active"))
+	})
+}
+
+func TestGlobalTaggingClient(t *testing.T) {
+	t.Run("Should attach and detach the user tags of a resource", func(t *testing.T) {
+		g := NewWithT(t)
+		b, _ := setupBackend()
+		vpc, _, err := b.VPCClient().CreateVPC(&vpcv1.CreateVPCOptions{Name: ptr.To("vpc")})
+		g.Expect(err).ToNot(HaveOccurred())
+		volume, _, err := b.VPCClient().CreateVolume(&vpcv1.CreateVolumeOptions{
+			VolumePrototype: &vpcv1.VolumePrototypeVolumeByCapacity{
+				Name:     ptr.To("volume"),
+				Capacity: ptr.To[int64](10),
+				Profile:  &vpcv1.VolumeProfileIdentityByName{Name: ptr.To("general-purpose")},
+				Zone:     &vpcv1.ZoneIdentityByName{Name: ptr.To("us-south-1")},
+				UserTags: []string{"env:test"},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		client := b.GlobalTaggingClient()
+		resources := []globaltaggingv1.Resource{{ResourceID: vpc.CRN}, {ResourceID: volume.CRN}}
+		_, _, err = client.AttachTag(&globaltaggingv1.AttachTagOptions{
+			Resources: resources,
+			TagNames:  []string{"env:test", "team:infra"},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.GetAttachedTags(*vpc.CRN)).To(ConsistOf("env:test", "team:infra"))
+		g.Expect(client.GetAttachedTags(*volume.CRN)).To(ConsistOf("env:test", "team:infra"))
+
+		_, _, err = client.DetachTag(&globaltaggingv1.DetachTagOptions{
+			Resources: resources,
+			TagNames:  []string{"env:test"},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.GetAttachedTags(*vpc.CRN)).To(ConsistOf("team:infra"))
+		g.Expect(client.GetAttachedTags(*volume.CRN)).To(ConsistOf("team:infra"))
+	})
+}
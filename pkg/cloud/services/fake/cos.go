@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+)
+
+// Kinds of the COS resources stored in the backend, the objects are stored with the ID bucket/key.
+const (
+	kindBucket    = "cos.bucket"
+	kindCOSObject = "cos.object"
+)
+
+// cosClient is the fake COS client of the backend.
+type cosClient struct {
+	b      *Backend
+	region string
+}
+
+var _ cos.Cos = &cosClient{}
+
+// COSClient returns a COS client of the buckets in the region backed by the backend.
+func (b *Backend) COSClient(region string) cos.Cos {
+	return &cosClient{b: b, region: region}
+}
+
+// GetBucketByName returns a NotFound error if the bucket does not exist.
+func (c *cosClient) GetBucketByName(name string) (*s3.HeadBucketOutput, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, ok := c.b.get(kindBucket, name); !ok {
+		return nil, awserr.New("NotFound", fmt.Sprintf("bucket %s not found", name), nil)
+	}
+	return &s3.HeadBucketOutput{}, nil
+}
+
+// CreateBucket creates a bucket.
+func (c *cosClient) CreateBucket(input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	name := *input.Bucket
+	if _, ok := c.b.get(kindBucket, name); ok {
+		return nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, fmt.Sprintf("bucket %s already exists", name), nil)
+	}
+	c.b.insert(kindBucket, name, object{"name": name, "region": c.region}, nil)
+	return &s3.CreateBucketOutput{Location: ptr.To("/" + name)}, nil
+}
+
+// CreateBucketWithContext creates a bucket.
+func (c *cosClient) CreateBucketWithContext(_ aws.Context, input *s3.CreateBucketInput, _ ...request.Option) (*s3.CreateBucketOutput, error) {
+	return c.CreateBucket(input)
+}
+
+// bucket returns a NoSuchBucket error if the bucket does not exist.
+func (c *cosClient) bucket(name *string) error {
+	if _, ok := c.b.get(kindBucket, ptr.Deref(name, "")); !ok {
+		return awserr.New(s3.ErrCodeNoSuchBucket, fmt.Sprintf("bucket %s does not exist", ptr.Deref(name, "")), nil)
+	}
+	return nil
+}
+
+// PutObject stores an object in a bucket.
+func (c *cosClient) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if err := c.bucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	var data []byte
+	if input.Body != nil {
+		var err error
+		if data, err = io.ReadAll(input.Body); err != nil {
+			return nil, fmt.Errorf("failed to read object body: %w", err)
+		}
+	}
+	id := *input.Bucket + "/" + *input.Key
+	c.b.removeNow(kindCOSObject, id)
+	c.b.insert(kindCOSObject, id, object{
+		"bucket":        *input.Bucket,
+		"key":           *input.Key,
+		"size":          int64(len(data)),
+		"last_modified": c.b.now(),
+		"_data":         data,
+	}, nil)
+	return &s3.PutObjectOutput{ETag: ptr.To(fmt.Sprintf("%q", c.b.newID("etag")))}, nil
+}
+
+// GetObjectRequest returns a request to get an object, which is only meant to be presigned.
+func (c *cosClient) GetObjectRequest(input *s3.GetObjectInput) (*request.Request, *s3.GetObjectOutput) {
+	req, _ := http.NewRequest(http.MethodGet, c.objectURL(ptr.Deref(input.Bucket, ""), ptr.Deref(input.Key, "")), nil)
+	return &request.Request{HTTPRequest: req}, &s3.GetObjectOutput{}
+}
+
+// objectURL returns the URL of an object in the bucket.
+func (c *cosClient) objectURL(bucket, key string) string {
+	return fmt.Sprintf("https://s3.%s.cloud-object-storage.appdomain.cloud/%s/%s", c.region, url.PathEscape(bucket), key)
+}
+
+// ListObjects lists the objects of a bucket with the prefix, if set.
+func (c *cosClient) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if err := c.bucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	output := &s3.ListObjectsOutput{Name: input.Bucket}
+	for _, o := range c.b.find(kindCOSObject, func(o object) bool {
+		return o.str("bucket") == *input.Bucket && strings.HasPrefix(o.str("key"), ptr.Deref(input.Prefix, ""))
+	}) {
+		output.Contents = append(output.Contents, &s3.Object{
+			Key:          ptr.To(o.str("key")),
+			Size:         ptr.To(o["size"].(int64)),
+			LastModified: ptr.To(o["last_modified"].(time.Time)),
+		})
+	}
+	return output, nil
+}
+
+// DeleteObject deletes an object, deleting an object which does not exist succeeds.
+func (c *cosClient) DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if err := c.bucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	c.b.removeNow(kindCOSObject, *input.Bucket+"/"+*input.Key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+// PutPublicAccessBlock sets the public access block configuration of a bucket.
+func (c *cosClient) PutPublicAccessBlock(input *s3.PutPublicAccessBlockInput) (*s3.PutPublicAccessBlockOutput, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if err := c.bucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	return &s3.PutPublicAccessBlockOutput{}, nil
+}
+
+// PresignedURL returns a URL of an object, which is not actually signed.
+func (c *cosClient) PresignedURL(bucket, key string, expiry time.Duration) (string, error) {
+	return fmt.Sprintf("%s?X-Amz-Expires=%d", c.objectURL(bucket, key), int(expiry.Seconds())), nil
+}
+
+// GetObject returns the content of an object stored in a bucket, e.g. to check the bootstrap data uploaded by
+// the controllers in tests.
+func (b *Backend) GetObject(bucket, key string) ([]byte, bool) {
+	b.lock()
+	defer b.unlock()
+
+	o, ok := b.get(kindCOSObject, bucket+"/"+key)
+	if !ok {
+		return nil, false
+	}
+	data, _ := o["_data"].([]byte)
+	return data, true
+}
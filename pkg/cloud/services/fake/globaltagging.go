@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+)
+
+// kindTag is the kind of the user tags stored in the backend, the tags attached to a resource are stored
+// in its _tags field.
+const kindTag = "tag"
+
+// globalTaggingClient is the fake global tagging client of the backend.
+type globalTaggingClient struct {
+	b *Backend
+}
+
+var _ globaltagging.GlobalTagging = &globalTaggingClient{}
+
+// GlobalTaggingClient returns a global tagging client backed by the backend.
+func (b *Backend) GlobalTaggingClient() globaltagging.GlobalTagging {
+	return &globalTaggingClient{b: b}
+}
+
+// CreateTag creates user tags.
+func (c *globalTaggingClient) CreateTag(options *globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	results := []any{}
+	for _, name := range options.TagNames {
+		if _, ok := c.b.get(kindTag, name); !ok {
+			c.b.insert(kindTag, name, object{"name": name}, nil)
+		}
+		results = append(results, object{"tag_name": name, "is_error": false})
+	}
+	var result *globaltaggingv1.CreateTagResults
+	err := toModel(object{"results": results}, globaltaggingv1.UnmarshalCreateTagResults, &result)
+	return result, response(http.StatusCreated), err
+}
+
+// AttachTag attaches user tags to resources, creating the tags which do not exist.
+func (c *globalTaggingClient) AttachTag(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	names := options.TagNames
+	if options.TagName != nil {
+		names = append(names, *options.TagName)
+	}
+	results := []any{}
+	for _, resource := range options.Resources {
+		o, ok := c.b.findByCRN(*resource.ResourceID)
+		if !ok {
+			resp, err := notFound("resource", *resource.ResourceID)
+			return nil, resp, err
+		}
+		tags, _ := o["_tags"].([]string)
+		for _, name := range names {
+			if _, ok := c.b.get(kindTag, name); !ok {
+				c.b.insert(kindTag, name, object{"name": name}, nil)
+			}
+			if !slices.Contains(tags, name) {
+				tags = append(tags, name)
+			}
+		}
+		o["_tags"] = tags
+		results = append(results, object{"resource_id": *resource.ResourceID, "is_error": false})
+	}
+	var result *globaltaggingv1.TagResults
+	err := toModel(object{"results": results}, globaltaggingv1.UnmarshalTagResults, &result)
+	return result, response(http.StatusOK), err
+}
+
+// DetachTag detaches user tags from resources.
+func (c *globalTaggingClient) DetachTag(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	names := options.TagNames
+	if options.TagName != nil {
+		names = append(names, *options.TagName)
+	}
+	results := []any{}
+	for _, resource := range options.Resources {
+		o, ok := c.b.findByCRN(*resource.ResourceID)
+		if !ok {
+			resp, err := notFound("resource", *resource.ResourceID)
+			return nil, resp, err
+		}
+		tags, _ := o["_tags"].([]string)
+		o["_tags"] = slices.DeleteFunc(slices.Clone(tags), func(tag string) bool { return slices.Contains(names, tag) })
+		results = append(results, object{"resource_id": *resource.ResourceID, "is_error": false})
+	}
+	var result *globaltaggingv1.TagResults
+	err := toModel(object{"results": results}, globaltaggingv1.UnmarshalTagResults, &result)
+	return result, response(http.StatusOK), err
+}
+
+// GetAttachedTags returns the names of the user tags attached to the resource with the given CRN.
+func (c *globalTaggingClient) GetAttachedTags(resourceCRN string) ([]string, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findByCRN(resourceCRN)
+	if !ok {
+		_, err := notFound("resource", resourceCRN)
+		return nil, err
+	}
+	tags, _ := o["_tags"].([]string)
+	return slices.Clone(tags), nil
+}
+
+// GetTagByName returns the user tag with the given name, or nil if not found.
+func (c *globalTaggingClient) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindTag, tagName)
+	if !ok {
+		return nil, nil
+	}
+	var result *globaltaggingv1.Tag
+	if err := toModel(o, globaltaggingv1.UnmarshalTag, &result); err != nil {
+		return nil, fmt.Errorf("failed to convert fake tag: %w", err)
+	}
+	return result, nil
+}
+
+// userTags returns the user tags of the prototype of a resource, which are attached to the resource on its creation.
+func userTags(body object) []string {
+	var tags []string
+	userTags, _ := body["user_tags"].([]any)
+	for _, tag := range userTags {
+		if name, ok := tag.(string); ok && !slices.Contains(tags, name) {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// findByCRN returns the resource of any kind with the given CRN.
+func (b *Backend) findByCRN(crn string) (object, bool) {
+	for kind := range b.tables {
+		if o, ok := b.findOne(kind, func(o object) bool { return o.str("crn") == crn }); ok {
+			return o, true
+		}
+	}
+	return nil, false
+}
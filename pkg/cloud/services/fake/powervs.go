@@ -0,0 +1,470 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+)
+
+// Kinds of the PowerVS resources stored in the backend, the resources of a workspace have its ID in _workspace.
+const (
+	kindPVMInstance = "powervs.instance"
+	kindPVSImage    = "powervs.image"
+	kindPVSJob      = "powervs.job"
+	kindPVSNetwork  = "powervs.network"
+	kindDHCPServer  = "powervs.dhcpserver"
+)
+
+// powerVSClient is the fake PowerVS client of a workspace of the backend.
+type powerVSClient struct {
+	b         *Backend
+	workspace string
+}
+
+var _ powervs.PowerVS = &powerVSClient{}
+
+// PowerVSClient returns a client of the PowerVS workspace backed by the backend.
+func (b *Backend) PowerVSClient(workspaceID string) powervs.PowerVS {
+	return &powerVSClient{b: b, workspace: workspaceID}
+}
+
+// inWorkspace returns a filter matching the resources of the workspace of the client.
+func (c *powerVSClient) inWorkspace(filter func(object) bool) func(object) bool {
+	return func(o object) bool {
+		return o.str("_workspace") == c.workspace && (filter == nil || filter(o))
+	}
+}
+
+// get returns the resource of the workspace of the client.
+func (c *powerVSClient) get(kind, id string) (object, error) {
+	o, ok := c.b.get(kind, id)
+	if !ok || o.str("_workspace") != c.workspace {
+		return nil, &NotFoundError{Kind: kind, ID: id}
+	}
+	return o, nil
+}
+
+// powerVSModel converts an object to the PowerVS model T.
+func powerVSModel[T any](o object) (*T, error) {
+	result := new(T)
+	if err := toJSONModel(o, result); err != nil {
+		return nil, fmt.Errorf("failed to convert fake %T: %w", result, err)
+	}
+	return result, nil
+}
+
+// macAddress returns a new unique MAC address.
+func (b *Backend) macAddress() string {
+	n := b.nextID
+	b.nextID++
+	return fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", (n>>16)&0xff, (n>>8)&0xff, n&0xff)
+}
+
+// CreateInstance creates a PowerVS instance, its addresses in the DHCP networks are leased by their DHCP server.
+func (c *powerVSClient) CreateInstance(_ context.Context, body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if body.ImageID != nil {
+		if _, err := c.get(kindPVSImage, *body.ImageID); err != nil {
+			return nil, err
+		}
+	}
+	id := c.b.newID("pvm")
+	networks := []any{}
+	for _, n := range body.Networks {
+		network, err := c.get(kindPVSNetwork, *n.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+		address := n.IPAddress
+		if address == "" {
+			count := len(c.b.find(kindPVMInstance, c.inWorkspace(func(o object) bool {
+				return hasNetwork(o, network.str("networkID"))
+			})))
+			address = fmt.Sprintf("%s.%d", subnetNetworkPrefix(network.str("cidr")), count+10)
+		}
+		mac := c.b.macAddress()
+		networks = append(networks, object{
+			"networkID":   network["networkID"],
+			"networkName": network["name"],
+			"ipAddress":   address,
+			"ip":          address,
+			"macAddress":  mac,
+			"type":        "fixed",
+		})
+		for _, server := range c.b.find(kindDHCPServer, c.inWorkspace(func(o object) bool { return o.str("network", "id") == network.str("networkID") })) {
+			leases, _ := server["leases"].([]any)
+			server["leases"] = append(append([]any{}, leases...), object{"instanceIP": address, "instanceMacAddress": mac})
+		}
+	}
+	o := object{
+		"pvmInstanceID": id,
+		"serverName":    *body.ServerName,
+		"imageID":       body.ImageID,
+		"memory":        body.Memory,
+		"processors":    body.Processors,
+		"procType":      body.ProcType,
+		"sysType":       body.SysType,
+		"storageType":   body.StorageType,
+		"osType":        "rhel",
+		"diskSize":      120,
+		"status":        "BUILD",
+		"health":        object{"status": "PENDING"},
+		"networks":      networks,
+		"addresses":     networks,
+		"crn":           fmt.Sprintf("crn:v1:bluemix:public:power-iaas:%s:a/fake:%s:pvm-instance:%s", c.b.region, c.workspace, id),
+		"_workspace":    c.workspace,
+	}
+	c.b.insert(kindPVMInstance, id, o, func(o object) {
+		o["status"] = "ACTIVE"
+		o["health"] = object{"status": "OK"}
+	})
+	return &models.PVMInstanceList{{PvmInstanceID: &id, ServerName: body.ServerName}}, nil
+}
+
+// hasNetwork returns whether a PowerVS instance is attached to the network.
+func hasNetwork(o object, networkID string) bool {
+	networks, _ := o["networks"].([]any)
+	for _, n := range networks {
+		if toObject(n).str("networkID") == networkID {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteInstance deletes a PowerVS instance.
+func (c *powerVSClient) DeleteInstance(_ context.Context, id string) error {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, err := c.get(kindPVMInstance, id); err != nil {
+		return err
+	}
+	c.b.remove(kindPVMInstance, id, setField("status", "DELETING"))
+	return nil
+}
+
+// GetInstance returns a PowerVS instance.
+func (c *powerVSClient) GetInstance(_ context.Context, id string) (*models.PVMInstance, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, err := c.get(kindPVMInstance, id)
+	if err != nil {
+		return nil, err
+	}
+	return powerVSModel[models.PVMInstance](o)
+}
+
+// ListInstances lists the PowerVS instances.
+func (c *powerVSClient) ListInstances(_ context.Context) (*models.PVMInstances, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	list := []any{}
+	for _, o := range c.b.find(kindPVMInstance, c.inWorkspace(nil)) {
+		list = append(list, o)
+	}
+	return powerVSModel[models.PVMInstances](object{"pvmInstances": list})
+}
+
+// AddPowerVSImage adds an active image to a PowerVS workspace and returns its ID, e.g. a stock image.
+func (b *Backend) AddPowerVSImage(workspaceID, name string) string {
+	b.lock()
+	defer b.unlock()
+
+	return b.addPowerVSImage(workspaceID, name, "active")
+}
+
+func (b *Backend) addPowerVSImage(workspaceID, name, state string) string {
+	id := b.newID("image")
+	b.insert(kindPVSImage, id, object{
+		"imageID":     id,
+		"name":        name,
+		"state":       state,
+		"storageType": "tier1",
+		"storagePool": "Tier1-Flash-1",
+		"_workspace":  workspaceID,
+	}, nil)
+	return id
+}
+
+// GetImage returns a PowerVS image.
+func (c *powerVSClient) GetImage(_ context.Context, id string) (*models.Image, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, err := c.get(kindPVSImage, id)
+	if err != nil {
+		return nil, err
+	}
+	return powerVSModel[models.Image](o)
+}
+
+// DeleteImage deletes a PowerVS image.
+func (c *powerVSClient) DeleteImage(_ context.Context, id string) error {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, err := c.get(kindPVSImage, id); err != nil {
+		return err
+	}
+	c.b.removeNow(kindPVSImage, id)
+	return nil
+}
+
+// ListImages lists the PowerVS images.
+func (c *powerVSClient) ListImages(_ context.Context) (*models.Images, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	list := []any{}
+	for _, o := range c.b.find(kindPVSImage, c.inWorkspace(nil)) {
+		list = append(list, o)
+	}
+	return powerVSModel[models.Images](object{"images": list})
+}
+
+// GetJob returns a PowerVS job.
+func (c *powerVSClient) GetJob(_ context.Context, id string) (*models.Job, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, err := c.get(kindPVSJob, id)
+	if err != nil {
+		return nil, err
+	}
+	return powerVSModel[models.Job](o)
+}
+
+// DeleteJob deletes a PowerVS job.
+func (c *powerVSClient) DeleteJob(_ context.Context, id string) error {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, err := c.get(kindPVSJob, id); err != nil {
+		return err
+	}
+	c.b.removeNow(kindPVSJob, id)
+	return nil
+}
+
+// CreateCosImage starts a job importing an image from a COS bucket, the job is queued then running before
+// completing with the image becoming active.
+func (c *powerVSClient) CreateCosImage(_ context.Context, body *models.CreateCosImageImportJob) (*models.JobReference, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if jobs := c.b.find(kindPVSJob, c.inWorkspace(func(o object) bool { return o.str("status", "state") != "completed" })); len(jobs) > 0 {
+		return nil, fmt.Errorf("image import job %s is already in progress", jobs[0]["id"])
+	}
+	imageID := c.b.addPowerVSImage(c.workspace, *body.ImageName, "queued")
+	id := c.b.newID("job")
+	href := fmt.Sprintf("/pcloud/v1/cloud-instances/%s/jobs/%s", c.workspace, id)
+	setState := func(state, progress string) func(object) {
+		return func(o object) {
+			o["status"] = object{"state": state, "progress": progress}
+			if image, ok := c.b.get(kindPVSImage, imageID); ok {
+				image["state"] = state
+				if state == "completed" {
+					image["state"] = "active"
+				}
+			}
+		}
+	}
+	c.b.insert(kindPVSJob, id, object{
+		"id":         id,
+		"operation":  object{"action": "imageImportJob", "id": imageID, "target": "cloudConnection"},
+		"status":     object{"state": "queued", "progress": "0"},
+		"_workspace": c.workspace,
+	}, func(o object) {
+		setState("running", "50")(o)
+		c.b.transition(kindPVSJob, id, setState("completed", "100"))
+	})
+	return &models.JobReference{ID: &id, Href: &href}, nil
+}
+
+// GetCosImages returns the last image import job of the workspace, or nil if none.
+func (c *powerVSClient) GetCosImages(_ context.Context, id string) (*models.Job, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	jobs := c.b.find(kindPVSJob, func(o object) bool { return o.str("_workspace") == id })
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return powerVSModel[models.Job](jobs[len(jobs)-1])
+}
+
+// AddPowerVSNetwork adds a network to a PowerVS workspace and returns its ID.
+func (b *Backend) AddPowerVSNetwork(workspaceID, name, cidr string) string {
+	b.lock()
+	defer b.unlock()
+
+	return b.addPowerVSNetwork(workspaceID, name, cidr, "vlan")
+}
+
+func (b *Backend) addPowerVSNetwork(workspaceID, name, cidr, networkType string) string {
+	id := b.newID("network")
+	b.insert(kindPVSNetwork, id, object{
+		"networkID":  id,
+		"name":       name,
+		"cidr":       cidr,
+		"gateway":    subnetNetworkPrefix(cidr) + ".1",
+		"type":       networkType,
+		"vlanID":     b.nextID%4000 + 1,
+		"href":       fmt.Sprintf("/pcloud/v1/cloud-instances/%s/networks/%s", workspaceID, id),
+		"dnsServers": []string{"9.9.9.9"},
+		"_workspace": workspaceID,
+	}, nil)
+	return id
+}
+
+// ListNetworks lists the PowerVS networks.
+func (c *powerVSClient) ListNetworks(_ context.Context) (*models.Networks, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	list := []any{}
+	for _, o := range c.b.find(kindPVSNetwork, c.inWorkspace(nil)) {
+		list = append(list, o)
+	}
+	return powerVSModel[models.Networks](object{"networks": list})
+}
+
+// GetNetworkByID returns a PowerVS network.
+func (c *powerVSClient) GetNetworkByID(_ context.Context, id string) (*models.Network, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, err := c.get(kindPVSNetwork, id)
+	if err != nil {
+		return nil, err
+	}
+	return powerVSModel[models.Network](o)
+}
+
+// GetNetworkByName returns the PowerVS network with the given name, or nil if not found.
+func (c *powerVSClient) GetNetworkByName(_ context.Context, networkName string) (*models.NetworkReference, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindPVSNetwork, c.inWorkspace(withName(networkName)))
+	if !ok {
+		return nil, nil
+	}
+	return powerVSModel[models.NetworkReference](o)
+}
+
+// CreateDHCPServer creates a DHCP server with its private network.
+func (c *powerVSClient) CreateDHCPServer(_ context.Context, body *models.DHCPServerCreate) (*models.DHCPServer, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := c.b.newID("dhcp")
+	name := fmt.Sprintf("DHCPSERVER%s_Private", id)
+	if body.Name != nil {
+		name = fmt.Sprintf("DHCPSERVER%s_Private", *body.Name)
+	}
+	cidr := "192.168.0.0/24"
+	if body.Cidr != nil {
+		cidr = *body.Cidr
+	}
+	networkID := c.b.addPowerVSNetwork(c.workspace, name, cidr, "dhcp-vlan")
+	o := object{
+		"id":         id,
+		"network":    object{"id": networkID, "name": name},
+		"status":     "BUILD",
+		"leases":     []any{},
+		"_workspace": c.workspace,
+	}
+	c.b.insert(kindDHCPServer, id, o, setField("status", "ACTIVE"))
+	return powerVSModel[models.DHCPServer](o)
+}
+
+// dhcpServer returns the DHCP server, with the error of the PowerVS client if not found.
+func (c *powerVSClient) dhcpServer(id string) (object, error) {
+	o, err := c.get(kindDHCPServer, id)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] dhcp server does not exist: %w", err)
+	}
+	return o, nil
+}
+
+// GetDHCPServer returns a DHCP server with its leases.
+func (c *powerVSClient) GetDHCPServer(_ context.Context, id string) (*models.DHCPServerDetail, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, err := c.dhcpServer(id)
+	if err != nil {
+		return nil, err
+	}
+	return powerVSModel[models.DHCPServerDetail](o)
+}
+
+// DeleteDHCPServer deletes a DHCP server with its private network.
+func (c *powerVSClient) DeleteDHCPServer(_ context.Context, id string) error {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, err := c.dhcpServer(id)
+	if err != nil {
+		return err
+	}
+	c.b.remove(kindDHCPServer, id, setField("status", "DELETING"))
+	c.b.removeNow(kindPVSNetwork, o.str("network", "id"))
+	return nil
+}
+
+// ListDHCPServers lists the DHCP servers.
+func (c *powerVSClient) ListDHCPServers(_ context.Context) (models.DHCPServers, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	servers := models.DHCPServers{}
+	for _, o := range c.b.find(kindDHCPServer, c.inWorkspace(nil)) {
+		server, err := powerVSModel[models.DHCPServer](o)
+		if err != nil {
+			return nil, err
+		}
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+// GetDatacenterDetails returns a datacenter supporting the Power Edge Router and the common system types.
+func (c *powerVSClient) GetDatacenterDetails(_ context.Context, zone string) (*models.Datacenter, error) {
+	region := strings.TrimRightFunc(zone, func(r rune) bool { return r >= '0' && r <= '9' || r == '-' })
+	return powerVSModel[models.Datacenter](object{
+		"status":       "active",
+		"type":         "off-premises",
+		"location":     object{"region": region, "type": "data-center", "url": "https://" + region + ".power-iaas.cloud.ibm.com"},
+		"capabilities": map[string]bool{"power-edge-router": true, "vpn-connections": true},
+		"capabilitiesDetails": object{
+			"supportedSystems": object{"general": []string{"e980", "s1022", "s922"}, "dedicated": []string{}},
+		},
+	})
+}
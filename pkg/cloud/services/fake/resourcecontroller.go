@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+)
+
+// Kinds of the resource controller resources stored in the backend.
+const (
+	kindResourceInstance = "resourceinstance"
+	kindResourceKey      = "resourceinstance.key"
+)
+
+// resourceIDs maps the plan IDs of the service instances created by the controllers to their resource IDs.
+var resourceIDs = map[string]string{
+	resourcecontroller.PowerVSResourcePlanID: resourcecontroller.PowerVSResourceID,
+	resourcecontroller.CosResourcePlanID:     resourcecontroller.CosResourceID,
+}
+
+// resourceControllerClient is the fake resource controller client of the backend.
+type resourceControllerClient struct {
+	b   *Backend
+	url string
+}
+
+var _ resourcecontroller.ResourceController = &resourceControllerClient{}
+
+// ResourceControllerClient returns a resource controller client backed by the backend.
+func (b *Backend) ResourceControllerClient() resourcecontroller.ResourceController {
+	return &resourceControllerClient{b: b, url: resourcecontrollerv2.DefaultServiceURL}
+}
+
+// resourceInstanceModel converts an object to a resource instance.
+func resourceInstanceModel(o object) (*resourcecontrollerv2.ResourceInstance, error) {
+	var result *resourcecontrollerv2.ResourceInstance
+	if err := toModel(o, resourcecontrollerv2.UnmarshalResourceInstance, &result); err != nil {
+		return nil, fmt.Errorf("failed to convert fake resource instance: %w", err)
+	}
+	return result, nil
+}
+
+// newResourceInstance returns a new provisioning service instance, whose ID is its CRN.
+func (b *Backend) newResourceInstance(name, target, resourceGroupID, planID string) object {
+	guid := b.newID("instance")
+	crn := fmt.Sprintf("crn:v1:bluemix:public:fake:%s:a/fake:%s::", target, guid)
+	return object{
+		"id":                crn,
+		"crn":               crn,
+		"guid":              guid,
+		"name":              name,
+		"region_id":         target,
+		"resource_group_id": resourceGroupID,
+		"resource_plan_id":  planID,
+		"resource_id":       resourceIDs[planID],
+		"state":             "provisioning",
+	}
+}
+
+// CreateResourceInstance creates a service instance, e.g. a PowerVS workspace or a COS instance.
+func (c *resourceControllerClient) CreateResourceInstance(options *resourcecontrollerv2.CreateResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o := c.b.newResourceInstance(*options.Name, *options.Target, *options.ResourceGroup, *options.ResourcePlanID)
+	c.b.insert(kindResourceInstance, o.str("guid"), o, setField("state", "active"))
+	result, err := resourceInstanceModel(o)
+	return result, response(http.StatusCreated), err
+}
+
+// DeleteResourceInstance deletes a service instance, which is kept in the removed state until the provisioning
+// delay elapsed.
+func (c *resourceControllerClient) DeleteResourceInstance(options *resourcecontrollerv2.DeleteResourceInstanceOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.resourceInstance(*options.ID)
+	if !ok {
+		return notFound(kindResourceInstance, *options.ID)
+	}
+	c.b.remove(kindResourceInstance, o.str("guid"), setField("state", "removed"))
+	return response(http.StatusAccepted), nil
+}
+
+// resourceInstance returns the service instance with the GUID or the CRN.
+func (c *resourceControllerClient) resourceInstance(id string) (object, bool) {
+	if o, ok := c.b.get(kindResourceInstance, id); ok {
+		return o, true
+	}
+	return c.b.findOne(kindResourceInstance, func(o object) bool { return o.str("crn") == id })
+}
+
+// GetResourceInstance returns a service instance.
+func (c *resourceControllerClient) GetResourceInstance(options *resourcecontrollerv2.GetResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.resourceInstance(*options.ID)
+	if !ok {
+		resp, err := notFound(kindResourceInstance, *options.ID)
+		return nil, resp, err
+	}
+	result, err := resourceInstanceModel(o)
+	return result, response(http.StatusOK), err
+}
+
+// ListResourceInstances lists the service instances which are not removed.
+func (c *resourceControllerClient) ListResourceInstances(options *resourcecontrollerv2.ListResourceInstancesOptions) (*resourcecontrollerv2.ResourceInstancesList, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if options == nil {
+		options = &resourcecontrollerv2.ListResourceInstancesOptions{}
+	}
+	matches := func(value *string, field string, o object) bool {
+		return value == nil || o.str(field) == *value
+	}
+	resources := []any{}
+	for _, o := range c.b.find(kindResourceInstance, func(o object) bool {
+		return o.str("state") != "removed" &&
+			matches(options.GUID, "guid", o) &&
+			matches(options.Name, "name", o) &&
+			matches(options.ResourceID, "resource_id", o) &&
+			matches(options.ResourcePlanID, "resource_plan_id", o) &&
+			matches(options.ResourceGroupID, "resource_group_id", o)
+	}) {
+		resources = append(resources, o)
+	}
+	var result *resourcecontrollerv2.ResourceInstancesList
+	err := toModel(object{"resources": resources, "rows_count": len(resources)}, resourcecontrollerv2.UnmarshalResourceInstancesList, &result)
+	return result, response(http.StatusOK), err
+}
+
+// listResourceInstances returns the service instances matching the filter.
+func (c *resourceControllerClient) listResourceInstances(filter resourcecontroller.InstanceFilter) ([]resourcecontrollerv2.ResourceInstance, error) {
+	options := &resourcecontrollerv2.ListResourceInstancesOptions{}
+	if filter.ID != "" {
+		options.GUID = &filter.ID
+	}
+	if filter.Name != "" {
+		options.Name = &filter.Name
+	}
+	if filter.ResourceID != "" {
+		options.ResourceID = &filter.ResourceID
+	}
+	if filter.ResourcePlanID != "" {
+		options.ResourcePlanID = &filter.ResourcePlanID
+	}
+	list, _, err := c.ListResourceInstances(options)
+	if err != nil {
+		return nil, err
+	}
+	instances := []resourcecontrollerv2.ResourceInstance{}
+	for _, instance := range list.Resources {
+		if filter.Zone == nil || *filter.Zone == "" || (instance.RegionID != nil && *instance.RegionID == *filter.Zone) {
+			instances = append(instances, instance)
+		}
+	}
+	return instances, nil
+}
+
+// GetResourceInstanceByFilter returns the service instance matching the filter, or nil if not found.
+func (c *resourceControllerClient) GetResourceInstanceByFilter(filter resourcecontroller.InstanceFilter) (*resourcecontrollerv2.ResourceInstance, error) {
+	instances, err := c.listResourceInstances(filter)
+	if err != nil {
+		return nil, fmt.Errorf("error listing resource instances: %v", err)
+	}
+	switch len(instances) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &instances[0], nil
+	default:
+		return nil, fmt.Errorf("found multiple resource instances matching filters (name=%s id=%s); specify a unique filter", filter.Name, filter.ID)
+	}
+}
+
+// GetServiceInstance returns the PowerVS workspace with the ID or the name, or nil if not found.
+func (c *resourceControllerClient) GetServiceInstance(id, name string, zone *string) (*resourcecontrollerv2.ResourceInstance, error) {
+	return c.GetResourceInstanceByFilter(resourcecontroller.InstanceFilter{
+		ID:             id,
+		Name:           name,
+		ResourceID:     resourcecontroller.PowerVSResourceID,
+		ResourcePlanID: resourcecontroller.PowerVSResourcePlanID,
+		Zone:           zone,
+	})
+}
+
+// GetInstanceByName returns the service instance with the name, or nil if not found.
+func (c *resourceControllerClient) GetInstanceByName(name, resourceID, planID string) (*resourcecontrollerv2.ResourceInstance, error) {
+	return c.GetResourceInstanceByFilter(resourcecontroller.InstanceFilter{
+		Name:           name,
+		ResourceID:     resourceID,
+		ResourcePlanID: planID,
+	})
+}
+
+// CreateResourceKey creates a resource key for a service instance, with HMAC credentials for a COS instance.
+func (c *resourceControllerClient) CreateResourceKey(options *resourcecontrollerv2.CreateResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	instance, ok := c.resourceInstance(*options.Source)
+	if !ok {
+		resp, err := notFound(kindResourceInstance, *options.Source)
+		return nil, resp, err
+	}
+	guid := c.b.newID("key")
+	o := object{
+		"id":     guid,
+		"guid":   guid,
+		"name":   *options.Name,
+		"source": instance["crn"],
+		"state":  "active",
+		"credentials": object{
+			"cos_hmac_keys": object{
+				"access_key_id":     fmt.Sprintf("%s-access-key-id", guid),
+				"secret_access_key": fmt.Sprintf("%s-secret-access-key", guid),
+			},
+		},
+	}
+	c.b.insert(kindResourceKey, guid, o, nil)
+	var result *resourcecontrollerv2.ResourceKey
+	err := toModel(o, resourcecontrollerv2.UnmarshalResourceKey, &result)
+	return result, response(http.StatusCreated), err
+}
+
+// SetServiceURL sets the URL of the service, which is ignored by the backend.
+func (c *resourceControllerClient) SetServiceURL(url string) error {
+	c.url = url
+	return nil
+}
+
+// GetServiceURL returns the URL of the service.
+func (c *resourceControllerClient) GetServiceURL() string {
+	return c.url
+}
+
+// AddPowerVSWorkspace adds an active PowerVS workspace in the zone to the backend and returns its GUID.
+func (b *Backend) AddPowerVSWorkspace(name, zone string) string {
+	b.lock()
+	defer b.unlock()
+
+	o := b.newResourceInstance(name, zone, b.resourceGroup(object{}).str("id"), resourcecontroller.PowerVSResourcePlanID)
+	o["state"] = "active"
+	b.insert(kindResourceInstance, o.str("guid"), o, nil)
+	return o.str("guid")
+}
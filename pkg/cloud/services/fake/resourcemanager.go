@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
+)
+
+// kindResourceGroup is the kind of the resource groups stored in the backend.
+const kindResourceGroup = "resourcegroup"
+
+// resourceManagerClient is the fake resource manager client of the backend.
+type resourceManagerClient struct {
+	b *Backend
+}
+
+var _ resourcemanager.ResourceManager = &resourceManagerClient{}
+
+// ResourceManagerClient returns a resource manager client backed by the backend.
+func (b *Backend) ResourceManagerClient() resourcemanager.ResourceManager {
+	return &resourceManagerClient{b: b}
+}
+
+// AddResourceGroup adds a resource group to the backend and returns its ID. The first resource group added is the
+// default one, used by the resources created without resource group.
+func (b *Backend) AddResourceGroup(name string) string {
+	b.lock()
+	defer b.unlock()
+
+	id := b.newID("rg")
+	b.insert(kindResourceGroup, id, object{
+		"id":      id,
+		"name":    name,
+		"crn":     fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/fake::resource-group:%s", id),
+		"state":   "ACTIVE",
+		"default": len(b.find(kindResourceGroup, nil)) == 0,
+	}, nil)
+	return id
+}
+
+// GetResourceGroup returns a resource group.
+func (c *resourceManagerClient) GetResourceGroup(options *resourcemanagerv2.GetResourceGroupOptions) (*resourcemanagerv2.ResourceGroup, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindResourceGroup, *options.ID)
+	if !ok {
+		resp, err := notFound(kindResourceGroup, *options.ID)
+		return nil, resp, err
+	}
+	var result *resourcemanagerv2.ResourceGroup
+	err := toModel(o, resourcemanagerv2.UnmarshalResourceGroup, &result)
+	return result, response(http.StatusOK), err
+}
+
+// ListResourceGroups lists the resource groups.
+func (c *resourceManagerClient) ListResourceGroups(options *resourcemanagerv2.ListResourceGroupsOptions) (*resourcemanagerv2.ResourceGroupList, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	name := ""
+	if options != nil && options.Name != nil {
+		name = *options.Name
+	}
+	list := []any{}
+	for _, o := range c.b.find(kindResourceGroup, withName(name)) {
+		list = append(list, o)
+	}
+	var result *resourcemanagerv2.ResourceGroupList
+	err := toModel(object{"resources": list}, resourcemanagerv2.UnmarshalResourceGroupList, &result)
+	return result, response(http.StatusOK), err
+}
+
+// GetResourceGroupByName returns the resource group with the given name.
+func (c *resourceManagerClient) GetResourceGroupByName(rgName string) (*resourcemanagerv2.ResourceGroup, error) {
+	result, _, err := c.ListResourceGroups(&resourcemanagerv2.ListResourceGroupsOptions{Name: &rgName})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing Resource Groups: %w", err)
+	}
+	if len(result.Resources) != 1 {
+		return nil, fmt.Errorf("failed to find Resource Group")
+	}
+	return &result.Resources[0], nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Seed holds the resources which exist in an IBM Cloud account before the clusters are created, e.g. the stock
+// images and the SSH keys referenced by the machines.
+type Seed struct {
+	// ResourceGroups are the names of the resource groups added in addition to the default one.
+	ResourceGroups []string `json:"resourceGroups,omitempty"`
+
+	// SSHKeys are the names of the VPC SSH keys.
+	SSHKeys []string `json:"sshKeys,omitempty"`
+
+	// VPCImages are the names of the VPC public images.
+	VPCImages []string `json:"vpcImages,omitempty"`
+
+	// PowerVSWorkspaces are the PowerVS workspaces with their images and networks.
+	PowerVSWorkspaces []PowerVSWorkspaceSeed `json:"powerVSWorkspaces,omitempty"`
+}
+
+// PowerVSWorkspaceSeed is a PowerVS workspace of a Seed.
+type PowerVSWorkspaceSeed struct {
+	Name     string               `json:"name"`
+	Zone     string               `json:"zone"`
+	Images   []string             `json:"images,omitempty"`
+	Networks []PowerVSNetworkSeed `json:"networks,omitempty"`
+}
+
+// PowerVSNetworkSeed is a network of a PowerVSWorkspaceSeed.
+type PowerVSNetworkSeed struct {
+	Name string `json:"name"`
+	CIDR string `json:"cidr"`
+}
+
+// LoadSeedFile reads a Seed from a YAML or JSON file.
+func LoadSeedFile(path string) (Seed, error) {
+	var seed Seed
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return seed, fmt.Errorf("failed to read fake backend seed file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, &seed); err != nil {
+		return seed, fmt.Errorf("failed to parse fake backend seed file %s: %w", path, err)
+	}
+	return seed, nil
+}
+
+// Load adds the resources of the seed to the backend.
+func (b *Backend) Load(seed Seed) {
+	for _, name := range seed.ResourceGroups {
+		b.AddResourceGroup(name)
+	}
+	for _, name := range seed.SSHKeys {
+		b.AddSSHKey(name)
+	}
+	for _, name := range seed.VPCImages {
+		b.AddVPCImage(name)
+	}
+	for _, workspace := range seed.PowerVSWorkspaces {
+		id := b.AddPowerVSWorkspace(workspace.Name, workspace.Zone)
+		for _, name := range workspace.Images {
+			b.AddPowerVSImage(id, name)
+		}
+		for _, network := range workspace.Networks {
+			b.AddPowerVSNetwork(id, network.Name, network.CIDR)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
+)
+
+// Kinds of the transit gateway resources stored in the backend.
+const (
+	kindTransitGateway = "transitgateway"
+	kindTGConnection   = "transitgateway.connection"
+)
+
+// transitGatewayClient is the fake transit gateway client of the backend.
+type transitGatewayClient struct {
+	b *Backend
+}
+
+var _ transitgateway.TransitGateway = &transitGatewayClient{}
+
+// TransitGatewayClient returns a transit gateway client backed by the backend.
+func (b *Backend) TransitGatewayClient() transitgateway.TransitGateway {
+	return &transitGatewayClient{b: b}
+}
+
+// transitGatewayModel converts an object to a transit gateway.
+func transitGatewayModel(o object) *tgapiv1.TransitGateway {
+	return &tgapiv1.TransitGateway{
+		ID:            ptr.To(o.str("id")),
+		Crn:           ptr.To(o.str("crn")),
+		Name:          ptr.To(o.str("name")),
+		Global:        ptr.To(o["global"] == true),
+		Status:        ptr.To(o.str("status")),
+		ResourceGroup: &tgapiv1.ResourceGroupReference{ID: ptr.To(o.str("resource_group", "id"))},
+	}
+}
+
+// transitGatewayConnectionModel converts an object to a transit gateway connection.
+func transitGatewayConnectionModel(o object) *tgapiv1.TransitGatewayConnectionCust {
+	return &tgapiv1.TransitGatewayConnectionCust{
+		ID:          ptr.To(o.str("id")),
+		Name:        ptr.To(o.str("name")),
+		NetworkType: ptr.To(o.str("network_type")),
+		NetworkID:   ptr.To(o.str("network_id")),
+		Status:      ptr.To(o.str("status")),
+		TransitGateway: &tgapiv1.TransitGatewayReference{
+			ID:   ptr.To(o.str("transit_gateway", "id")),
+			Crn:  ptr.To(o.str("transit_gateway", "crn")),
+			Name: ptr.To(o.str("transit_gateway", "name")),
+		},
+	}
+}
+
+// CreateTransitGateway creates a transit gateway.
+func (c *transitGatewayClient) CreateTransitGateway(options *tgapiv1.CreateTransitGatewayOptions) (*tgapiv1.TransitGateway, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := c.b.newID("tg")
+	o := object{
+		"id":       id,
+		"crn":      fmt.Sprintf("crn:v1:bluemix:public:transit:%s:a/fake::gateway:%s", ptr.Deref(options.Location, ""), id),
+		"name":     ptr.Deref(options.Name, id),
+		"location": ptr.Deref(options.Location, ""),
+		"global":   ptr.Deref(options.Global, false),
+		"status":   "pending",
+	}
+	if options.ResourceGroup != nil && options.ResourceGroup.ID != nil {
+		o["resource_group"] = object{"id": *options.ResourceGroup.ID}
+	} else {
+		o["resource_group"] = c.b.resourceGroup(object{})
+	}
+	c.b.insert(kindTransitGateway, id, o, setField("status", "available"))
+	return transitGatewayModel(o), response(http.StatusCreated), nil
+}
+
+// DeleteTransitGateway deletes a transit gateway, which must not have connections.
+func (c *transitGatewayClient) DeleteTransitGateway(options *tgapiv1.DeleteTransitGatewayOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindTransitGateway, id); !ok {
+		return notFound(kindTransitGateway, id)
+	}
+	if conns := c.b.find(kindTGConnection, func(o object) bool { return o.str("transit_gateway", "id") == id }); len(conns) > 0 {
+		return conflict("the transit gateway %s still has %d connections", id, len(conns))
+	}
+	c.b.remove(kindTransitGateway, id, setField("status", "deleting"))
+	return response(http.StatusNoContent), nil
+}
+
+// GetTransitGateway returns a transit gateway.
+func (c *transitGatewayClient) GetTransitGateway(options *tgapiv1.GetTransitGatewayOptions) (*tgapiv1.TransitGateway, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindTransitGateway, *options.ID)
+	if !ok {
+		resp, err := notFound(kindTransitGateway, *options.ID)
+		return nil, resp, err
+	}
+	return transitGatewayModel(o), response(http.StatusOK), nil
+}
+
+// GetTransitGatewayByName returns the transit gateway with the given name, or nil if not found.
+func (c *transitGatewayClient) GetTransitGatewayByName(name string) (*tgapiv1.TransitGateway, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindTransitGateway, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	return transitGatewayModel(o), nil
+}
+
+// CreateTransitGatewayConnection connects a network to a transit gateway.
+func (c *transitGatewayClient) CreateTransitGatewayConnection(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	tg, ok := c.b.get(kindTransitGateway, *options.TransitGatewayID)
+	if !ok {
+		resp, err := notFound(kindTransitGateway, *options.TransitGatewayID)
+		return nil, resp, err
+	}
+	id := c.b.newID("tgc")
+	o := object{
+		"id":              id,
+		"name":            ptr.Deref(options.Name, id),
+		"network_type":    ptr.Deref(options.NetworkType, ""),
+		"network_id":      ptr.Deref(options.NetworkID, ""),
+		"status":          "pending",
+		"transit_gateway": reference(tg),
+	}
+	c.b.insert(kindTGConnection, id, o, setField("status", "attached"))
+	return transitGatewayConnectionModel(o), response(http.StatusCreated), nil
+}
+
+// DeleteTransitGatewayConnection disconnects a network from a transit gateway.
+func (c *transitGatewayClient) DeleteTransitGatewayConnection(options *tgapiv1.DeleteTransitGatewayConnectionOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindTGConnection, *options.ID)
+	if !ok || o.str("transit_gateway", "id") != *options.TransitGatewayID {
+		return notFound(kindTGConnection, *options.ID)
+	}
+	c.b.remove(kindTGConnection, *options.ID, setField("status", "deleting"))
+	return response(http.StatusNoContent), nil
+}
+
+// GetTransitGatewayConnection returns a connection of a transit gateway.
+func (c *transitGatewayClient) GetTransitGatewayConnection(options *tgapiv1.GetTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindTGConnection, *options.ID)
+	if !ok || o.str("transit_gateway", "id") != *options.TransitGatewayID {
+		resp, err := notFound(kindTGConnection, *options.ID)
+		return nil, resp, err
+	}
+	return transitGatewayConnectionModel(o), response(http.StatusOK), nil
+}
+
+// ListTransitGatewayConnections lists the connections of a transit gateway.
+func (c *transitGatewayClient) ListTransitGatewayConnections(options *tgapiv1.ListTransitGatewayConnectionsOptions) (*tgapiv1.TransitGatewayConnectionCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, ok := c.b.get(kindTransitGateway, *options.TransitGatewayID); !ok {
+		resp, err := notFound(kindTransitGateway, *options.TransitGatewayID)
+		return nil, resp, err
+	}
+	result := &tgapiv1.TransitGatewayConnectionCollection{Connections: []tgapiv1.TransitGatewayConnectionCust{}}
+	for _, o := range c.b.find(kindTGConnection, func(o object) bool { return o.str("transit_gateway", "id") == *options.TransitGatewayID }) {
+		result.Connections = append(result.Connections, *transitGatewayConnectionModel(o))
+	}
+	return result, response(http.StatusOK), nil
+}
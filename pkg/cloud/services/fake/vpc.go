@@ -0,0 +1,2657 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+)
+
+// Kinds of the VPC resources stored in the backend.
+const (
+	kindVPC              = "vpc"
+	kindAddressPrefix    = "vpc.addressprefix"
+	kindSubnet           = "vpc.subnet"
+	kindPublicGateway    = "vpc.publicgateway"
+	kindSecurityGroup    = "vpc.securitygroup"
+	kindLoadBalancer     = "vpc.loadbalancer"
+	kindLBPool           = "vpc.loadbalancer.pool"
+	kindLBListener       = "vpc.loadbalancer.listener"
+	kindLBPoolMember     = "vpc.loadbalancer.pool.member"
+	kindKey              = "vpc.key"
+	kindImage            = "vpc.image"
+	kindInstance         = "vpc.instance"
+	kindVolume           = "vpc.volume"
+	kindVolumeAttachment = "vpc.instance.volumeattachment"
+	kindInstanceTemplate = "vpc.instancetemplate"
+	kindInstanceGroup    = "vpc.instancegroup"
+	kindIGMembership     = "vpc.instancegroup.membership"
+	kindVNI              = "vpc.virtualnetworkinterface"
+	kindFloatingIP       = "vpc.floatingip"
+	kindReservedIP       = "vpc.subnet.reservedip"
+	kindReservation      = "vpc.reservation"
+	kindShare            = "vpc.share"
+	kindShareMountTarget = "vpc.share.mounttarget"
+	kindSnapshot         = "vpc.snapshot"
+)
+
+// instanceProfileRegexp matches the names of the instance profiles, e.g. bx2-4x16, to get their vCPU count and memory.
+var instanceProfileRegexp = regexp.MustCompile(`^[a-z0-9]+-(\d+)x(\d+)$`)
+
+// vpcClient is the fake VPC client of the backend.
+type vpcClient struct {
+	b *Backend
+}
+
+var _ vpc.Vpc = &vpcClient{}
+
+// VPCClient returns a VPC client backed by the backend.
+func (b *Backend) VPCClient() vpc.Vpc {
+	return &vpcClient{b: b}
+}
+
+// vpcModel converts an object to the VPC model T using its unmarshaller.
+func vpcModel[T any](o object, unmarshal func(map[string]json.RawMessage, any) error) (*T, error) {
+	var result *T
+	if err := toModel(o, unmarshal, &result); err != nil {
+		return nil, fmt.Errorf("failed to convert fake %T: %w", result, err)
+	}
+	return result, nil
+}
+
+// vpcCollection converts the objects to the VPC collection model T, whose items are in the field named items.
+func vpcCollection[T any](items string, objs []object, unmarshal func(map[string]json.RawMessage, any) error) (*T, *core.DetailedResponse, error) {
+	list := make([]any, 0, len(objs))
+	for _, o := range objs {
+		list = append(list, o)
+	}
+	result, err := vpcModel[T](object{items: list, "limit": len(list), "total_count": len(list), "first": object{"href": "https://fake/" + items}}, unmarshal)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, response(http.StatusOK), nil
+}
+
+// vpcResource returns a new VPC resource object with its identifiers.
+func (c *vpcClient) vpcResource(kind, collection, name string) object {
+	id := c.b.newID(collection)
+	if name == "" {
+		name = id
+	}
+	return object{
+		"id":   id,
+		"name": name,
+		"crn":  fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/fake::%s:%s", c.b.region, kind, id),
+		"href": fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1/%s/%s", c.b.region, collection, id),
+	}
+}
+
+// reference returns the reference to a resource.
+func reference(o object) object {
+	ref := object{}
+	for _, key := range []string{"id", "crn", "href", "name"} {
+		if v, ok := o[key]; ok {
+			ref[key] = v
+		}
+	}
+	return ref
+}
+
+// resolve returns the resource of the kind matching the identity, by ID, CRN, href or name.
+func (b *Backend) resolve(kind string, identity any) (object, bool) {
+	ref, ok := identity.(object)
+	if !ok {
+		if m, isMap := identity.(map[string]any); isMap {
+			ref = m
+		} else {
+			ref = toObject(identity)
+		}
+	}
+	for _, key := range []string{"id", "crn", "href", "name"} {
+		if v := ref.str(key); v != "" {
+			return b.findOne(kind, func(o object) bool { return o.str(key) == v })
+		}
+	}
+	return nil, false
+}
+
+// resourceGroup returns the resource group reference of a request body, or of the default resource group.
+func (b *Backend) resourceGroup(body object) object {
+	if id := body.str("resource_group", "id"); id != "" {
+		return object{"id": id}
+	}
+	if rg, ok := b.findOne(kindResourceGroup, func(o object) bool { return o["default"] == true }); ok {
+		return object{"id": rg["id"], "name": rg["name"]}
+	}
+	return object{"id": "default"}
+}
+
+// conflict returns the response and the error of a call which conflicts with the state of a resource.
+func conflict(format string, args ...any) (*core.DetailedResponse, error) {
+	return response(http.StatusConflict), fmt.Errorf(format, args...)
+}
+
+// hasReference returns whether the list of references in the field of an object contains the resource with the ID.
+func hasReference(o object, key, id string) bool {
+	refs, _ := o[key].([]any)
+	for _, ref := range refs {
+		if toObject(ref).str("id") == id {
+			return true
+		}
+	}
+	return false
+}
+
+// setField returns a function setting a field of an object, to be used as a state transition.
+func setField(key string, value any) func(object) {
+	return func(o object) {
+		o[key] = value
+	}
+}
+
+// CreateVPC creates a VPC, with its default security group and an address prefix per zone unless the address
+// prefixes are managed manually.
+func (c *vpcClient) CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options)
+	o := c.vpcResource("vpc", "vpcs", body.str("name"))
+	o["status"] = "pending"
+	o["classic_access"] = false
+	o["health_state"] = "ok"
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["default_network_acl"] = object{"id": c.b.newID("acl")}
+	o["default_routing_table"] = object{"id": c.b.newID("rtb")}
+
+	sg := c.vpcResource("security-group", "security_groups", fmt.Sprintf("%s-default", o["name"]))
+	sg["vpc"] = reference(o)
+	sg["resource_group"] = o["resource_group"]
+	sg["rules"] = []any{}
+	c.b.insert(kindSecurityGroup, sg.str("id"), sg, nil)
+	o["default_security_group"] = reference(sg)
+
+	if body.str("address_prefix_management") != vpcv1.CreateVPCOptionsAddressPrefixManagementManualConst {
+		for i, zone := range c.b.zones() {
+			prefix := c.vpcResource("address-prefix", "address_prefixes", "")
+			prefix["cidr"] = fmt.Sprintf("10.240.%d.0/18", i*64)
+			prefix["zone"] = object{"name": zone}
+			prefix["is_default"] = true
+			prefix["_vpc_id"] = o["id"]
+			c.b.insert(kindAddressPrefix, prefix.str("id"), prefix, nil)
+		}
+	}
+
+	c.b.insert(kindVPC, o.str("id"), o, setField("status", "available"))
+	result, err := vpcModel[vpcv1.VPC](o, vpcv1.UnmarshalVPC)
+	return result, response(http.StatusCreated), err
+}
+
+// DeleteVPC deletes a VPC, which must not have subnets.
+func (c *vpcClient) DeleteVPC(options *vpcv1.DeleteVPCOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindVPC, id); !ok {
+		return notFound(kindVPC, id)
+	}
+	if subnets := c.b.find(kindSubnet, func(o object) bool { return o.str("vpc", "id") == id }); len(subnets) > 0 {
+		return conflict("the VPC %s still has %d subnets", id, len(subnets))
+	}
+	c.b.remove(kindVPC, id, setField("status", "deleting"))
+	for _, sg := range c.b.find(kindSecurityGroup, func(o object) bool { return o.str("vpc", "id") == id }) {
+		c.b.removeNow(kindSecurityGroup, sg.str("id"))
+	}
+	for _, prefix := range c.b.find(kindAddressPrefix, func(o object) bool { return o.str("_vpc_id") == id }) {
+		c.b.removeNow(kindAddressPrefix, prefix.str("id"))
+	}
+	return response(http.StatusNoContent), nil
+}
+
+// ListVpcs lists the VPCs.
+func (c *vpcClient) ListVpcs(options *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	rg := ""
+	if options != nil && options.ResourceGroupID != nil {
+		rg = *options.ResourceGroupID
+	}
+	objs := c.b.find(kindVPC, func(o object) bool { return rg == "" || o.str("resource_group", "id") == rg })
+	return vpcCollection[vpcv1.VPCCollection]("vpcs", objs, vpcv1.UnmarshalVPCCollection)
+}
+
+// GetVPC returns a VPC.
+func (c *vpcClient) GetVPC(options *vpcv1.GetVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVPC, *options.ID)
+	if !ok {
+		resp, err := notFound(kindVPC, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.VPC](o, vpcv1.UnmarshalVPC)
+	return result, response(http.StatusOK), err
+}
+
+// GetVPCByName returns the VPC with the given name, or nil if not found.
+func (c *vpcClient) GetVPCByName(vpcName string) (*vpcv1.VPC, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindVPC, withName(vpcName))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.VPC](o, vpcv1.UnmarshalVPC)
+}
+
+// ListVPCAddressPrefixes lists the address prefixes of a VPC.
+func (c *vpcClient) ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (*vpcv1.AddressPrefixCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.VPCID
+	if _, ok := c.b.get(kindVPC, id); !ok {
+		resp, err := notFound(kindVPC, id)
+		return nil, resp, err
+	}
+	objs := c.b.find(kindAddressPrefix, func(o object) bool { return o.str("_vpc_id") == id })
+	return vpcCollection[vpcv1.AddressPrefixCollection]("address_prefixes", objs, vpcv1.UnmarshalAddressPrefixCollection)
+}
+
+// GetVPCZonesByRegion returns the zones of the region of the backend.
+func (c *vpcClient) GetVPCZonesByRegion(region string) ([]string, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if region != c.b.region {
+		return nil, fmt.Errorf("region %q not found", region)
+	}
+	return c.b.zones(), nil
+}
+
+// zones returns the zones of the region of the backend.
+func (b *Backend) zones() []string {
+	return []string{b.region + "-1", b.region + "-2", b.region + "-3"}
+}
+
+// CreateSubnet creates a subnet, its CIDR is allocated in the address prefix of its zone if not set.
+func (c *vpcClient) CreateSubnet(options *vpcv1.CreateSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options.SubnetPrototype)
+	vpcObj, ok := c.b.resolve(kindVPC, body["vpc"])
+	if !ok {
+		resp, err := notFound(kindVPC, body.str("vpc", "id"))
+		return nil, resp, err
+	}
+	zone := body.str("zone", "name")
+	cidr := body.str("ipv4_cidr_block")
+	if cidr == "" {
+		zoneIndex := 0
+		for i, z := range c.b.zones() {
+			if z == zone {
+				zoneIndex = i
+			}
+		}
+		count := len(c.b.find(kindSubnet, func(o object) bool { return o.str("zone", "name") == zone }))
+		cidr = fmt.Sprintf("10.240.%d.0/24", zoneIndex*64+count)
+	}
+
+	o := c.vpcResource("subnet", "subnets", body.str("name"))
+	o["status"] = "pending"
+	o["vpc"] = reference(vpcObj)
+	o["zone"] = object{"name": zone}
+	o["ipv4_cidr_block"] = cidr
+	o["ip_version"] = "ipv4"
+	o["total_ipv4_address_count"] = 256
+	o["available_ipv4_address_count"] = 251
+	o["resource_group"] = c.b.resourceGroup(body)
+	if pgw, ok := c.b.resolve(kindPublicGateway, body["public_gateway"]); ok {
+		o["public_gateway"] = reference(pgw)
+	}
+	c.b.insert(kindSubnet, o.str("id"), o, setField("status", "available"))
+	result, err := vpcModel[vpcv1.Subnet](o, vpcv1.UnmarshalSubnet)
+	return result, response(http.StatusCreated), err
+}
+
+// DeleteSubnet deletes a subnet, which must not be used by instances or load balancers.
+func (c *vpcClient) DeleteSubnet(options *vpcv1.DeleteSubnetOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindSubnet, id); !ok {
+		return notFound(kindSubnet, id)
+	}
+	if instances := c.b.find(kindInstance, func(o object) bool { return len(networkInterfacesInSubnet(o, id)) > 0 }); len(instances) > 0 {
+		return conflict("the subnet %s is still used by %d instances", id, len(instances))
+	}
+	if vnis := c.b.find(kindVNI, func(o object) bool { return o.str("subnet", "id") == id }); len(vnis) > 0 {
+		return conflict("the subnet %s is still used by %d virtual network interfaces", id, len(vnis))
+	}
+	if reservedIPs := c.b.find(kindReservedIP, func(o object) bool { return o.str("_subnet_id") == id }); len(reservedIPs) > 0 {
+		return conflict("the subnet %s still has %d reserved IPs", id, len(reservedIPs))
+	}
+	if lbs := c.b.find(kindLoadBalancer, func(o object) bool { return hasReference(o, "subnets", id) }); len(lbs) > 0 {
+		return conflict("the subnet %s is still used by %d load balancers", id, len(lbs))
+	}
+	c.b.remove(kindSubnet, id, setField("status", "deleting"))
+	return response(http.StatusNoContent), nil
+}
+
+// ListSubnets lists the subnets.
+func (c *vpcClient) ListSubnets(options *vpcv1.ListSubnetsOptions) (*vpcv1.SubnetCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if options == nil {
+		options = &vpcv1.ListSubnetsOptions{}
+	}
+	objs := c.b.find(kindSubnet, func(o object) bool {
+		return (options.ResourceGroupID == nil || o.str("resource_group", "id") == *options.ResourceGroupID) &&
+			(options.ZoneName == nil || o.str("zone", "name") == *options.ZoneName) &&
+			(options.VPCID == nil || o.str("vpc", "id") == *options.VPCID) &&
+			(options.VPCName == nil || o.str("vpc", "name") == *options.VPCName)
+	})
+	return vpcCollection[vpcv1.SubnetCollection]("subnets", objs, vpcv1.UnmarshalSubnetCollection)
+}
+
+// GetSubnet returns a subnet.
+func (c *vpcClient) GetSubnet(options *vpcv1.GetSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindSubnet, *options.ID)
+	if !ok {
+		resp, err := notFound(kindSubnet, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Subnet](o, vpcv1.UnmarshalSubnet)
+	return result, response(http.StatusOK), err
+}
+
+// GetVPCSubnetByName returns the subnet with the given name, or nil if not found.
+func (c *vpcClient) GetVPCSubnetByName(subnetName string) (*vpcv1.Subnet, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindSubnet, withName(subnetName))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.Subnet](o, vpcv1.UnmarshalSubnet)
+}
+
+// CreatePublicGateway creates a public gateway.
+func (c *vpcClient) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options)
+	vpcObj, ok := c.b.resolve(kindVPC, body["vpc"])
+	if !ok {
+		resp, err := notFound(kindVPC, body.str("vpc", "id"))
+		return nil, resp, err
+	}
+	o := c.vpcResource("public-gateway", "public_gateways", body.str("name"))
+	o["status"] = "pending"
+	o["vpc"] = reference(vpcObj)
+	o["zone"] = object{"name": body.str("zone", "name")}
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["floating_ip"] = object{"id": c.b.newID("fip"), "address": fmt.Sprintf("169.48.0.%d", c.b.nextID%250+1)}
+	o["resource_type"] = "public_gateway"
+	c.b.insert(kindPublicGateway, o.str("id"), o, setField("status", "available"))
+	result, err := vpcModel[vpcv1.PublicGateway](o, vpcv1.UnmarshalPublicGateway)
+	return result, response(http.StatusCreated), err
+}
+
+// DeletePublicGateway deletes a public gateway, which must not be attached to subnets.
+func (c *vpcClient) DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindPublicGateway, id); !ok {
+		return notFound(kindPublicGateway, id)
+	}
+	if subnets := c.b.find(kindSubnet, func(o object) bool { return o.str("public_gateway", "id") == id }); len(subnets) > 0 {
+		return conflict("the public gateway %s is still attached to %d subnets", id, len(subnets))
+	}
+	c.b.remove(kindPublicGateway, id, setField("status", "deleting"))
+	return response(http.StatusNoContent), nil
+}
+
+// GetVPCPublicGatewayByName returns the public gateway with the given name in the resource group, or nil if not found.
+func (c *vpcClient) GetVPCPublicGatewayByName(publicGatewayName string, resourceGroupID string) (*vpcv1.PublicGateway, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindPublicGateway, func(o object) bool {
+		return o.str("name") == publicGatewayName && (resourceGroupID == "" || o.str("resource_group", "id") == resourceGroupID)
+	})
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.PublicGateway](o, vpcv1.UnmarshalPublicGateway)
+}
+
+// GetPublicGateway returns a public gateway.
+func (c *vpcClient) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindPublicGateway, *options.ID)
+	if !ok {
+		resp, err := notFound(kindPublicGateway, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.PublicGateway](o, vpcv1.UnmarshalPublicGateway)
+	return result, response(http.StatusOK), err
+}
+
+// GetSubnetPublicGateway returns the public gateway attached to a subnet.
+func (c *vpcClient) GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	subnet, ok := c.b.get(kindSubnet, *options.ID)
+	if !ok {
+		resp, err := notFound(kindSubnet, *options.ID)
+		return nil, resp, err
+	}
+	o, ok := c.b.get(kindPublicGateway, subnet.str("public_gateway", "id"))
+	if !ok {
+		resp, err := notFound(kindPublicGateway, subnet.str("public_gateway", "id"))
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.PublicGateway](o, vpcv1.UnmarshalPublicGateway)
+	return result, response(http.StatusOK), err
+}
+
+// SetSubnetPublicGateway attaches a public gateway to a subnet.
+func (c *vpcClient) SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	subnet, ok := c.b.get(kindSubnet, *options.ID)
+	if !ok {
+		resp, err := notFound(kindSubnet, *options.ID)
+		return nil, resp, err
+	}
+	o, ok := c.b.resolve(kindPublicGateway, options.PublicGatewayIdentity)
+	if !ok {
+		resp, err := notFound(kindPublicGateway, toObject(options.PublicGatewayIdentity).str("id"))
+		return nil, resp, err
+	}
+	subnet["public_gateway"] = reference(o)
+	result, err := vpcModel[vpcv1.PublicGateway](o, vpcv1.UnmarshalPublicGateway)
+	return result, response(http.StatusCreated), err
+}
+
+// UnsetSubnetPublicGateway detaches the public gateway of a subnet.
+func (c *vpcClient) UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	subnet, ok := c.b.get(kindSubnet, *options.ID)
+	if !ok {
+		return notFound(kindSubnet, *options.ID)
+	}
+	delete(subnet, "public_gateway")
+	return response(http.StatusNoContent), nil
+}
+
+// CreateSecurityGroup creates a security group with its rules.
+func (c *vpcClient) CreateSecurityGroup(options *vpcv1.CreateSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options)
+	vpcObj, ok := c.b.resolve(kindVPC, body["vpc"])
+	if !ok {
+		resp, err := notFound(kindVPC, body.str("vpc", "id"))
+		return nil, resp, err
+	}
+	o := c.vpcResource("security-group", "security_groups", body.str("name"))
+	o["vpc"] = reference(vpcObj)
+	o["resource_group"] = c.b.resourceGroup(body)
+	rules := []any{}
+	for _, rule := range options.Rules {
+		rules = append(rules, c.securityGroupRule(o, rule))
+	}
+	o["rules"] = rules
+	o["targets"] = []any{}
+	c.b.insert(kindSecurityGroup, o.str("id"), o, nil)
+	result, err := vpcModel[vpcv1.SecurityGroup](o, vpcv1.UnmarshalSecurityGroup)
+	return result, response(http.StatusCreated), err
+}
+
+// securityGroupRule returns a new rule of a security group from its prototype.
+func (c *vpcClient) securityGroupRule(sg object, prototype any) object {
+	rule := toObject(prototype)
+	rule["id"] = c.b.newID("rule")
+	rule["href"] = fmt.Sprintf("%s/rules/%s", sg["href"], rule["id"])
+	if _, ok := rule["remote"]; !ok {
+		rule["remote"] = object{"cidr_block": "0.0.0.0/0"}
+	}
+	if _, ok := rule["ip_version"]; !ok {
+		rule["ip_version"] = "ipv4"
+	}
+	return rule
+}
+
+// DeleteSecurityGroup deletes a security group.
+func (c *vpcClient) DeleteSecurityGroup(options *vpcv1.DeleteSecurityGroupOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if !c.b.removeNow(kindSecurityGroup, *options.ID) {
+		return notFound(kindSecurityGroup, *options.ID)
+	}
+	return response(http.StatusNoContent), nil
+}
+
+// ListSecurityGroups lists the security groups.
+func (c *vpcClient) ListSecurityGroups(options *vpcv1.ListSecurityGroupsOptions) (*vpcv1.SecurityGroupCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if options == nil {
+		options = &vpcv1.ListSecurityGroupsOptions{}
+	}
+	objs := c.b.find(kindSecurityGroup, func(o object) bool {
+		return (options.ResourceGroupID == nil || o.str("resource_group", "id") == *options.ResourceGroupID) &&
+			(options.VPCID == nil || o.str("vpc", "id") == *options.VPCID) &&
+			(options.VPCName == nil || o.str("vpc", "name") == *options.VPCName)
+	})
+	return vpcCollection[vpcv1.SecurityGroupCollection]("security_groups", objs, vpcv1.UnmarshalSecurityGroupCollection)
+}
+
+// GetSecurityGroup returns a security group.
+func (c *vpcClient) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindSecurityGroup, *options.ID)
+	if !ok {
+		resp, err := notFound(kindSecurityGroup, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.SecurityGroup](o, vpcv1.UnmarshalSecurityGroup)
+	return result, response(http.StatusOK), err
+}
+
+// GetSecurityGroupByName returns the security group with the given name, or a SecurityGroupByNameNotFound error.
+func (c *vpcClient) GetSecurityGroupByName(name string) (*vpcv1.SecurityGroup, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindSecurityGroup, withName(name))
+	if !ok {
+		return nil, &vpc.SecurityGroupByNameNotFound{Name: name}
+	}
+	return vpcModel[vpcv1.SecurityGroup](o, vpcv1.UnmarshalSecurityGroup)
+}
+
+// CreateSecurityGroupRule adds a rule to a security group.
+func (c *vpcClient) CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	sg, ok := c.b.get(kindSecurityGroup, *options.SecurityGroupID)
+	if !ok {
+		resp, err := notFound(kindSecurityGroup, *options.SecurityGroupID)
+		return nil, resp, err
+	}
+	rule := c.securityGroupRule(sg, options.SecurityGroupRulePrototype)
+	rules, _ := sg["rules"].([]any)
+	sg["rules"] = append(append([]any{}, rules...), rule)
+
+	var result vpcv1.SecurityGroupRuleIntf
+	err := toModel(rule, vpcv1.UnmarshalSecurityGroupRule, &result)
+	return result, response(http.StatusCreated), err
+}
+
+// GetSecurityGroupRule returns a rule of a security group.
+func (c *vpcClient) GetSecurityGroupRule(options *vpcv1.GetSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	sg, ok := c.b.get(kindSecurityGroup, *options.SecurityGroupID)
+	if !ok {
+		resp, err := notFound(kindSecurityGroup, *options.SecurityGroupID)
+		return nil, resp, err
+	}
+	rules, _ := sg["rules"].([]any)
+	for _, r := range rules {
+		rule := toObject(r)
+		if rule.str("id") == *options.ID {
+			var result vpcv1.SecurityGroupRuleIntf
+			err := toModel(rule, vpcv1.UnmarshalSecurityGroupRule, &result)
+			return result, response(http.StatusOK), err
+		}
+	}
+	resp, err := notFound("vpc.securitygroup.rule", *options.ID)
+	return nil, resp, err
+}
+
+// ListSecurityGroupRules lists the rules of a security group.
+func (c *vpcClient) ListSecurityGroupRules(options *vpcv1.ListSecurityGroupRulesOptions) (*vpcv1.SecurityGroupRuleCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	sg, ok := c.b.get(kindSecurityGroup, *options.SecurityGroupID)
+	if !ok {
+		resp, err := notFound(kindSecurityGroup, *options.SecurityGroupID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.SecurityGroupRuleCollection](object{"rules": sg["rules"]}, vpcv1.UnmarshalSecurityGroupRuleCollection)
+	return result, response(http.StatusOK), err
+}
+
+// CreateLoadBalancer creates a load balancer with its pools and listeners.
+func (c *vpcClient) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options)
+	o := c.vpcResource("load-balancer", "load_balancers", body.str("name"))
+	subnets := []any{}
+	for _, identity := range options.Subnets {
+		subnet, ok := c.b.resolve(kindSubnet, identity)
+		if !ok {
+			resp, err := notFound(kindSubnet, toObject(identity).str("id"))
+			return nil, resp, err
+		}
+		subnets = append(subnets, reference(subnet))
+	}
+	securityGroups := []any{}
+	for _, identity := range options.SecurityGroups {
+		sg, ok := c.b.resolve(kindSecurityGroup, identity)
+		if !ok {
+			resp, err := notFound(kindSecurityGroup, toObject(identity).str("id"))
+			return nil, resp, err
+		}
+		securityGroups = append(securityGroups, reference(sg))
+	}
+	o["subnets"] = subnets
+	o["security_groups"] = securityGroups
+	o["is_public"] = body["is_public"] == true
+	o["hostname"] = fmt.Sprintf("%s-%s.lb.appdomain.cloud", o["id"], c.b.region)
+	o["provisioning_status"] = string(vpcv1.LoadBalancerProvisioningStatusCreatePendingConst)
+	o["operating_status"] = string(vpcv1.LoadBalancerOperatingStatusOfflineConst)
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["private_ips"] = []any{object{"address": fmt.Sprintf("10.240.255.%d", c.b.nextID%250+1)}}
+
+	pools := []any{}
+	for _, prototype := range options.Pools {
+		pool := toObject(prototype)
+		for key, value := range c.vpcResource("load-balancer-pool", "pools", pool.str("name")) {
+			pool[key] = value
+		}
+		pool["_load_balancer_id"] = o["id"]
+		pool["members"] = []any{}
+		pool["provisioning_status"] = "active"
+		c.b.insert(kindLBPool, pool.str("id"), pool, nil)
+		pools = append(pools, reference(pool))
+	}
+	o["pools"] = pools
+
+	listeners := []any{}
+	for _, prototype := range options.Listeners {
+		listener := toObject(prototype)
+		listener["id"] = c.b.newID("listener")
+		listener["href"] = fmt.Sprintf("%s/listeners/%s", o["href"], listener["id"])
+		listener["provisioning_status"] = "active"
+		listener["_load_balancer_id"] = o["id"]
+		if name := listener.str("default_pool", "name"); name != "" {
+			if pool, ok := c.b.findOne(kindLBPool, func(p object) bool { return p.str("_load_balancer_id") == o.str("id") && p.str("name") == name }); ok {
+				listener["default_pool"] = reference(pool)
+			}
+		}
+		c.b.insert(kindLBListener, listener.str("id"), listener, nil)
+		listeners = append(listeners, object{"id": listener["id"], "href": listener["href"]})
+	}
+	o["listeners"] = listeners
+
+	c.b.insert(kindLoadBalancer, o.str("id"), o, func(o object) {
+		o["provisioning_status"] = string(vpcv1.LoadBalancerProvisioningStatusActiveConst)
+		o["operating_status"] = string(vpcv1.LoadBalancerOperatingStatusOnlineConst)
+	})
+	result, err := vpcModel[vpcv1.LoadBalancer](o, vpcv1.UnmarshalLoadBalancer)
+	return result, response(http.StatusCreated), err
+}
+
+// DeleteLoadBalancer deletes a load balancer with its pools and listeners.
+func (c *vpcClient) DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindLoadBalancer, id); !ok {
+		return notFound(kindLoadBalancer, id)
+	}
+	c.b.remove(kindLoadBalancer, id, setField("provisioning_status", string(vpcv1.LoadBalancerProvisioningStatusDeletePendingConst)))
+	for _, kind := range []string{kindLBPool, kindLBListener, kindLBPoolMember} {
+		for _, o := range c.b.find(kind, func(o object) bool { return o.str("_load_balancer_id") == id }) {
+			c.b.removeNow(kind, o.str("id"))
+		}
+	}
+	return response(http.StatusAccepted), nil
+}
+
+// ListLoadBalancers lists the load balancers.
+func (c *vpcClient) ListLoadBalancers(_ *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	return vpcCollection[vpcv1.LoadBalancerCollection]("load_balancers", c.b.find(kindLoadBalancer, nil), vpcv1.UnmarshalLoadBalancerCollection)
+}
+
+// GetLoadBalancer returns a load balancer.
+func (c *vpcClient) GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindLoadBalancer, *options.ID)
+	if !ok {
+		resp, err := notFound(kindLoadBalancer, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.LoadBalancer](o, vpcv1.UnmarshalLoadBalancer)
+	return result, response(http.StatusOK), err
+}
+
+// GetLoadBalancerByName returns the load balancer with the given name, or nil if not found.
+func (c *vpcClient) GetLoadBalancerByName(loadBalancerName string) (*vpcv1.LoadBalancer, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindLoadBalancer, withName(loadBalancerName))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.LoadBalancer](o, vpcv1.UnmarshalLoadBalancer)
+}
+
+// GetLoadBalancerPoolByName returns the pool of a load balancer with the given name, or nil if not found.
+func (c *vpcClient) GetLoadBalancerPoolByName(loadBalancerID string, poolName string) (*vpcv1.LoadBalancerPool, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, ok := c.b.get(kindLoadBalancer, loadBalancerID); !ok {
+		_, err := notFound(kindLoadBalancer, loadBalancerID)
+		return nil, fmt.Errorf("error listing pools for load balancer %s: %w", loadBalancerID, err)
+	}
+	o, ok := c.b.findOne(kindLBPool, func(o object) bool {
+		return o.str("_load_balancer_id") == loadBalancerID && o.str("name") == poolName
+	})
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.LoadBalancerPool](o, vpcv1.UnmarshalLoadBalancerPool)
+}
+
+// GetLoadBalancerListener returns a listener of a load balancer.
+func (c *vpcClient) GetLoadBalancerListener(options *vpcv1.GetLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindLBListener, *options.ID)
+	if !ok || o.str("_load_balancer_id") != *options.LoadBalancerID {
+		resp, err := notFound(kindLBListener, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.LoadBalancerListener](o, vpcv1.UnmarshalLoadBalancerListener)
+	return result, response(http.StatusOK), err
+}
+
+// loadBalancerPool returns the pool of a load balancer and the load balancer, whose update must not be pending.
+func (c *vpcClient) loadBalancerPool(loadBalancerID, poolID string) (object, object, *core.DetailedResponse, error) {
+	lb, ok := c.b.get(kindLoadBalancer, loadBalancerID)
+	if !ok {
+		resp, err := notFound(kindLoadBalancer, loadBalancerID)
+		return nil, nil, resp, err
+	}
+	pool, ok := c.b.get(kindLBPool, poolID)
+	if !ok || pool.str("_load_balancer_id") != loadBalancerID {
+		resp, err := notFound(kindLBPool, poolID)
+		return nil, nil, resp, err
+	}
+	return lb, pool, nil, nil
+}
+
+// updateLoadBalancer marks a load balancer as being updated until the provisioning delay elapsed.
+func (c *vpcClient) updateLoadBalancer(lb object) {
+	lb["provisioning_status"] = string(vpcv1.LoadBalancerProvisioningStatusUpdatePendingConst)
+	c.b.transition(kindLoadBalancer, lb.str("id"), setField("provisioning_status", string(vpcv1.LoadBalancerProvisioningStatusActiveConst)))
+}
+
+// CreateLoadBalancerPoolMember adds a member to a pool of a load balancer, which must be active.
+func (c *vpcClient) CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	lb, pool, resp, err := c.loadBalancerPool(*options.LoadBalancerID, *options.PoolID)
+	if err != nil {
+		return nil, resp, err
+	}
+	if lb.str("provisioning_status") != string(vpcv1.LoadBalancerProvisioningStatusActiveConst) {
+		resp, err := conflict("the load balancer %s is in %s state", lb["id"], lb["provisioning_status"])
+		return nil, resp, err
+	}
+
+	o := toObject(options)
+	delete(o, "load_balancer_id")
+	delete(o, "pool_id")
+	o["id"] = c.b.newID("member")
+	o["href"] = fmt.Sprintf("%s/members/%s", pool["href"], o["id"])
+	o["health"] = "unknown"
+	o["provisioning_status"] = "create_pending"
+	o["_load_balancer_id"] = lb["id"]
+	o["_pool_id"] = pool["id"]
+	if _, ok := o["weight"]; !ok {
+		o["weight"] = 50
+	}
+	c.b.insert(kindLBPoolMember, o.str("id"), o, func(o object) {
+		o["health"] = "ok"
+		o["provisioning_status"] = "active"
+	})
+	members, _ := pool["members"].([]any)
+	pool["members"] = append(append([]any{}, members...), object{"id": o["id"], "href": o["href"]})
+	c.updateLoadBalancer(lb)
+
+	result, err := vpcModel[vpcv1.LoadBalancerPoolMember](o, vpcv1.UnmarshalLoadBalancerPoolMember)
+	return result, response(http.StatusCreated), err
+}
+
+// DeleteLoadBalancerPoolMember removes a member from a pool of a load balancer, which must be active.
+func (c *vpcClient) DeleteLoadBalancerPoolMember(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	lb, pool, resp, err := c.loadBalancerPool(*options.LoadBalancerID, *options.PoolID)
+	if err != nil {
+		return resp, err
+	}
+	if member, ok := c.b.get(kindLBPoolMember, *options.ID); !ok || member.str("_pool_id") != *options.PoolID {
+		return notFound(kindLBPoolMember, *options.ID)
+	}
+	if lb.str("provisioning_status") != string(vpcv1.LoadBalancerProvisioningStatusActiveConst) {
+		return conflict("the load balancer %s is in %s state", lb["id"], lb["provisioning_status"])
+	}
+	c.b.removeNow(kindLBPoolMember, *options.ID)
+	members := []any{}
+	for _, ref := range pool["members"].([]any) {
+		if toObject(ref).str("id") != *options.ID {
+			members = append(members, ref)
+		}
+	}
+	pool["members"] = members
+	c.updateLoadBalancer(lb)
+	return response(http.StatusNoContent), nil
+}
+
+// ListLoadBalancerPoolMembers lists the members of a pool of a load balancer.
+func (c *vpcClient) ListLoadBalancerPoolMembers(options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, _, resp, err := c.loadBalancerPool(*options.LoadBalancerID, *options.PoolID); err != nil {
+		return nil, resp, err
+	}
+	objs := c.b.find(kindLBPoolMember, func(o object) bool { return o.str("_pool_id") == *options.PoolID })
+	return vpcCollection[vpcv1.LoadBalancerPoolMemberCollection]("members", objs, vpcv1.UnmarshalLoadBalancerPoolMemberCollection)
+}
+
+// ListKeys lists the SSH keys.
+func (c *vpcClient) ListKeys(options *vpcv1.ListKeysOptions) (*vpcv1.KeyCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	objs := c.b.find(kindKey, func(o object) bool {
+		return options == nil || options.ResourceGroupID == nil || o.str("resource_group", "id") == *options.ResourceGroupID
+	})
+	return vpcCollection[vpcv1.KeyCollection]("keys", objs, vpcv1.UnmarshalKeyCollection)
+}
+
+// CreateImage creates a custom image, e.g. imported from a COS bucket.
+func (c *vpcClient) CreateImage(options *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options.ImagePrototype)
+	o := c.vpcResource("image", "images", body.str("name"))
+	o["status"] = "pending"
+	o["visibility"] = "private"
+	o["resource_group"] = c.b.resourceGroup(body)
+	if os := body.str("operating_system", "name"); os != "" {
+		o["operating_system"] = object{"name": os}
+	}
+	c.b.insert(kindImage, o.str("id"), o, setField("status", "available"))
+	result, err := vpcModel[vpcv1.Image](o, vpcv1.UnmarshalImage)
+	return result, response(http.StatusCreated), err
+}
+
+// ListImages lists the images.
+func (c *vpcClient) ListImages(options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if options == nil {
+		options = &vpcv1.ListImagesOptions{}
+	}
+	objs := c.b.find(kindImage, func(o object) bool {
+		return (options.Name == nil || o.str("name") == *options.Name) &&
+			(options.ResourceGroupID == nil || o.str("resource_group", "id") == *options.ResourceGroupID) &&
+			(options.Visibility == nil || o.str("visibility") == *options.Visibility)
+	})
+	return vpcCollection[vpcv1.ImageCollection]("images", objs, vpcv1.UnmarshalImageCollection)
+}
+
+// GetImage returns an image.
+func (c *vpcClient) GetImage(options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindImage, *options.ID)
+	if !ok {
+		resp, err := notFound(kindImage, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Image](o, vpcv1.UnmarshalImage)
+	return result, response(http.StatusOK), err
+}
+
+// GetImageByName returns the image with the given name, or nil if not found.
+func (c *vpcClient) GetImageByName(imageName string) (*vpcv1.Image, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindImage, withName(imageName))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.Image](o, vpcv1.UnmarshalImage)
+}
+
+// GetInstanceProfile returns an instance profile, whose vCPU count and memory are read from its name.
+func (c *vpcClient) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	match := instanceProfileRegexp.FindStringSubmatch(*options.Name)
+	if match == nil {
+		resp, err := notFound("vpc.instance.profile", *options.Name)
+		return nil, resp, err
+	}
+	vcpu, _ := strconv.Atoi(match[1])
+	memory, _ := strconv.Atoi(match[2])
+	o := object{
+		"name":       *options.Name,
+		"href":       fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1/instance/profiles/%s", c.b.region, *options.Name),
+		"family":     "balanced",
+		"vcpu_count": object{"type": "fixed", "value": vcpu},
+		"memory":     object{"type": "fixed", "value": memory},
+		"secure_boot_modes": object{
+			"type":    "enum",
+			"default": false,
+			"values":  []any{false, true},
+		},
+		"confidential_compute_modes": object{
+			"type":    "enum",
+			"default": vpcv1.InstanceConfidentialComputeModeDisabledConst,
+			"values":  confidentialComputeModes(*options.Name),
+		},
+	}
+	result, err := vpcModel[vpcv1.InstanceProfile](o, vpcv1.UnmarshalInstanceProfile)
+	return result, response(http.StatusOK), err
+}
+
+// confidentialComputeModes returns the confidential compute modes supported by an instance profile. Only the profiles
+// of the confidential computing families, e.g. bx3dc-2x10, support SGX and TDX.
+func confidentialComputeModes(profile string) []any {
+	modes := []any{vpcv1.InstanceConfidentialComputeModeDisabledConst}
+	if family, _, _ := strings.Cut(profile, "-"); strings.HasSuffix(family, "dc") {
+		modes = append(modes, vpcv1.InstanceConfidentialComputeModeSgxConst, vpcv1.InstanceConfidentialComputeModeTdxConst)
+	}
+	return modes
+}
+
+// GetDedicatedHostByName returns nil as the backend has no dedicated hosts.
+func (c *vpcClient) GetDedicatedHostByName(_ string) (*vpcv1.DedicatedHost, error) {
+	return nil, nil
+}
+
+// CreateInstance creates an instance with its boot volume.
+func (c *vpcClient) CreateInstance(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, resp, err := c.createInstance(toObject(options.InstancePrototype))
+	if err != nil {
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Instance](o, vpcv1.UnmarshalInstance)
+	return result, response(http.StatusCreated), err
+}
+
+// createInstance creates an instance from the JSON representation of its prototype.
+func (c *vpcClient) createInstance(body object) (object, *core.DetailedResponse, error) {
+	o := c.vpcResource("instance", "instances", body.str("name"))
+
+	var networkInterfaces, networkAttachments []any
+	var vnis []object
+	if primaryAttachment, ok := body["primary_network_attachment"].(map[string]any); ok {
+		additionalAttachments, _ := body["network_attachments"].([]any)
+		for i, prototype := range append([]any{primaryAttachment}, additionalAttachments...) {
+			vni, resp, err := c.virtualNetworkInterface(toObject(prototype)["virtual_network_interface"], vnis)
+			if err != nil {
+				return nil, resp, err
+			}
+			attachment := object{
+				"id":                        c.b.newID("attachment"),
+				"name":                      fmt.Sprintf("eth%d", i),
+				"type":                      "secondary",
+				"subnet":                    vni["subnet"],
+				"primary_ip":                vni["primary_ip"],
+				"virtual_network_interface": reference(vni),
+			}
+			if i == 0 {
+				attachment["type"] = "primary"
+			}
+			vnis = append(vnis, vni)
+			networkAttachments = append(networkAttachments, attachment)
+			// The network interfaces of an instance with network attachments are their read-only representation.
+			networkInterfaces = append(networkInterfaces, object{
+				"id":         attachment["id"],
+				"name":       attachment["name"],
+				"subnet":     vni["subnet"],
+				"primary_ip": vni["primary_ip"],
+			})
+		}
+	} else {
+		primaryInterface, _ := body["primary_network_interface"].(map[string]any)
+		subnet, ok := c.b.resolve(kindSubnet, object(primaryInterface)["subnet"])
+		if !ok {
+			resp, err := notFound(kindSubnet, object(primaryInterface).str("subnet", "id"))
+			return nil, resp, err
+		}
+		networkInterface, resp, err := c.newNetworkInterface("eth0", subnet, object(primaryInterface)["primary_ip"], nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		networkInterfaces = append(networkInterfaces, networkInterface)
+		additionalInterfaces, _ := body["network_interfaces"].([]any)
+		for i, additionalInterface := range additionalInterfaces {
+			additionalSubnet, ok := c.b.resolve(kindSubnet, toObject(additionalInterface)["subnet"])
+			if !ok {
+				resp, err := notFound(kindSubnet, toObject(additionalInterface).str("subnet", "id"))
+				return nil, resp, err
+			}
+			networkInterface, resp, err := c.newNetworkInterface(fmt.Sprintf("eth%d", i+1), additionalSubnet, toObject(additionalInterface)["primary_ip"], networkInterfaces)
+			if err != nil {
+				return nil, resp, err
+			}
+			networkInterfaces = append(networkInterfaces, networkInterface)
+		}
+	}
+	networkInterface := toObject(networkInterfaces[0])
+	subnet, _ := c.b.get(kindSubnet, networkInterface.str("subnet", "id"))
+	vpcObj, ok := c.b.get(kindVPC, subnet.str("vpc", "id"))
+	if !ok {
+		resp, err := notFound(kindVPC, subnet.str("vpc", "id"))
+		return nil, resp, err
+	}
+	if image := body.str("image", "id"); image != "" {
+		if _, ok := c.b.get(kindImage, image); !ok {
+			resp, err := notFound(kindImage, image)
+			return nil, resp, err
+		}
+		o["image"] = object{"id": image}
+	}
+
+	bootVolumePrototype := toObject(toObject(body["boot_volume_attachment"])["volume"])
+	var snapshot object
+	if identity, ok := bootVolumePrototype["source_snapshot"]; ok {
+		if snapshot, ok = c.b.resolve(kindSnapshot, identity); !ok {
+			resp, err := notFound(kindSnapshot, toObject(identity).str("id"))
+			return nil, resp, err
+		}
+		if snapshot["bootable"] != true {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("the snapshot %s is not bootable", snapshot["id"])
+		}
+		minimumCapacity, _ := toObject(snapshot)["minimum_capacity"].(float64)
+		if capacity, ok := bootVolumePrototype["capacity"].(float64); ok && capacity < minimumCapacity {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("the capacity of the boot volume cannot be smaller than the snapshot %s", snapshot["id"])
+		}
+	}
+
+	confidentialComputeMode := vpcv1.InstanceConfidentialComputeModeDisabledConst
+	if mode := body.str("confidential_compute_mode"); mode != "" {
+		if !slices.Contains(confidentialComputeModes(body.str("profile", "name")), any(mode)) {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("the confidential compute mode %s is not supported by the instance profile %s", mode, body.str("profile", "name"))
+		}
+		confidentialComputeMode = mode
+	}
+
+	reservation, resp, err := c.reserveCapacity(body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	vcpu, memory := 2, 8
+	if match := instanceProfileRegexp.FindStringSubmatch(body.str("profile", "name")); match != nil {
+		vcpu, _ = strconv.Atoi(match[1])
+		memory, _ = strconv.Atoi(match[2])
+	}
+
+	bootVolume := c.vpcResource("volume", "volumes", fmt.Sprintf("%s-boot", o["name"]))
+	bootVolume["status"] = "available"
+	bootVolume["capacity"] = 100
+	bootVolume["profile"] = object{"name": "general-purpose"}
+	if snapshot != nil {
+		bootVolume["capacity"] = snapshot["minimum_capacity"]
+		bootVolume["source_snapshot"] = reference(snapshot)
+	}
+	for key, value := range bootVolumePrototype {
+		if key == "capacity" || key == "iops" || key == "profile" {
+			bootVolume[key] = value
+		}
+	}
+	bootVolume["_tags"] = userTags(bootVolumePrototype)
+	bootVolume["zone"] = object{"name": body.str("zone", "name")}
+	c.b.insert(kindVolume, bootVolume.str("id"), bootVolume, nil)
+	bootAttachment := object{"id": c.b.newID("attachment"), "name": fmt.Sprintf("%s-boot", o["name"]), "volume": reference(bootVolume)}
+
+	o["status"] = vpcv1.InstanceStatusPendingConst
+	o["health_state"] = "inapplicable"
+	availabilityPolicy := object{"host_failure": "restart", "preemption": "stop"}
+	for key, value := range toObject(body["availability_policy"]) {
+		availabilityPolicy[key] = value
+	}
+	o["availability_policy"] = availabilityPolicy
+	o["availability"] = object{"class": vpcv1.InstanceAvailabilityClassStandardConst}
+	if class := body.str("availability", "class"); class != "" {
+		o["availability"] = object{"class": class}
+	}
+	o["lifecycle_state"] = "pending"
+	o["enable_secure_boot"] = body["enable_secure_boot"] == true
+	o["confidential_compute_mode"] = confidentialComputeMode
+	metadataService := object{"enabled": false, "protocol": "http", "response_hop_limit": 1}
+	for key, value := range toObject(body["metadata_service"]) {
+		metadataService[key] = value
+	}
+	o["metadata_service"] = metadataService
+	o["zone"] = object{"name": body.str("zone", "name")}
+	o["vpc"] = reference(vpcObj)
+	o["profile"] = object{"name": body.str("profile", "name")}
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["primary_network_interface"] = networkInterface
+	o["network_interfaces"] = networkInterfaces
+	if len(networkAttachments) > 0 {
+		o["primary_network_attachment"] = networkAttachments[0]
+		o["network_attachments"] = networkAttachments
+		for i, vni := range vnis {
+			attachment := toObject(networkAttachments[i])
+			vni["target"] = object{"id": attachment["id"], "name": attachment["name"], "resource_type": "instance_network_attachment"}
+			vni["_instance_id"] = o["id"]
+			if _, ok := c.b.get(kindVNI, vni.str("id")); !ok {
+				c.b.insert(kindVNI, vni.str("id"), vni, nil)
+				c.bindReservedIP(vni.str("primary_ip", "id"), object{"id": vni["id"], "name": vni["name"], "resource_type": "virtual_network_interface"})
+			}
+		}
+	} else {
+		for _, networkInterface := range networkInterfaces {
+			nic := toObject(networkInterface)
+			c.bindReservedIP(nic.str("primary_ip", "id"), object{"id": nic["id"], "name": nic["name"], "resource_type": "network_interface"})
+		}
+	}
+	if reservation != nil {
+		o["reservation"] = reference(reservation)
+	}
+	o["boot_volume_attachment"] = bootAttachment
+	o["volume_attachments"] = []any{bootAttachment}
+	o["vcpu"] = object{"architecture": "amd64", "count": vcpu}
+	o["memory"] = memory
+	o["_boot_volume_id"] = bootVolume["id"]
+	c.b.insert(kindInstance, o.str("id"), o, func(o object) {
+		o["status"] = vpcv1.InstanceStatusRunningConst
+		o["health_state"] = "ok"
+		o["lifecycle_state"] = "stable"
+	})
+	return o, nil, nil
+}
+
+// newNetworkInterface returns a network interface of an instance in the subnet, with the primary IP of the prototype
+// given the network interfaces already created for the instance.
+func (c *vpcClient) newNetworkInterface(name string, subnet object, primaryIPPrototype any, created []any) (object, *core.DetailedResponse, error) {
+	primaryIP, resp, err := c.primaryIP(subnet, primaryIPPrototype, networkInterfacesInSubnet(object{"network_interfaces": created}, subnet.str("id")))
+	if err != nil {
+		return nil, resp, err
+	}
+	return object{
+		"id":         c.b.newID("nic"),
+		"name":       name,
+		"subnet":     reference(subnet),
+		"primary_ip": primaryIP,
+	}, nil, nil
+}
+
+// primaryIP returns the primary IP of a network interface in the subnet from the prototype of its primary IP, which
+// either identifies an unbound reserved IP of the subnet or sets the address. Without prototype, it returns the next
+// free address of the subnet given the network interfaces already allocated by the request.
+func (c *vpcClient) primaryIP(subnet object, prototype any, allocated []object) (object, *core.DetailedResponse, error) {
+	body := toObject(prototype)
+	if id := body.str("id"); id != "" {
+		reservedIP, ok := c.b.get(kindReservedIP, id)
+		if !ok || reservedIP.str("_subnet_id") != subnet.str("id") {
+			resp, err := notFound(kindReservedIP, id)
+			return nil, resp, err
+		}
+		if reservedIP["target"] != nil {
+			resp, err := conflict("the reserved IP %s is already bound to %s", id, reservedIP.str("target", "id"))
+			return nil, resp, err
+		}
+		return object{"id": id, "name": reservedIP["name"], "address": reservedIP["address"], "resource_type": "subnet_reserved_ip"}, nil, nil
+	}
+	if address := body.str("address"); address != "" {
+		return object{"address": address}, nil, nil
+	}
+	return c.nextAddress(subnet, allocated), nil, nil
+}
+
+// bindReservedIP binds the reserved IP with the ID, if any, to the target network interface.
+func (c *vpcClient) bindReservedIP(id string, target object) {
+	if reservedIP, ok := c.b.get(kindReservedIP, id); ok {
+		reservedIP["target"] = target
+	}
+}
+
+// nextAddress returns the lowest free address of the subnet, given the network interfaces already allocated by the
+// request. The addresses of the network interfaces of the instances, of the virtual network interfaces and of the
+// reserved IPs are in use.
+func (c *vpcClient) nextAddress(subnet object, allocated []object) object {
+	subnetID := subnet.str("id")
+	used := map[string]bool{}
+	for _, nic := range allocated {
+		used[nic.str("primary_ip", "address")] = true
+	}
+	for _, instance := range c.b.find(kindInstance, func(object) bool { return true }) {
+		for _, nic := range networkInterfacesInSubnet(instance, subnetID) {
+			used[nic.str("primary_ip", "address")] = true
+		}
+	}
+	for _, vni := range c.b.find(kindVNI, func(o object) bool { return o.str("subnet", "id") == subnetID }) {
+		used[vni.str("primary_ip", "address")] = true
+	}
+	for _, reservedIP := range c.b.find(kindReservedIP, func(o object) bool { return o.str("_subnet_id") == subnetID }) {
+		used[reservedIP.str("address")] = true
+	}
+	prefix := subnetNetworkPrefix(subnet.str("ipv4_cidr_block"))
+	for host := 4; ; host++ {
+		if address := fmt.Sprintf("%s.%d", prefix, host); !used[address] {
+			return object{"address": address}
+		}
+	}
+}
+
+// virtualNetworkInterface returns the virtual network interface of a network attachment prototype of an instance,
+// either an existing unattached one or a new one, given the virtual network interfaces already attached by the request.
+// The new virtual network interfaces are not stored until the instance is created.
+func (c *vpcClient) virtualNetworkInterface(prototype any, attached []object) (object, *core.DetailedResponse, error) {
+	body := toObject(prototype)
+	if body.str("subnet", "id") == "" && body.str("subnet", "crn") == "" && body.str("subnet", "href") == "" {
+		vni, ok := c.b.resolve(kindVNI, body)
+		if !ok {
+			resp, err := notFound(kindVNI, body.str("id"))
+			return nil, resp, err
+		}
+		if vni["target"] != nil {
+			resp, err := conflict("the virtual network interface %s is already attached to %s", vni.str("id"), vni.str("target", "id"))
+			return nil, resp, err
+		}
+		return vni, nil, nil
+	}
+
+	subnet, ok := c.b.resolve(kindSubnet, body["subnet"])
+	if !ok {
+		resp, err := notFound(kindSubnet, body.str("subnet", "id"))
+		return nil, resp, err
+	}
+	primaryIP, resp, err := c.primaryIP(subnet, body["primary_ip"], attached)
+	if err != nil {
+		return nil, resp, err
+	}
+	vni := c.vpcResource("virtual_network_interface", "virtual_network_interfaces", body.str("name"))
+	vni["subnet"] = reference(subnet)
+	vni["vpc"] = subnet["vpc"]
+	vni["zone"] = subnet["zone"]
+	vni["primary_ip"] = primaryIP
+	vni["allow_ip_spoofing"] = body["allow_ip_spoofing"] == true
+	vni["enable_infrastructure_nat"] = body["enable_infrastructure_nat"] != false
+	vni["auto_delete"] = body["auto_delete"] != false
+	vni["security_groups"] = body["security_groups"]
+	vni["lifecycle_state"] = "stable"
+	vni["resource_type"] = "virtual_network_interface"
+	return vni, nil, nil
+}
+
+// networkInterfacesInSubnet returns the network interfaces of an instance in the subnet, or all of them if subnetID is empty.
+func networkInterfacesInSubnet(instance object, subnetID string) []object {
+	var result []object
+	networkInterfaces, _ := instance["network_interfaces"].([]any)
+	for _, networkInterface := range networkInterfaces {
+		if nic := toObject(networkInterface); subnetID == "" || nic.str("subnet", "id") == subnetID {
+			result = append(result, nic)
+		}
+	}
+	return result
+}
+
+// subnetNetworkPrefix returns the first three octets of a /24 CIDR.
+func subnetNetworkPrefix(cidr string) string {
+	for i := len(cidr) - 1; i >= 0; i-- {
+		if cidr[i] == '.' {
+			return cidr[:i]
+		}
+	}
+	return "10.240.0"
+}
+
+// DeleteInstance deletes an instance, with its boot volume and the volumes deleted on instance deletion.
+func (c *vpcClient) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if !c.deleteInstance(*options.ID) {
+		return notFound(kindInstance, *options.ID)
+	}
+	return response(http.StatusNoContent), nil
+}
+
+func (c *vpcClient) deleteInstance(id string) bool {
+	o, ok := c.b.get(kindInstance, id)
+	if !ok {
+		return false
+	}
+	c.b.remove(kindInstance, id, func(o object) {
+		o["status"] = vpcv1.InstanceStatusDeletingConst
+		o["lifecycle_state"] = "deleting"
+	})
+	c.b.removeNow(kindVolume, o.str("_boot_volume_id"))
+	if reservation, ok := c.b.get(kindReservation, o.str("reservation", "id")); ok {
+		capacity := reservation["capacity"].(object)
+		capacity["used"] = capacity["used"].(int64) - 1
+		capacity["available"] = capacity["available"].(int64) + 1
+	}
+	targets := map[string]bool{}
+	for _, nic := range networkInterfacesInSubnet(o, "") {
+		targets[nic.str("id")] = true
+	}
+	for _, vni := range c.b.find(kindVNI, func(v object) bool { return v.str("_instance_id") == id }) {
+		if vni["auto_delete"] == true {
+			targets[vni.str("id")] = true
+			c.b.removeNow(kindVNI, vni.str("id"))
+		} else {
+			delete(vni, "target")
+			delete(vni, "_instance_id")
+		}
+	}
+	for _, floatingIP := range c.b.find(kindFloatingIP, func(f object) bool { return targets[f.str("target", "id")] }) {
+		delete(floatingIP, "target")
+	}
+	for _, reservedIP := range c.b.find(kindReservedIP, func(r object) bool { return targets[r.str("target", "id")] }) {
+		if reservedIP["auto_delete"] == true {
+			c.b.removeNow(kindReservedIP, reservedIP.str("id"))
+		} else {
+			delete(reservedIP, "target")
+		}
+	}
+	for _, attachment := range c.b.find(kindVolumeAttachment, func(a object) bool { return a.str("_instance_id") == id }) {
+		c.b.removeNow(kindVolumeAttachment, attachment.str("id"))
+		if attachment["delete_volume_on_instance_delete"] == true {
+			c.b.removeNow(kindVolume, attachment.str("volume", "id"))
+		} else if volume, ok := c.b.get(kindVolume, attachment.str("volume", "id")); ok {
+			volume["attachment_state"] = "unattached"
+		}
+	}
+	return true
+}
+
+// reserveCapacity places an instance in a reservation according to its reservation affinity: the reservation of the
+// pool with the manual policy, which must have available capacity, or any matching reservation with an automatic
+// affinity policy and available capacity.
+func (c *vpcClient) reserveCapacity(body object) (object, *core.DetailedResponse, error) {
+	matches := func(r object) bool {
+		return r.str("status") == vpcv1.ReservationStatusActiveConst && r.str("profile", "name") == body.str("profile", "name") &&
+			r.str("zone", "name") == body.str("zone", "name") && r["capacity"].(object)["available"].(int64) > 0
+	}
+
+	var reservation object
+	affinity := toObject(body["reservation_affinity"])
+	switch affinity.str("policy") {
+	case vpcv1.InstanceReservationAffinityPrototypePolicyManualConst:
+		pool, _ := affinity["pool"].([]any)
+		if len(pool) != 1 {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("the pool of a manual reservation affinity must have one reservation")
+		}
+		var ok bool
+		if reservation, ok = c.b.resolve(kindReservation, pool[0]); !ok {
+			resp, err := notFound(kindReservation, toObject(pool[0]).str("id"))
+			return nil, resp, err
+		}
+		if !matches(reservation) {
+			resp, err := conflict("the reservation %s has no available capacity for the instance", reservation["id"])
+			return nil, resp, err
+		}
+	case vpcv1.InstanceReservationAffinityPrototypePolicyAutomaticConst:
+		automatic, ok := c.b.findOne(kindReservation, func(r object) bool {
+			return r.str("affinity_policy") == vpcv1.ReservationAffinityPolicyAutomaticConst && matches(r)
+		})
+		if !ok {
+			return nil, nil, nil
+		}
+		reservation = automatic
+	default:
+		return nil, nil, nil
+	}
+	capacity := reservation["capacity"].(object)
+	capacity["used"] = capacity["used"].(int64) + 1
+	capacity["available"] = capacity["available"].(int64) - 1
+	return reservation, nil, nil
+}
+
+// GetInstance returns an instance.
+func (c *vpcClient) GetInstance(options *vpcv1.GetInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindInstance, *options.ID)
+	if !ok {
+		resp, err := notFound(kindInstance, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Instance](o, vpcv1.UnmarshalInstance)
+	return result, response(http.StatusOK), err
+}
+
+// ListInstances lists the instances.
+func (c *vpcClient) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if options == nil {
+		options = &vpcv1.ListInstancesOptions{}
+	}
+	objs := c.b.find(kindInstance, func(o object) bool {
+		return (options.Name == nil || o.str("name") == *options.Name) &&
+			(options.ResourceGroupID == nil || o.str("resource_group", "id") == *options.ResourceGroupID) &&
+			(options.VPCID == nil || o.str("vpc", "id") == *options.VPCID) &&
+			(options.VPCName == nil || o.str("vpc", "name") == *options.VPCName)
+	})
+	return vpcCollection[vpcv1.InstanceCollection]("instances", objs, vpcv1.UnmarshalInstanceCollection)
+}
+
+// UpdateInstance updates an instance with a patch. The profile of an instance can only be changed while it is stopped.
+func (c *vpcClient) UpdateInstance(options *vpcv1.UpdateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindInstance, *options.ID)
+	if !ok {
+		resp, err := notFound(kindInstance, *options.ID)
+		return nil, resp, err
+	}
+	patch := toObject(options.InstancePatch)
+	if profile := patch.str("profile", "name"); profile != "" && profile != o.str("profile", "name") {
+		if o.str("status") != vpcv1.InstanceStatusStoppedConst {
+			resp, err := conflict("the profile of the instance %s cannot be changed in %s state", o["id"], o["status"])
+			return nil, resp, err
+		}
+		match := instanceProfileRegexp.FindStringSubmatch(profile)
+		if match == nil {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("invalid instance profile %s", profile)
+		}
+		vcpu, _ := strconv.Atoi(match[1])
+		memory, _ := strconv.Atoi(match[2])
+		o["vcpu"] = object{"architecture": "amd64", "count": vcpu}
+		o["memory"] = memory
+	}
+	for key, value := range patch {
+		o[key] = value
+	}
+	result, err := vpcModel[vpcv1.Instance](o, vpcv1.UnmarshalInstance)
+	return result, response(http.StatusOK), err
+}
+
+// CreateInstanceAction stops, starts or reboots an instance. The instance goes through the stopping or the starting
+// state before it is stopped or running.
+func (c *vpcClient) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindInstance, *options.InstanceID)
+	if !ok {
+		resp, err := notFound(kindInstance, *options.InstanceID)
+		return nil, resp, err
+	}
+	status := o.str("status")
+	switch *options.Type {
+	case vpcv1.CreateInstanceActionOptionsTypeStopConst:
+		if status != vpcv1.InstanceStatusRunningConst {
+			resp, err := conflict("the instance %s cannot be stopped in %s state", o["id"], status)
+			return nil, resp, err
+		}
+		o["status"] = vpcv1.InstanceStatusStoppingConst
+		c.b.transition(kindInstance, o.str("id"), setField("status", vpcv1.InstanceStatusStoppedConst))
+	case vpcv1.CreateInstanceActionOptionsTypeStartConst:
+		if status != vpcv1.InstanceStatusStoppedConst {
+			resp, err := conflict("the instance %s cannot be started in %s state", o["id"], status)
+			return nil, resp, err
+		}
+		o["status"] = vpcv1.InstanceStatusStartingConst
+		o["status_reasons"] = []any{}
+		c.b.transition(kindInstance, o.str("id"), setField("status", vpcv1.InstanceStatusRunningConst))
+	case vpcv1.CreateInstanceActionOptionsTypeRebootConst:
+		if status != vpcv1.InstanceStatusRunningConst {
+			resp, err := conflict("the instance %s cannot be rebooted in %s state", o["id"], status)
+			return nil, resp, err
+		}
+	default:
+		return nil, response(http.StatusBadRequest), fmt.Errorf("invalid instance action type %s", *options.Type)
+	}
+	action := object{
+		"id":     c.b.newID("action"),
+		"href":   fmt.Sprintf("%s/actions", o["href"]),
+		"type":   *options.Type,
+		"status": vpcv1.InstanceActionStatusPendingConst,
+		"force":  ptr.Deref(options.Force, false),
+	}
+	result, err := vpcModel[vpcv1.InstanceAction](action, vpcv1.UnmarshalInstanceAction)
+	return result, response(http.StatusCreated), err
+}
+
+// CreateVolume creates a volume.
+func (c *vpcClient) CreateVolume(options *vpcv1.CreateVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options.VolumePrototype)
+	o := c.vpcResource("volume", "volumes", body.str("name"))
+	for _, key := range []string{"capacity", "iops", "profile", "zone", "user_tags"} {
+		if v, ok := body[key]; ok {
+			o[key] = v
+		}
+	}
+	o["_tags"] = userTags(body)
+	o["status"] = vpcv1.VolumeStatusPendingConst
+	o["attachment_state"] = "unattached"
+	o["resource_group"] = c.b.resourceGroup(body)
+	c.b.insert(kindVolume, o.str("id"), o, setField("status", vpcv1.VolumeStatusAvailableConst))
+	result, err := vpcModel[vpcv1.Volume](o, vpcv1.UnmarshalVolume)
+	return result, response(http.StatusCreated), err
+}
+
+// GetVolume returns a volume.
+func (c *vpcClient) GetVolume(options *vpcv1.GetVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVolume, *options.ID)
+	if !ok {
+		resp, err := notFound(kindVolume, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Volume](o, vpcv1.UnmarshalVolume)
+	return result, response(http.StatusOK), err
+}
+
+// UpdateVolume updates an available volume, whose capacity can only be expanded. The volume is updating until the
+// provisioning delay elapsed.
+func (c *vpcClient) UpdateVolume(options *vpcv1.UpdateVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVolume, *options.ID)
+	if !ok {
+		resp, err := notFound(kindVolume, *options.ID)
+		return nil, resp, err
+	}
+	if o.str("status") != vpcv1.VolumeStatusAvailableConst {
+		resp, err := conflict("the volume %s cannot be updated in %s state", o["id"], o["status"])
+		return nil, resp, err
+	}
+	patch := toObject(options.VolumePatch)
+	if capacity, ok := patch["capacity"].(float64); ok {
+		if current, _ := toObject(o)["capacity"].(float64); capacity < current {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("the capacity of the volume %s cannot be reduced", o["id"])
+		}
+	}
+	for key, value := range patch {
+		o[key] = value
+	}
+	o["status"] = vpcv1.VolumeStatusUpdatingConst
+	c.b.transition(kindVolume, o.str("id"), setField("status", vpcv1.VolumeStatusAvailableConst))
+	result, err := vpcModel[vpcv1.Volume](o, vpcv1.UnmarshalVolume)
+	return result, response(http.StatusOK), err
+}
+
+// AttachVolumeToInstance attaches an available volume to an instance.
+func (c *vpcClient) AttachVolumeToInstance(options *vpcv1.CreateInstanceVolumeAttachmentOptions) (*vpcv1.VolumeAttachment, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	instance, ok := c.b.get(kindInstance, *options.InstanceID)
+	if !ok {
+		resp, err := notFound(kindInstance, *options.InstanceID)
+		return nil, resp, err
+	}
+	volume, ok := c.b.resolve(kindVolume, options.Volume)
+	if !ok {
+		resp, err := notFound(kindVolume, toObject(options.Volume).str("id"))
+		return nil, resp, err
+	}
+	if volume.str("status") != vpcv1.VolumeStatusAvailableConst || volume.str("attachment_state") == "attached" {
+		resp, err := conflict("the volume %s cannot be attached in %s state", volume["id"], volume["status"])
+		return nil, resp, err
+	}
+
+	o := object{
+		"id":                               c.b.newID("attachment"),
+		"name":                             ptrOr(options.Name, volume.str("name")),
+		"type":                             "data",
+		"status":                           "attached",
+		"delete_volume_on_instance_delete": options.DeleteVolumeOnInstanceDelete != nil && *options.DeleteVolumeOnInstanceDelete,
+		"volume":                           reference(volume),
+		"_instance_id":                     instance["id"],
+	}
+	o["href"] = fmt.Sprintf("%s/volume_attachments/%s", instance["href"], o["id"])
+	c.b.insert(kindVolumeAttachment, o.str("id"), o, nil)
+	volume["attachment_state"] = "attached"
+	attachments, _ := instance["volume_attachments"].([]any)
+	instance["volume_attachments"] = append(append([]any{}, attachments...), object{"id": o["id"], "name": o["name"], "volume": o["volume"]})
+
+	result, err := vpcModel[vpcv1.VolumeAttachment](o, vpcv1.UnmarshalVolumeAttachment)
+	return result, response(http.StatusCreated), err
+}
+
+// DetachVolumeFromInstance deletes a data volume attachment of an instance.
+func (c *vpcClient) DetachVolumeFromInstance(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	instance, ok := c.b.get(kindInstance, *options.InstanceID)
+	if !ok {
+		return notFound(kindInstance, *options.InstanceID)
+	}
+	o, ok := c.b.get(kindVolumeAttachment, *options.ID)
+	if !ok || o.str("_instance_id") != *options.InstanceID {
+		return notFound(kindVolumeAttachment, *options.ID)
+	}
+	c.b.removeNow(kindVolumeAttachment, *options.ID)
+	if volume, ok := c.b.get(kindVolume, o.str("volume", "id")); ok {
+		volume["attachment_state"] = "unattached"
+	}
+	attachments, _ := instance["volume_attachments"].([]any)
+	remaining := []any{}
+	for _, attachment := range attachments {
+		if toObject(attachment).str("id") != *options.ID {
+			remaining = append(remaining, attachment)
+		}
+	}
+	instance["volume_attachments"] = remaining
+	return response(http.StatusAccepted), nil
+}
+
+// DeleteVolume deletes a volume, which must not be attached.
+func (c *vpcClient) DeleteVolume(options *vpcv1.DeleteVolumeOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVolume, *options.ID)
+	if !ok {
+		return notFound(kindVolume, *options.ID)
+	}
+	if o.str("attachment_state") == "attached" {
+		return conflict("the volume %s is still attached", *options.ID)
+	}
+	c.b.removeNow(kindVolume, *options.ID)
+	return response(http.StatusNoContent), nil
+}
+
+// ptrOr returns the value of p, or def if p is nil.
+func ptrOr(p *string, def string) string {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// GetVolumeAttachments lists the volume attachments of an instance, including its boot volume.
+func (c *vpcClient) GetVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (*vpcv1.VolumeAttachmentCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	instance, ok := c.b.get(kindInstance, *options.InstanceID)
+	if !ok {
+		resp, err := notFound(kindInstance, *options.InstanceID)
+		return nil, resp, err
+	}
+	boot := toObject(instance["boot_volume_attachment"])
+	boot["type"] = "boot"
+	boot["status"] = "attached"
+	attachments := []any{boot}
+	for _, o := range c.b.find(kindVolumeAttachment, func(a object) bool { return a.str("_instance_id") == *options.InstanceID }) {
+		attachments = append(attachments, o)
+	}
+	result, err := vpcModel[vpcv1.VolumeAttachmentCollection](object{"volume_attachments": attachments}, vpcv1.UnmarshalVolumeAttachmentCollection)
+	return result, response(http.StatusOK), err
+}
+
+// CreateInstanceTemplate creates an instance template.
+func (c *vpcClient) CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o := toObject(options.InstanceTemplatePrototype)
+	for key, value := range c.vpcResource("instance-template", "instance/templates", o.str("name")) {
+		o[key] = value
+	}
+	o["resource_group"] = c.b.resourceGroup(o)
+	c.b.insert(kindInstanceTemplate, o.str("id"), o, nil)
+
+	var result vpcv1.InstanceTemplateIntf
+	err := toModel(o, vpcv1.UnmarshalInstanceTemplate, &result)
+	return result, response(http.StatusCreated), err
+}
+
+// DeleteInstanceTemplate deletes an instance template, which must not be used by an instance group.
+func (c *vpcClient) DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindInstanceTemplate, id); !ok {
+		return notFound(kindInstanceTemplate, id)
+	}
+	if groups := c.b.find(kindInstanceGroup, func(o object) bool { return o.str("instance_template", "id") == id }); len(groups) > 0 {
+		return conflict("the instance template %s is still used by %d instance groups", id, len(groups))
+	}
+	c.b.removeNow(kindInstanceTemplate, id)
+	return response(http.StatusNoContent), nil
+}
+
+// ListInstanceTemplates lists the instance templates.
+func (c *vpcClient) ListInstanceTemplates(_ *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	return vpcCollection[vpcv1.InstanceTemplateCollection]("templates", c.b.find(kindInstanceTemplate, nil), vpcv1.UnmarshalInstanceTemplateCollection)
+}
+
+// GetInstanceTemplateByName returns the instance template with the given name, or nil if not found.
+func (c *vpcClient) GetInstanceTemplateByName(name string) (*vpcv1.InstanceTemplate, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindInstanceTemplate, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	var result vpcv1.InstanceTemplateIntf
+	if err := toModel(o, vpcv1.UnmarshalInstanceTemplate, &result); err != nil {
+		return nil, err
+	}
+	template, _ := result.(*vpcv1.InstanceTemplate)
+	return template, nil
+}
+
+// CreateInstanceGroup creates an instance group, whose membership count is reconciled by creating or deleting
+// instances from its template.
+func (c *vpcClient) CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options)
+	template, ok := c.b.resolve(kindInstanceTemplate, body["instance_template"])
+	if !ok {
+		resp, err := notFound(kindInstanceTemplate, body.str("instance_template", "id"))
+		return nil, resp, err
+	}
+	o := c.vpcResource("instance-group", "instance_groups", body.str("name"))
+	o["instance_template"] = reference(template)
+	o["subnets"] = body["subnets"]
+	o["membership_count"] = body["membership_count"]
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["vpc"] = template["vpc"]
+	o["managers"] = []any{}
+	c.b.insert(kindInstanceGroup, o.str("id"), o, nil)
+	if resp, err := c.scaleInstanceGroup(o); err != nil {
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.InstanceGroup](o, vpcv1.UnmarshalInstanceGroup)
+	return result, response(http.StatusCreated), err
+}
+
+// scaleInstanceGroup creates or deletes the instances of an instance group to match its membership count, the group
+// is scaling until the provisioning delay elapsed.
+func (c *vpcClient) scaleInstanceGroup(group object) (*core.DetailedResponse, error) {
+	id := group.str("id")
+	desired := 0
+	if count, ok := group["membership_count"].(float64); ok {
+		desired = int(count)
+	} else if count, ok := group["membership_count"].(int); ok {
+		desired = count
+	}
+	memberships := c.b.find(kindIGMembership, func(o object) bool { return o.str("_instance_group_id") == id })
+	for i := len(memberships); i < desired; i++ {
+		template, ok := c.b.get(kindInstanceTemplate, group.str("instance_template", "id"))
+		if !ok {
+			return notFound(kindInstanceTemplate, group.str("instance_template", "id"))
+		}
+		body := toObject(template)
+		body["name"] = fmt.Sprintf("%s-%s", group["name"], c.b.newID("member"))
+		if subnets, _ := group["subnets"].([]any); len(subnets) > 0 {
+			body["primary_network_interface"] = map[string]any{"subnet": subnets[i%len(subnets)]}
+		}
+		instance, resp, err := c.createInstance(body)
+		if err != nil {
+			return resp, err
+		}
+		membership := object{
+			"id":                 c.b.newID("membership"),
+			"name":               instance["name"],
+			"status":             "pending",
+			"instance":           reference(instance),
+			"instance_template":  group["instance_template"],
+			"_instance_group_id": id,
+		}
+		c.b.insert(kindIGMembership, membership.str("id"), membership, setField("status", "healthy"))
+	}
+	for i := len(memberships) - 1; i >= desired; i-- {
+		c.b.removeNow(kindIGMembership, memberships[i].str("id"))
+		c.deleteInstance(memberships[i].str("instance", "id"))
+	}
+	group["status"] = "scaling"
+	c.b.transition(kindInstanceGroup, id, setField("status", vpcv1.InstanceGroupStatusHealthyConst))
+	return nil, nil
+}
+
+// GetInstanceGroup returns an instance group.
+func (c *vpcClient) GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindInstanceGroup, *options.ID)
+	if !ok {
+		resp, err := notFound(kindInstanceGroup, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.InstanceGroup](o, vpcv1.UnmarshalInstanceGroup)
+	return result, response(http.StatusOK), err
+}
+
+// GetInstanceGroupByName returns the instance group with the given name, or nil if not found.
+func (c *vpcClient) GetInstanceGroupByName(name string) (*vpcv1.InstanceGroup, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindInstanceGroup, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.InstanceGroup](o, vpcv1.UnmarshalInstanceGroup)
+}
+
+// UpdateInstanceGroup updates an instance group, e.g. its membership count or its instance template.
+func (c *vpcClient) UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindInstanceGroup, *options.ID)
+	if !ok {
+		resp, err := notFound(kindInstanceGroup, *options.ID)
+		return nil, resp, err
+	}
+	for key, value := range toObject(options.InstanceGroupPatch) {
+		if key == "instance_template" {
+			template, ok := c.b.resolve(kindInstanceTemplate, value)
+			if !ok {
+				resp, err := notFound(kindInstanceTemplate, toObject(value).str("id"))
+				return nil, resp, err
+			}
+			value = reference(template)
+		}
+		o[key] = value
+	}
+	if resp, err := c.scaleInstanceGroup(o); err != nil {
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.InstanceGroup](o, vpcv1.UnmarshalInstanceGroup)
+	return result, response(http.StatusOK), err
+}
+
+// DeleteInstanceGroup deletes an instance group, which must not have members.
+func (c *vpcClient) DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	id := *options.ID
+	if _, ok := c.b.get(kindInstanceGroup, id); !ok {
+		return notFound(kindInstanceGroup, id)
+	}
+	if memberships := c.b.find(kindIGMembership, func(o object) bool { return o.str("_instance_group_id") == id }); len(memberships) > 0 {
+		return conflict("the instance group %s still has %d members", id, len(memberships))
+	}
+	c.b.remove(kindInstanceGroup, id, setField("status", vpcv1.InstanceGroupStatusDeletingConst))
+	return response(http.StatusAccepted), nil
+}
+
+// ListInstanceGroupMemberships lists the memberships of an instance group.
+func (c *vpcClient) ListInstanceGroupMemberships(instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if _, ok := c.b.get(kindInstanceGroup, instanceGroupID); !ok {
+		_, err := notFound(kindInstanceGroup, instanceGroupID)
+		return nil, fmt.Errorf("error listing instance group memberships: %w", err)
+	}
+	objs := c.b.find(kindIGMembership, func(o object) bool { return o.str("_instance_group_id") == instanceGroupID })
+	collection, _, err := vpcCollection[vpcv1.InstanceGroupMembershipCollection]("memberships", objs, vpcv1.UnmarshalInstanceGroupMembershipCollection)
+	if err != nil {
+		return nil, err
+	}
+	return collection.Memberships, nil
+}
+
+// DeleteInstanceGroupMembership deletes a membership of an instance group with its instance.
+func (c *vpcClient) DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindIGMembership, *options.ID)
+	if !ok || o.str("_instance_group_id") != *options.InstanceGroupID {
+		return notFound(kindIGMembership, *options.ID)
+	}
+	c.b.removeNow(kindIGMembership, *options.ID)
+	c.deleteInstance(o.str("instance", "id"))
+	if group, ok := c.b.get(kindInstanceGroup, *options.InstanceGroupID); ok {
+		if count, ok := group["membership_count"].(float64); ok && count > 0 {
+			group["membership_count"] = count - 1
+		}
+	}
+	return response(http.StatusNoContent), nil
+}
+
+// GetVirtualNetworkInterface returns a virtual network interface.
+func (c *vpcClient) GetVirtualNetworkInterface(options *vpcv1.GetVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVNI, *options.ID)
+	if !ok {
+		resp, err := notFound(kindVNI, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.VirtualNetworkInterface](o, vpcv1.UnmarshalVirtualNetworkInterface)
+	return result, response(http.StatusOK), err
+}
+
+// ListVirtualNetworkInterfacesByNamePrefix returns the virtual network interfaces with a name starting with the given prefix.
+func (c *vpcClient) ListVirtualNetworkInterfacesByNamePrefix(prefix string) ([]vpcv1.VirtualNetworkInterface, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	var result []vpcv1.VirtualNetworkInterface
+	for _, o := range c.b.find(kindVNI, func(o object) bool { return strings.HasPrefix(o.str("name"), prefix) }) {
+		vni, err := vpcModel[vpcv1.VirtualNetworkInterface](o, vpcv1.UnmarshalVirtualNetworkInterface)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *vni)
+	}
+	return result, nil
+}
+
+// ListVirtualNetworkInterfacesBySubnet returns the virtual network interfaces in the subnet.
+func (c *vpcClient) ListVirtualNetworkInterfacesBySubnet(subnetID string) ([]vpcv1.VirtualNetworkInterface, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	var result []vpcv1.VirtualNetworkInterface
+	for _, o := range c.b.find(kindVNI, func(o object) bool { return o.str("subnet", "id") == subnetID }) {
+		vni, err := vpcModel[vpcv1.VirtualNetworkInterface](o, vpcv1.UnmarshalVirtualNetworkInterface)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *vni)
+	}
+	return result, nil
+}
+
+// UpdateVirtualNetworkInterface updates a virtual network interface, e.g. whether it is deleted with its instance.
+func (c *vpcClient) UpdateVirtualNetworkInterface(options *vpcv1.UpdateVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVNI, *options.ID)
+	if !ok {
+		resp, err := notFound(kindVNI, *options.ID)
+		return nil, resp, err
+	}
+	for key, value := range options.VirtualNetworkInterfacePatch {
+		o[key] = value
+	}
+	result, err := vpcModel[vpcv1.VirtualNetworkInterface](o, vpcv1.UnmarshalVirtualNetworkInterface)
+	return result, response(http.StatusOK), err
+}
+
+// DeleteVirtualNetworkInterfaces deletes a virtual network interface, which must not be attached.
+func (c *vpcClient) DeleteVirtualNetworkInterfaces(options *vpcv1.DeleteVirtualNetworkInterfacesOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindVNI, *options.ID)
+	if !ok {
+		resp, err := notFound(kindVNI, *options.ID)
+		return nil, resp, err
+	}
+	if _, attached := o["target"]; attached {
+		resp, err := conflict("the virtual network interface %s is attached", *options.ID)
+		return nil, resp, err
+	}
+	c.b.remove(kindVNI, *options.ID, setField("lifecycle_state", vpcv1.VirtualNetworkInterfaceLifecycleStateDeletingConst))
+	for _, reservedIP := range c.b.find(kindReservedIP, func(r object) bool { return r.str("target", "id") == *options.ID }) {
+		if reservedIP["auto_delete"] == true {
+			c.b.removeNow(kindReservedIP, reservedIP.str("id"))
+		} else {
+			delete(reservedIP, "target")
+		}
+	}
+	result, err := vpcModel[vpcv1.VirtualNetworkInterface](o, vpcv1.UnmarshalVirtualNetworkInterface)
+	return result, response(http.StatusAccepted), err
+}
+
+// CreateFloatingIP creates a floating IP, bound to its target if set.
+func (c *vpcClient) CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options.FloatingIPPrototype)
+	o := c.vpcResource("floating-ip", "floating_ips", body.str("name"))
+	count := len(c.b.find(kindFloatingIP, func(object) bool { return true }))
+	o["address"] = fmt.Sprintf("169.48.%d.%d", count/250, count%250+2)
+	o["status"] = vpcv1.FloatingIPStatusAvailableConst
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["zone"] = object{"name": body.str("zone", "name")}
+	if body["target"] != nil {
+		target, resp, err := c.floatingIPTarget(toObject(body["target"]).str("id"))
+		if err != nil {
+			return nil, resp, err
+		}
+		o["target"] = target
+		o["zone"] = target["_zone"]
+		delete(target, "_zone")
+	}
+	c.b.insert(kindFloatingIP, o.str("id"), o, nil)
+	result, err := vpcModel[vpcv1.FloatingIP](o, vpcv1.UnmarshalFloatingIP)
+	return result, response(http.StatusCreated), err
+}
+
+// floatingIPTarget returns the reference to the network interface of an instance or the virtual network interface
+// with the ID, to be bound to a floating IP.
+func (c *vpcClient) floatingIPTarget(id string) (object, *core.DetailedResponse, error) {
+	if vni, ok := c.b.get(kindVNI, id); ok {
+		target := reference(vni)
+		target["resource_type"] = "virtual_network_interface"
+		target["_zone"] = vni["zone"]
+		return target, nil, nil
+	}
+	for _, instance := range c.b.find(kindInstance, func(object) bool { return true }) {
+		for _, nic := range networkInterfacesInSubnet(instance, "") {
+			if nic.str("id") == id {
+				return object{
+					"id":            id,
+					"name":          nic["name"],
+					"primary_ip":    nic["primary_ip"],
+					"resource_type": "network_interface",
+					"_zone":         instance["zone"],
+				}, nil, nil
+			}
+		}
+	}
+	resp, err := notFound("vpc.networkinterface", id)
+	return nil, resp, err
+}
+
+// GetFloatingIP returns a floating IP.
+func (c *vpcClient) GetFloatingIP(options *vpcv1.GetFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindFloatingIP, *options.ID)
+	if !ok {
+		resp, err := notFound(kindFloatingIP, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.FloatingIP](o, vpcv1.UnmarshalFloatingIP)
+	return result, response(http.StatusOK), err
+}
+
+// GetFloatingIPByName returns the floating IP with the given name, or nil if not found.
+func (c *vpcClient) GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindFloatingIP, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.FloatingIP](o, vpcv1.UnmarshalFloatingIP)
+}
+
+// UpdateFloatingIP updates a floating IP, e.g. binds it to another target.
+func (c *vpcClient) UpdateFloatingIP(options *vpcv1.UpdateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindFloatingIP, *options.ID)
+	if !ok {
+		resp, err := notFound(kindFloatingIP, *options.ID)
+		return nil, resp, err
+	}
+	for key, value := range toObject(options.FloatingIPPatch) {
+		if key == "target" {
+			target, resp, err := c.floatingIPTarget(toObject(value).str("id"))
+			if err != nil {
+				return nil, resp, err
+			}
+			delete(target, "_zone")
+			value = target
+		}
+		o[key] = value
+	}
+	result, err := vpcModel[vpcv1.FloatingIP](o, vpcv1.UnmarshalFloatingIP)
+	return result, response(http.StatusOK), err
+}
+
+// DeleteFloatingIP deletes a floating IP.
+func (c *vpcClient) DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	if !c.b.removeNow(kindFloatingIP, *options.ID) {
+		return notFound(kindFloatingIP, *options.ID)
+	}
+	return response(http.StatusNoContent), nil
+}
+
+// RemoveInstanceNetworkInterfaceFloatingIP unbinds a floating IP from an instance network interface.
+func (c *vpcClient) RemoveInstanceNetworkInterfaceFloatingIP(options *vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	return c.unbindFloatingIP(*options.ID, *options.NetworkInterfaceID)
+}
+
+// RemoveNetworkInterfaceFloatingIP unbinds a floating IP from a virtual network interface.
+func (c *vpcClient) RemoveNetworkInterfaceFloatingIP(options *vpcv1.RemoveNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	return c.unbindFloatingIP(*options.ID, *options.VirtualNetworkInterfaceID)
+}
+
+// unbindFloatingIP unbinds a floating IP from its target, which has to be the given one.
+func (c *vpcClient) unbindFloatingIP(id, targetID string) (*core.DetailedResponse, error) {
+	o, ok := c.b.get(kindFloatingIP, id)
+	if !ok || o.str("target", "id") != targetID {
+		return notFound(kindFloatingIP, id)
+	}
+	delete(o, "target")
+	return response(http.StatusNoContent), nil
+}
+
+// CreateSubnetReservedIP reserves an address of a subnet, the given one or the lowest free one.
+func (c *vpcClient) CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	subnet, ok := c.b.get(kindSubnet, *options.SubnetID)
+	if !ok {
+		resp, err := notFound(kindSubnet, *options.SubnetID)
+		return nil, resp, err
+	}
+	if ptr.Deref(options.AutoDelete, false) && options.Target == nil {
+		return nil, response(http.StatusBadRequest), fmt.Errorf("auto_delete must be false for an unbound reserved IP")
+	}
+	address := c.nextAddress(subnet, nil).str("address")
+	if options.Address != nil {
+		reserved := func(o object) bool {
+			return o.str("_subnet_id") == *options.SubnetID && o.str("address") == *options.Address
+		}
+		if _, ok := c.b.findOne(kindReservedIP, reserved); ok {
+			resp, err := conflict("the address %s of the subnet %s is already reserved", *options.Address, *options.SubnetID)
+			return nil, resp, err
+		}
+		address = *options.Address
+	}
+	id := c.b.newID("reserved-ip")
+	o := object{
+		"id":              id,
+		"name":            ptr.Deref(options.Name, id),
+		"href":            fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1/subnets/%s/reserved_ips/%s", c.b.region, *options.SubnetID, id),
+		"address":         address,
+		"auto_delete":     ptr.Deref(options.AutoDelete, false),
+		"lifecycle_state": "stable",
+		"owner":           "user",
+		"resource_type":   "subnet_reserved_ip",
+		"_subnet_id":      *options.SubnetID,
+	}
+	c.b.insert(kindReservedIP, id, o, nil)
+	result, err := vpcModel[vpcv1.ReservedIP](o, vpcv1.UnmarshalReservedIP)
+	return result, response(http.StatusCreated), err
+}
+
+// GetSubnetReservedIPByName returns the reserved IP of the subnet with the given name, or nil if not found.
+func (c *vpcClient) GetSubnetReservedIPByName(subnetID string, name string) (*vpcv1.ReservedIP, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindReservedIP, func(o object) bool { return o.str("_subnet_id") == subnetID && o.str("name") == name })
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.ReservedIP](o, vpcv1.UnmarshalReservedIP)
+}
+
+// UpdateSubnetReservedIP updates a reserved IP of a subnet, e.g. whether it is deleted with its target.
+func (c *vpcClient) UpdateSubnetReservedIP(options *vpcv1.UpdateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindReservedIP, *options.ID)
+	if !ok || o.str("_subnet_id") != *options.SubnetID {
+		resp, err := notFound(kindReservedIP, *options.ID)
+		return nil, resp, err
+	}
+	for key, value := range toObject(options.ReservedIPPatch) {
+		o[key] = value
+	}
+	result, err := vpcModel[vpcv1.ReservedIP](o, vpcv1.UnmarshalReservedIP)
+	return result, response(http.StatusOK), err
+}
+
+// DeleteSubnetReservedIP releases a reserved IP of a subnet, which must not be bound.
+func (c *vpcClient) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindReservedIP, *options.ID)
+	if !ok || o.str("_subnet_id") != *options.SubnetID {
+		return notFound(kindReservedIP, *options.ID)
+	}
+	if o["target"] != nil {
+		return conflict("the reserved IP %s is still bound to %s", *options.ID, o.str("target", "id"))
+	}
+	c.b.removeNow(kindReservedIP, *options.ID)
+	return response(http.StatusNoContent), nil
+}
+
+// AddVPCImage adds an available public image to the backend and returns its ID, e.g. a stock image.
+func (b *Backend) AddVPCImage(name string) string {
+	b.lock()
+	defer b.unlock()
+
+	c := &vpcClient{b: b}
+	o := c.vpcResource("image", "images", name)
+	o["status"] = "available"
+	o["visibility"] = "public"
+	o["operating_system"] = object{"name": "ubuntu-24-04-amd64"}
+	b.insert(kindImage, o.str("id"), o, nil)
+	return o.str("id")
+}
+
+// PreemptVPCInstance preempts a running spot instance, which is stopped or deleted according to its availability policy,
+// and returns whether it was preempted.
+func (b *Backend) PreemptVPCInstance(id string) bool {
+	b.lock()
+	defer b.unlock()
+
+	c := &vpcClient{b: b}
+	o, ok := b.get(kindInstance, id)
+	if !ok || o.str("status") != vpcv1.InstanceStatusRunningConst || o.str("availability", "class") != vpcv1.InstanceAvailabilityClassSpotConst {
+		return false
+	}
+	if o.str("availability_policy", "preemption") == vpcv1.InstanceAvailabilityPolicyPreemptionDeleteConst {
+		return c.deleteInstance(id)
+	}
+	o["status"] = vpcv1.InstanceStatusStoppedConst
+	o["status_reasons"] = []any{object{
+		"code":    vpcv1.InstanceStatusReasonCodeStoppedByPreemptionConst,
+		"message": "The instance was preempted",
+	}}
+	return true
+}
+
+// AddReservation adds an active capacity reservation for instances of a profile in a zone to the backend, and returns
+// its ID. affinityPolicy is either automatic or restricted.
+func (b *Backend) AddReservation(name, profile, zone string, total int64, affinityPolicy string) string {
+	b.lock()
+	defer b.unlock()
+
+	c := &vpcClient{b: b}
+	o := c.vpcResource("reservation", "reservations", name)
+	o["affinity_policy"] = affinityPolicy
+	o["status"] = vpcv1.ReservationStatusActiveConst
+	o["lifecycle_state"] = vpcv1.ReservationLifecycleStateStableConst
+	o["profile"] = object{"name": profile, "resource_type": "instance_profile"}
+	o["zone"] = object{"name": zone}
+	o["capacity"] = object{"allocated": total, "available": total, "status": "allocated", "total": total, "used": int64(0)}
+	o["resource_group"] = b.resourceGroup(object{})
+	b.insert(kindReservation, o.str("id"), o, nil)
+	return o.str("id")
+}
+
+// GetReservation returns a capacity reservation.
+func (c *vpcClient) GetReservation(options *vpcv1.GetReservationOptions) (*vpcv1.Reservation, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindReservation, *options.ID)
+	if !ok {
+		resp, err := notFound(kindReservation, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Reservation](o, vpcv1.UnmarshalReservation)
+	return result, response(http.StatusOK), err
+}
+
+// GetReservationByName returns the capacity reservation with the given name, or nil.
+func (c *vpcClient) GetReservationByName(name string) (*vpcv1.Reservation, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindReservation, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.Reservation](o, vpcv1.UnmarshalReservation)
+}
+
+// CreateShare creates a file share, which is pending until the provisioning delay elapsed.
+func (c *vpcClient) CreateShare(options *vpcv1.CreateShareOptions) (*vpcv1.Share, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	body := toObject(options.SharePrototype)
+	if _, ok := c.b.findOne(kindShare, withName(body.str("name"))); ok && body.str("name") != "" {
+		resp, err := conflict("a file share named %s already exists", body.str("name"))
+		return nil, resp, err
+	}
+	o := c.vpcResource("share", "shares", body.str("name"))
+	for _, key := range []string{"size", "iops", "user_tags"} {
+		if v, ok := body[key]; ok {
+			o[key] = v
+		}
+	}
+	o["_tags"] = userTags(body)
+	o["access_control_mode"] = vpcv1.ShareAccessControlModeSecurityGroupConst
+	if mode := body.str("access_control_mode"); mode != "" {
+		o["access_control_mode"] = mode
+	}
+	o["profile"] = object{"name": body.str("profile", "name"), "resource_type": "share_profile"}
+	o["zone"] = object{"name": body.str("zone", "name")}
+	o["lifecycle_state"] = vpcv1.ShareLifecycleStatePendingConst
+	o["resource_group"] = c.b.resourceGroup(body)
+	o["resource_type"] = "share"
+	c.b.insert(kindShare, o.str("id"), o, setField("lifecycle_state", vpcv1.ShareLifecycleStateStableConst))
+	return c.shareModel(o, http.StatusCreated)
+}
+
+// shareModel returns the model of a file share, referencing its mount targets.
+func (c *vpcClient) shareModel(o object, code int) (*vpcv1.Share, *core.DetailedResponse, error) {
+	mountTargets := []any{}
+	for _, mountTarget := range c.b.find(kindShareMountTarget, func(m object) bool { return m.str("_share_id") == o.str("id") }) {
+		mountTargets = append(mountTargets, reference(mountTarget))
+	}
+	o["mount_targets"] = mountTargets
+	result, err := vpcModel[vpcv1.Share](o, vpcv1.UnmarshalShare)
+	return result, response(code), err
+}
+
+// GetShare returns a file share.
+func (c *vpcClient) GetShare(options *vpcv1.GetShareOptions) (*vpcv1.Share, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindShare, *options.ID)
+	if !ok {
+		resp, err := notFound(kindShare, *options.ID)
+		return nil, resp, err
+	}
+	return c.shareModel(o, http.StatusOK)
+}
+
+// GetShareByName returns the file share with the given name, or nil.
+func (c *vpcClient) GetShareByName(name string) (*vpcv1.Share, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindShare, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	result, _, err := c.shareModel(o, http.StatusOK)
+	return result, err
+}
+
+// CreateShareMountTarget creates a mount target of a stable file share, with the access control mode of the file
+// share: a new virtual network interface in a subnet of the zone of the file share with the security group mode, or
+// a single mount target per VPC with the VPC mode. The mount path is set once the mount target is stable.
+func (c *vpcClient) CreateShareMountTarget(options *vpcv1.CreateShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	share, ok := c.b.get(kindShare, *options.ShareID)
+	if !ok {
+		resp, err := notFound(kindShare, *options.ShareID)
+		return nil, resp, err
+	}
+	if share.str("lifecycle_state") != vpcv1.ShareLifecycleStateStableConst {
+		resp, err := conflict("the file share %s cannot be mounted in %s state", share["id"], share["lifecycle_state"])
+		return nil, resp, err
+	}
+	body := toObject(options.ShareMountTargetPrototype)
+	if _, ok := c.b.findOne(kindShareMountTarget, func(m object) bool {
+		return m.str("_share_id") == share.str("id") && m.str("name") == body.str("name")
+	}); ok && body.str("name") != "" {
+		resp, err := conflict("the file share %s already has a mount target named %s", share["id"], body.str("name"))
+		return nil, resp, err
+	}
+
+	o := c.vpcResource("share_mount_target", "shares/"+share.str("id")+"/mount_targets", body.str("name"))
+	o["_share_id"] = share["id"]
+	o["access_control_mode"] = share["access_control_mode"]
+	o["access_protocol"] = vpcv1.ShareMountTargetAccessProtocolNfs4Const
+	o["transit_encryption"] = body.str("transit_encryption")
+	o["lifecycle_state"] = vpcv1.ShareMountTargetLifecycleStatePendingConst
+	o["resource_type"] = "share_mount_target"
+	var mountPath string
+	switch share.str("access_control_mode") {
+	case vpcv1.ShareAccessControlModeVPCConst:
+		vpcObj, ok := c.b.resolve(kindVPC, body["vpc"])
+		if !ok {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("a VPC is required to mount the file share %s", share["id"])
+		}
+		if _, ok := c.b.findOne(kindShareMountTarget, func(m object) bool {
+			return m.str("_share_id") == share.str("id") && m.str("vpc", "id") == vpcObj.str("id")
+		}); ok {
+			resp, err := conflict("the file share %s already has a mount target in the VPC %s", share["id"], vpcObj["id"])
+			return nil, resp, err
+		}
+		o["vpc"] = reference(vpcObj)
+		mountPath = fmt.Sprintf("fsf-%s.adn.networklayer.com:/%s", share.str("zone", "name"), share.str("id"))
+	default:
+		if body["virtual_network_interface"] == nil {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("a virtual network interface is required to mount the file share %s", share["id"])
+		}
+		vni, resp, err := c.virtualNetworkInterface(body["virtual_network_interface"], nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		if vni.str("zone", "name") != share.str("zone", "name") {
+			return nil, response(http.StatusBadRequest), fmt.Errorf("the subnet %s is not in the zone %s of the file share %s", vni.str("subnet", "id"), share.str("zone", "name"), share["id"])
+		}
+		vni["target"] = object{"id": o["id"], "name": o["name"], "resource_type": "share_mount_target"}
+		vni["_share_mount_target_id"] = o["id"]
+		if _, ok := c.b.get(kindVNI, vni.str("id")); !ok {
+			c.b.insert(kindVNI, vni.str("id"), vni, nil)
+		}
+		o["virtual_network_interface"] = reference(vni)
+		o["subnet"] = vni["subnet"]
+		o["primary_ip"] = vni["primary_ip"]
+		o["vpc"] = vni["vpc"]
+		mountPath = fmt.Sprintf("%s:/%s", vni.str("primary_ip", "address"), share.str("id"))
+	}
+	c.b.insert(kindShareMountTarget, o.str("id"), o, func(o object) {
+		o["lifecycle_state"] = vpcv1.ShareMountTargetLifecycleStateStableConst
+		o["mount_path"] = mountPath
+	})
+	result, err := vpcModel[vpcv1.ShareMountTarget](o, vpcv1.UnmarshalShareMountTarget)
+	return result, response(http.StatusCreated), err
+}
+
+// GetShareMountTarget returns a mount target of a file share.
+func (c *vpcClient) GetShareMountTarget(options *vpcv1.GetShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindShareMountTarget, *options.ID)
+	if !ok || o.str("_share_id") != *options.ShareID {
+		resp, err := notFound(kindShareMountTarget, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.ShareMountTarget](o, vpcv1.UnmarshalShareMountTarget)
+	return result, response(http.StatusOK), err
+}
+
+// GetShareMountTargetByName returns the mount target of the file share with the given name, or nil.
+func (c *vpcClient) GetShareMountTargetByName(shareID string, name string) (*vpcv1.ShareMountTarget, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindShareMountTarget, func(m object) bool { return m.str("_share_id") == shareID && m.str("name") == name })
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.ShareMountTarget](o, vpcv1.UnmarshalShareMountTarget)
+}
+
+// DeleteShareMountTarget deletes a mount target of a file share, which is deleting until the provisioning delay
+// elapsed. Its virtual network interface is deleted immediately.
+func (c *vpcClient) DeleteShareMountTarget(options *vpcv1.DeleteShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindShareMountTarget, *options.ID)
+	if !ok || o.str("_share_id") != *options.ShareID {
+		resp, err := notFound(kindShareMountTarget, *options.ID)
+		return nil, resp, err
+	}
+	c.b.removeNow(kindVNI, o.str("virtual_network_interface", "id"))
+	c.b.remove(kindShareMountTarget, *options.ID, setField("lifecycle_state", vpcv1.ShareMountTargetLifecycleStateDeletingConst))
+	result, err := vpcModel[vpcv1.ShareMountTarget](o, vpcv1.UnmarshalShareMountTarget)
+	return result, response(http.StatusAccepted), err
+}
+
+// AddVPCSnapshot adds a stable snapshot of a volume to the backend and returns its ID. A bootable snapshot, e.g. of a
+// boot volume, can be used as the boot source of an instance.
+func (b *Backend) AddVPCSnapshot(name string, minimumCapacity int64, bootable bool) string {
+	b.lock()
+	defer b.unlock()
+
+	c := &vpcClient{b: b}
+	o := c.vpcResource("snapshot", "snapshots", name)
+	o["bootable"] = bootable
+	o["lifecycle_state"] = vpcv1.SnapshotLifecycleStateStableConst
+	o["minimum_capacity"] = minimumCapacity
+	o["size"] = minimumCapacity
+	o["resource_group"] = b.resourceGroup(object{})
+	b.insert(kindSnapshot, o.str("id"), o, nil)
+	return o.str("id")
+}
+
+// GetSnapshot returns a snapshot.
+func (c *vpcClient) GetSnapshot(options *vpcv1.GetSnapshotOptions) (*vpcv1.Snapshot, *core.DetailedResponse, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.get(kindSnapshot, *options.ID)
+	if !ok {
+		resp, err := notFound(kindSnapshot, *options.ID)
+		return nil, resp, err
+	}
+	result, err := vpcModel[vpcv1.Snapshot](o, vpcv1.UnmarshalSnapshot)
+	return result, response(http.StatusOK), err
+}
+
+// GetSnapshotByName returns the snapshot with the given name, or nil.
+func (c *vpcClient) GetSnapshotByName(name string) (*vpcv1.Snapshot, error) {
+	c.b.lock()
+	defer c.b.unlock()
+
+	o, ok := c.b.findOne(kindSnapshot, withName(name))
+	if !ok {
+		return nil, nil
+	}
+	return vpcModel[vpcv1.Snapshot](o, vpcv1.UnmarshalSnapshot)
+}
+
+// AddSSHKey adds an SSH key to the backend and returns its ID.
+func (b *Backend) AddSSHKey(name string) string {
+	b.lock()
+	defer b.unlock()
+
+	c := &vpcClient{b: b}
+	o := c.vpcResource("key", "keys", name)
+	o["type"] = "rsa"
+	o["length"] = 2048
+	o["fingerprint"] = fmt.Sprintf("SHA256:%s", o["id"])
+	o["public_key"] = fmt.Sprintf("ssh-rsa AAAA%s", o["id"])
+	o["resource_group"] = b.resourceGroup(object{})
+	b.insert(kindKey, o.str("id"), o, nil)
+	return o.str("id")
+}
@@ -30,4 +30,6 @@ type GlobalTagging interface {
 	CreateTag(*globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error)
 	AttachTag(*globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error)
 	GetTagByName(string) (*globaltaggingv1.Tag, error)
+	DetachTag(*globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error)
+	GetAttachedTags(string) ([]string, error)
 }
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaltagging
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the GlobalTagging API calls spans and metrics.
+const instrumentationServiceName = "globaltagging"
+
+// instrumentedService rate limits and retries the calls to the wrapped GlobalTagging and records their spans and metrics.
+type instrumentedService struct {
+	GlobalTagging
+
+	// phases holds the span of the reconciliation phase issuing the API calls, the parent of their spans.
+	phases *tracing.Phases
+}
+
+// newInstrumentedService returns a GlobalTagging rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span of the reconciliation phase issuing them.
+func newInstrumentedService(ctx context.Context, service GlobalTagging) GlobalTagging {
+	return &instrumentedService{GlobalTagging: service, phases: tracing.PhasesFrom(ctx)}
+}
+
+// WithContext returns the GlobalTagging recording the spans of the API calls as children of the phases of the flow in ctx.
+func (s *instrumentedService) WithContext(ctx context.Context) GlobalTagging {
+	return newInstrumentedService(ctx, s.GlobalTagging)
+}
+
+// CreateTag calls CreateTag with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateTag(options *globaltaggingv1.CreateTagOptions) (result *globaltaggingv1.CreateTagResults, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateTag", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateTag")
+		result, response, err = s.GlobalTagging.CreateTag(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateTag", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// AttachTag calls AttachTag with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) AttachTag(options *globaltaggingv1.AttachTagOptions) (result *globaltaggingv1.TagResults, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "AttachTag", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "AttachTag")
+		result, response, err = s.GlobalTagging.AttachTag(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "AttachTag", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetTagByName calls GetTagByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetTagByName(tagName string) (result *globaltaggingv1.Tag, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetTagByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetTagByName")
+		result, err = s.GlobalTagging.GetTagByName(tagName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetTagByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DetachTag calls DetachTag with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DetachTag(options *globaltaggingv1.DetachTagOptions) (result *globaltaggingv1.TagResults, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DetachTag", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DetachTag")
+		result, response, err = s.GlobalTagging.DetachTag(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DetachTag", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetAttachedTags calls GetAttachedTags with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetAttachedTags(resourceCRN string) (result []string, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetAttachedTags", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetAttachedTags")
+		result, err = s.GlobalTagging.GetAttachedTags(resourceCRN)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetAttachedTags", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package globaltagging
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -29,6 +30,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 )
 
+// attachedTagsPageLimit is the number of tags listed per page when listing the tags attached to a resource.
+const attachedTagsPageLimit = 1000
+
 // Service holds the IBM Cloud Global Tagging Service specific information.
 type Service struct {
 	client *globaltaggingv1.GlobalTaggingV1
@@ -51,7 +55,7 @@ func (s *Service) AttachTag(options *globaltaggingv1.AttachTagOptions) (*globalt
 
 // GetTagByName returns the Tag with the provided name, if found.
 func (s *Service) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
-	accountID, err := accounts.GetAccountID()
+	accountID, err := accounts.GetAccount(s.client.Service.Options.Authenticator)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +79,41 @@ func (s *Service) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
 	return nil, nil
 }
 
+// DetachTag will remove tag(s) from resource(s).
+func (s *Service) DetachTag(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	return s.client.DetachTag(options)
+}
+
+// GetAttachedTags returns the names of the user tags attached to the resource with the provided CRN.
+func (s *Service) GetAttachedTags(resourceCRN string) ([]string, error) {
+	listOptions := s.client.NewListTagsOptions()
+	listOptions.SetTagType(globaltaggingv1.ListTagsOptionsTagTypeUserConst)
+	listOptions.SetAttachedTo(resourceCRN)
+	listOptions.SetLimit(attachedTagsPageLimit)
+
+	var tags []string
+	for offset := int64(0); ; offset += attachedTagsPageLimit {
+		listOptions.SetOffset(offset)
+		result, _, err := s.client.ListTags(listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed listing user tags attached to %s: %w", resourceCRN, err)
+		}
+		if result == nil {
+			return nil, fmt.Errorf("failed to list tags attached to %s", resourceCRN)
+		}
+		for _, tag := range result.Items {
+			if tag.Name != nil {
+				tags = append(tags, *tag.Name)
+			}
+		}
+		if int64(len(result.Items)) < attachedTagsPageLimit {
+			return tags, nil
+		}
+	}
+}
+
 // NewService returns a new service for the IBM Cloud Global Tagging api client.
-func NewService(options ServiceOptions) (*Service, error) {
+func NewService(ctx context.Context, options ServiceOptions) (GlobalTagging, error) {
 	if options.GlobalTaggingV1Options == nil {
 		options.GlobalTaggingV1Options = &globaltaggingv1.GlobalTaggingV1Options{}
 	}
@@ -91,7 +128,7 @@ func NewService(options ServiceOptions) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Service{
+	return newInstrumentedService(ctx, &Service{
 		client: service,
-	}, nil
+	}), nil
 }
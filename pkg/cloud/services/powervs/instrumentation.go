@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powervs
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the PowerVS API calls spans and metrics.
+const instrumentationServiceName = "powervs"
+
+// instrumentedService rate limits and retries the calls to the wrapped PowerVS and records their spans and metrics.
+type instrumentedService struct {
+	PowerVS
+}
+
+// newInstrumentedService returns a PowerVS rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span in the context of each call.
+func newInstrumentedService(service PowerVS) PowerVS {
+	return &instrumentedService{PowerVS: service}
+}
+
+// CreateInstance calls CreateInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateInstance(ctx context.Context, body *models.PVMInstanceCreate) (result *models.PVMInstanceList, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "CreateInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateInstance")
+		result, err = s.PowerVS.CreateInstance(ctx, body)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateInstance", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteInstance calls DeleteInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteInstance(ctx context.Context, id string) (err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteInstance")
+		err = s.PowerVS.DeleteInstance(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteInstance", start, nil, err)
+		return nil, err
+	})
+	return err
+}
+
+// GetInstance calls GetInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstance(ctx context.Context, id string) (result *models.PVMInstance, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstance")
+		result, err = s.PowerVS.GetInstance(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstance", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// ListInstances calls ListInstances with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListInstances(ctx context.Context) (result *models.PVMInstances, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "ListInstances", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListInstances")
+		result, err = s.PowerVS.ListInstances(ctx)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListInstances", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetImage calls GetImage with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetImage(ctx context.Context, id string) (result *models.Image, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetImage", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetImage")
+		result, err = s.PowerVS.GetImage(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetImage", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteImage calls DeleteImage with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteImage(ctx context.Context, id string) (err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteImage", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteImage")
+		err = s.PowerVS.DeleteImage(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteImage", start, nil, err)
+		return nil, err
+	})
+	return err
+}
+
+// ListImages calls ListImages with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListImages(ctx context.Context) (result *models.Images, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "ListImages", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListImages")
+		result, err = s.PowerVS.ListImages(ctx)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListImages", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetJob calls GetJob with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetJob(ctx context.Context, id string) (result *models.Job, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetJob", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetJob")
+		result, err = s.PowerVS.GetJob(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetJob", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteJob calls DeleteJob with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteJob(ctx context.Context, id string) (err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteJob", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteJob")
+		err = s.PowerVS.DeleteJob(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteJob", start, nil, err)
+		return nil, err
+	})
+	return err
+}
+
+// CreateCosImage calls CreateCosImage with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateCosImage(ctx context.Context, body *models.CreateCosImageImportJob) (result *models.JobReference, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "CreateCosImage", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateCosImage")
+		result, err = s.PowerVS.CreateCosImage(ctx, body)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateCosImage", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetCosImages calls GetCosImages with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetCosImages(ctx context.Context, id string) (result *models.Job, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetCosImages", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetCosImages")
+		result, err = s.PowerVS.GetCosImages(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetCosImages", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// ListNetworks calls ListNetworks with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListNetworks(ctx context.Context) (result *models.Networks, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "ListNetworks", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListNetworks")
+		result, err = s.PowerVS.ListNetworks(ctx)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListNetworks", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetNetworkByID calls GetNetworkByID with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetNetworkByID(ctx context.Context, id string) (result *models.Network, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetNetworkByID", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetNetworkByID")
+		result, err = s.PowerVS.GetNetworkByID(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetNetworkByID", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetNetworkByName calls GetNetworkByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetNetworkByName(ctx context.Context, networkName string) (result *models.NetworkReference, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetNetworkByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetNetworkByName")
+		result, err = s.PowerVS.GetNetworkByName(ctx, networkName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetNetworkByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateDHCPServer calls CreateDHCPServer with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateDHCPServer(ctx context.Context, body *models.DHCPServerCreate) (result *models.DHCPServer, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "CreateDHCPServer", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateDHCPServer")
+		result, err = s.PowerVS.CreateDHCPServer(ctx, body)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateDHCPServer", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetDHCPServer calls GetDHCPServer with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetDHCPServer(ctx context.Context, id string) (result *models.DHCPServerDetail, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetDHCPServer", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetDHCPServer")
+		result, err = s.PowerVS.GetDHCPServer(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetDHCPServer", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteDHCPServer calls DeleteDHCPServer with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteDHCPServer(ctx context.Context, id string) (err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteDHCPServer", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteDHCPServer")
+		err = s.PowerVS.DeleteDHCPServer(ctx, id)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteDHCPServer", start, nil, err)
+		return nil, err
+	})
+	return err
+}
+
+// ListDHCPServers calls ListDHCPServers with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListDHCPServers(ctx context.Context) (result models.DHCPServers, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "ListDHCPServers", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListDHCPServers")
+		result, err = s.PowerVS.ListDHCPServers(ctx)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListDHCPServers", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetDatacenterDetails calls GetDatacenterDetails with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetDatacenterDetails(ctx context.Context, zone string) (result *models.Datacenter, err error) {
+	err = retry.Do(ctx, instrumentationServiceName, "GetDatacenterDetails", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetDatacenterDetails")
+		result, err = s.PowerVS.GetDatacenterDetails(ctx, zone)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetDatacenterDetails", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
@@ -71,7 +71,7 @@ func NewService(ctx context.Context, options ServiceOptions) (PowerVS, error) {
 		return nil, fmt.Errorf("failed to create PowerVS session: %w", err)
 	}
 
-	return &Service{
+	return newInstrumentedService(&Service{
 		session:          session,
 		instanceClient:   instance.NewIBMPIInstanceClient(ctx, session, options.WorkspaceID),
 		networkClient:    instance.NewIBMPINetworkClient(ctx, session, options.WorkspaceID),
@@ -79,7 +79,7 @@ func NewService(ctx context.Context, options ServiceOptions) (PowerVS, error) {
 		jobClient:        instance.NewIBMPIJobClient(ctx, session, options.WorkspaceID),
 		dhcpClient:       instance.NewIBMPIDhcpClient(ctx, session, options.WorkspaceID),
 		dataCenterClient: instance.NewIBMPIDatacenterClient(ctx, session, options.WorkspaceID),
-	}, nil
+	}), nil
 }
 
 // CreateInstance creates the virtual machine in the Power VS service instance.
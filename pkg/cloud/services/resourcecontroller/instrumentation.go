@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecontroller
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the ResourceController API calls spans and metrics.
+const instrumentationServiceName = "resourcecontroller"
+
+// instrumentedService rate limits and retries the calls to the wrapped ResourceController and records their spans and metrics.
+type instrumentedService struct {
+	ResourceController
+
+	// phases holds the span of the reconciliation phase issuing the API calls, the parent of their spans.
+	phases *tracing.Phases
+}
+
+// newInstrumentedService returns a ResourceController rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span of the reconciliation phase issuing them.
+func newInstrumentedService(ctx context.Context, service ResourceController) ResourceController {
+	return &instrumentedService{ResourceController: service, phases: tracing.PhasesFrom(ctx)}
+}
+
+// WithContext returns the ResourceController recording the spans of the API calls as children of the phases of the flow in ctx.
+func (s *instrumentedService) WithContext(ctx context.Context) ResourceController {
+	return newInstrumentedService(ctx, s.ResourceController)
+}
+
+// ListResourceInstances calls ListResourceInstances with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListResourceInstances(listResourceInstancesOptions *resourcecontrollerv2.ListResourceInstancesOptions) (result *resourcecontrollerv2.ResourceInstancesList, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListResourceInstances", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListResourceInstances")
+		result, response, err = s.ResourceController.ListResourceInstances(listResourceInstancesOptions)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListResourceInstances", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetResourceInstance calls GetResourceInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetResourceInstance(getResourceInstanceOptions *resourcecontrollerv2.GetResourceInstanceOptions) (result *resourcecontrollerv2.ResourceInstance, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetResourceInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetResourceInstance")
+		result, response, err = s.ResourceController.GetResourceInstance(getResourceInstanceOptions)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetResourceInstance", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateResourceInstance calls CreateResourceInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateResourceInstance(options *resourcecontrollerv2.CreateResourceInstanceOptions) (result *resourcecontrollerv2.ResourceInstance, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateResourceInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateResourceInstance")
+		result, response, err = s.ResourceController.CreateResourceInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateResourceInstance", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetServiceInstance calls GetServiceInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetServiceInstance(id string, name string, zone *string) (result *resourcecontrollerv2.ResourceInstance, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetServiceInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetServiceInstance")
+		result, err = s.ResourceController.GetServiceInstance(id, name, zone)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetServiceInstance", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteResourceInstance calls DeleteResourceInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteResourceInstance(options *resourcecontrollerv2.DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteResourceInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteResourceInstance")
+		response, err = s.ResourceController.DeleteResourceInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteResourceInstance", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// GetInstanceByName calls GetInstanceByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstanceByName(name string, resourceID string, planID string) (result *resourcecontrollerv2.ResourceInstance, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstanceByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstanceByName")
+		result, err = s.ResourceController.GetInstanceByName(name, resourceID, planID)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstanceByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateResourceKey calls CreateResourceKey with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateResourceKey(options *resourcecontrollerv2.CreateResourceKeyOptions) (result *resourcecontrollerv2.ResourceKey, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateResourceKey", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateResourceKey")
+		result, response, err = s.ResourceController.CreateResourceKey(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateResourceKey", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetResourceInstanceByFilter calls GetResourceInstanceByFilter with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetResourceInstanceByFilter(filter InstanceFilter) (result *resourcecontrollerv2.ResourceInstance, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetResourceInstanceByFilter", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetResourceInstanceByFilter")
+		result, err = s.ResourceController.GetResourceInstanceByFilter(filter)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetResourceInstanceByFilter", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
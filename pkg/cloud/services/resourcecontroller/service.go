@@ -17,6 +17,7 @@ limitations under the License.
 package resourcecontroller
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -279,7 +280,7 @@ func (s *Service) CreateResourceKey(options *resourcecontrollerv2.CreateResource
 }
 
 // NewService returns a new service for the IBM Cloud Resource Controller api client.
-func NewService(options ServiceOptions) (ResourceController, error) {
+func NewService(ctx context.Context, options ServiceOptions) (ResourceController, error) {
 	if options.ResourceControllerV2Options == nil {
 		options.ResourceControllerV2Options = &resourcecontrollerv2.ResourceControllerV2Options{}
 	}
@@ -294,7 +295,7 @@ func NewService(options ServiceOptions) (ResourceController, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Service{
+	return newInstrumentedService(ctx, &Service{
 		client: service,
-	}, nil
+	}), nil
 }
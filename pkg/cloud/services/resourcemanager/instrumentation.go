@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the ResourceManager API calls spans and metrics.
+const instrumentationServiceName = "resourcemanager"
+
+// instrumentedService rate limits and retries the calls to the wrapped ResourceManager and records their spans and metrics.
+type instrumentedService struct {
+	ResourceManager
+
+	// phases holds the span of the reconciliation phase issuing the API calls, the parent of their spans.
+	phases *tracing.Phases
+}
+
+// newInstrumentedService returns a ResourceManager rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span of the reconciliation phase issuing them.
+func newInstrumentedService(ctx context.Context, service ResourceManager) ResourceManager {
+	return &instrumentedService{ResourceManager: service, phases: tracing.PhasesFrom(ctx)}
+}
+
+// WithContext returns the ResourceManager recording the spans of the API calls as children of the phases of the flow in ctx.
+func (s *instrumentedService) WithContext(ctx context.Context) ResourceManager {
+	return newInstrumentedService(ctx, s.ResourceManager)
+}
+
+// GetResourceGroup calls GetResourceGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetResourceGroup(getResourceGroupOptions *resourcemanagerv2.GetResourceGroupOptions) (result *resourcemanagerv2.ResourceGroup, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetResourceGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetResourceGroup")
+		result, response, err = s.ResourceManager.GetResourceGroup(getResourceGroupOptions)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetResourceGroup", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// ListResourceGroups calls ListResourceGroups with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListResourceGroups(listResourceGroupsOptions *resourcemanagerv2.ListResourceGroupsOptions) (result *resourcemanagerv2.ResourceGroupList, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListResourceGroups", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListResourceGroups")
+		result, response, err = s.ResourceManager.ListResourceGroups(listResourceGroupsOptions)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListResourceGroups", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetResourceGroupByName calls GetResourceGroupByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetResourceGroupByName(rgName string) (result *resourcemanagerv2.ResourceGroup, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetResourceGroupByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetResourceGroupByName")
+		result, err = s.ResourceManager.GetResourceGroupByName(rgName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetResourceGroupByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package resourcemanager
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -33,7 +34,7 @@ type Service struct {
 }
 
 // NewService returns a new service for the resource manager.
-func NewService(options *resourcemanagerv2.ResourceManagerV2Options) (ResourceManager, error) {
+func NewService(ctx context.Context, options *resourcemanagerv2.ResourceManagerV2Options) (ResourceManager, error) {
 	if options == nil {
 		options = &resourcemanagerv2.ResourceManagerV2Options{}
 	}
@@ -48,9 +49,9 @@ func NewService(options *resourcemanagerv2.ResourceManagerV2Options) (ResourceMa
 	if err != nil {
 		return nil, err
 	}
-	return &Service{
+	return newInstrumentedService(ctx, &Service{
 		client: rmClient,
-	}, nil
+	}), nil
 }
 
 // GetResourceGroup returns a Resource Group.
@@ -65,7 +66,7 @@ func (s *Service) ListResourceGroups(listResourceGroupsOptions *resourcemanagerv
 
 // GetResourceGroupByName returns the Resource Group with the provided name, if found.
 func (s *Service) GetResourceGroupByName(rgName string) (*resourcemanagerv2.ResourceGroup, error) {
-	accountID, err := accounts.GetAccountID()
+	accountID, err := accounts.GetAccount(s.client.Service.Options.Authenticator)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting account id for resource group lookup: %w", err)
 	}
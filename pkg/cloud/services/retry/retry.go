@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"golang.org/x/time/rate"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+)
+
+// idempotentPrefixes are the prefixes of the operations which are retried on server and network errors.
+// The other operations are only retried when they are throttled, as the request was rejected before being processed.
+var idempotentPrefixes = []string{"Get", "List", "Delete", "Put"}
+
+// Options are the rate limiting and retry options of the IBM Cloud API calls.
+type Options struct {
+	// QPS is the number of calls per second allowed to each IBM Cloud service, the calls are not rate limited if 0.
+	QPS float64
+
+	// Burst is the number of calls allowed to each IBM Cloud service in a burst above QPS.
+	Burst int
+
+	// ServiceQPS overrides QPS for the services in the map, keyed by service name, e.g. vpc.
+	ServiceQPS map[string]float64
+
+	// MaxRetries is the maximum number of retries of a failed call, the calls are not retried if 0.
+	MaxRetries int
+
+	// InitialBackoff is the delay before the first retry, doubled for each following retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two retries.
+	// The calls throttled with a longer Retry-After delay are not retried.
+	MaxBackoff time.Duration
+}
+
+// DefaultOptions returns the default rate limiting and retry options.
+func DefaultOptions() Options {
+	return Options{
+		QPS:            20,
+		Burst:          40,
+		MaxRetries:     3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+	}
+}
+
+var (
+	mu       sync.Mutex
+	options  = DefaultOptions()
+	limiters = map[string]*rate.Limiter{}
+)
+
+// Configure sets the rate limiting and retry options of all the IBM Cloud API calls.
+func Configure(opts Options) error {
+	if opts.QPS < 0 || opts.Burst < 0 || opts.MaxRetries < 0 || opts.InitialBackoff < 0 || opts.MaxBackoff < 0 {
+		return errors.New("rate limiting and retry options must not be negative")
+	}
+	for service, qps := range opts.ServiceQPS {
+		if qps < 0 {
+			return fmt.Errorf("QPS of service %s must not be negative", service)
+		}
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		return fmt.Errorf("max backoff %s must not be less than initial backoff %s", opts.MaxBackoff, opts.InitialBackoff)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	options = opts
+	limiters = map[string]*rate.Limiter{}
+	return nil
+}
+
+// config returns the options and the token bucket of the service.
+func config(service string) (Options, *rate.Limiter) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	limiter, ok := limiters[service]
+	if !ok {
+		qps := options.QPS
+		if serviceQPS, ok := options.ServiceQPS[service]; ok {
+			qps = serviceQPS
+		}
+		limit := rate.Inf
+		if qps > 0 {
+			limit = rate.Limit(qps)
+		}
+		limiter = rate.NewLimiter(limit, max(options.Burst, 1))
+		limiters[service] = limiter
+	}
+	return options, limiter
+}
+
+// Do makes an IBM Cloud API call to the operation of the service once allowed by the token bucket of the service.
+// The call is retried with an exponential backoff with jitter, or after the Retry-After delay of the response,
+// when it is throttled or, for idempotent operations, when it fails with a server or network error.
+// It returns the error of the last call.
+func Do(ctx context.Context, service, operation string, call func() (*core.DetailedResponse, error)) error {
+	opts, limiter := config(service)
+	for attempt := 0; ; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("failed to wait for %s rate limiter: %w", service, err)
+		}
+
+		response, err := call()
+		if err == nil || attempt >= opts.MaxRetries {
+			return err
+		}
+		delay, ok := retryDelay(opts, operation, response, err, attempt)
+		if !ok {
+			return err
+		}
+
+		ctrl.LoggerFrom(ctx).V(3).Info("Retrying IBM Cloud API call", "service", service, "operation", operation, "attempt", attempt+1, "delay", delay, "error", err.Error())
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns the delay before retrying a failed call and whether it is retried.
+func retryDelay(opts Options, operation string, response *core.DetailedResponse, err error, attempt int) (time.Duration, bool) {
+	code := metrics.HTTPStatusCode(response, err)
+	switch {
+	case code == http.StatusTooManyRequests:
+	case code >= http.StatusInternalServerError && code != http.StatusNotImplemented && isIdempotent(operation):
+	case code == 0 && isNetworkError(err) && isIdempotent(operation):
+	default:
+		return 0, false
+	}
+
+	if delay, ok := retryAfter(response); ok {
+		return delay, delay <= opts.MaxBackoff
+	}
+	return backoff(opts, attempt), true
+}
+
+// backoff returns the exponential backoff of the retry with equal jitter.
+func backoff(opts Options, attempt int) time.Duration {
+	delay := opts.MaxBackoff
+	if attempt < 32 {
+		delay = min(opts.InitialBackoff<<attempt, opts.MaxBackoff)
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1) //nolint:gosec // The jitter does not need a cryptographically secure generator.
+}
+
+// retryAfter returns the delay set in the Retry-After header of the response, if any.
+func retryAfter(response *core.DetailedResponse) (time.Duration, bool) {
+	if response == nil || response.Headers == nil {
+		return 0, false
+	}
+	value := response.Headers.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(operation string) bool {
+	for _, prefix := range idempotentPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
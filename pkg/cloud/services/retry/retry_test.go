@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	. "github.com/onsi/gomega"
+)
+
+func setOptions(t *testing.T, opts Options) {
+	t.Helper()
+	if err := Configure(opts); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := Configure(DefaultOptions()); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func response(code int, headers http.Header) *core.DetailedResponse {
+	return &core.DetailedResponse{StatusCode: code, Headers: headers}
+}
+
+func TestDo(t *testing.T) {
+	testCases := []struct {
+		name          string
+		operation     string
+		responses     []*core.DetailedResponse
+		expectedCalls int
+		expectErr     bool
+	}{
+		{
+			name:          "Should not retry a successful call",
+			operation:     "GetInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusOK, nil)},
+			expectedCalls: 1,
+		},
+		{
+			name:          "Should retry a throttled call of a non idempotent operation",
+			operation:     "CreateInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusTooManyRequests, nil), response(http.StatusCreated, nil)},
+			expectedCalls: 2,
+		},
+		{
+			name:          "Should retry a server error of an idempotent operation",
+			operation:     "GetInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusServiceUnavailable, nil), response(http.StatusOK, nil)},
+			expectedCalls: 2,
+		},
+		{
+			name:          "Should not retry a server error of a non idempotent operation",
+			operation:     "CreateInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusServiceUnavailable, nil)},
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+		{
+			name:          "Should not retry a client error",
+			operation:     "GetInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusNotFound, nil)},
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+		{
+			name:          "Should return the last error once the retries are exhausted",
+			operation:     "ListInstances",
+			responses:     []*core.DetailedResponse{response(http.StatusBadGateway, nil), response(http.StatusBadGateway, nil), response(http.StatusBadGateway, nil)},
+			expectedCalls: 3,
+			expectErr:     true,
+		},
+		{
+			name:          "Should retry a throttled call after the Retry-After delay",
+			operation:     "CreateInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"0"}}), response(http.StatusCreated, nil)},
+			expectedCalls: 2,
+		},
+		{
+			name:          "Should not retry a throttled call with a Retry-After delay longer than the max backoff",
+			operation:     "CreateInstance",
+			responses:     []*core.DetailedResponse{response(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"60"}})},
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			setOptions(t, Options{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+
+			calls := 0
+			err := Do(context.Background(), "test", tc.operation, func() (*core.DetailedResponse, error) {
+				resp := tc.responses[calls]
+				calls++
+				if resp.StatusCode >= http.StatusBadRequest {
+					return resp, errors.New(http.StatusText(resp.StatusCode))
+				}
+				return resp, nil
+			})
+			g.Expect(calls).To(Equal(tc.expectedCalls))
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDoRateLimit(t *testing.T) {
+	t.Run("Should rate limit the calls of each service separately", func(t *testing.T) {
+		g := NewWithT(t)
+		setOptions(t, Options{QPS: 1, Burst: 1, ServiceQPS: map[string]float64{"vpc": 0}})
+
+		call := func() (*core.DetailedResponse, error) { return nil, nil }
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		g.Expect(Do(ctx, "powervs", "GetInstance", call)).To(Succeed())
+		g.Expect(Do(ctx, "cos", "GetObject", call)).To(Succeed())
+		g.Expect(Do(ctx, "powervs", "GetInstance", call)).ToNot(Succeed())
+		for range 5 {
+			g.Expect(Do(ctx, "vpc", "GetInstance", call)).To(Succeed())
+		}
+	})
+}
+
+func TestConfigure(t *testing.T) {
+	testCases := []struct {
+		name      string
+		opts      Options
+		expectErr bool
+	}{
+		{
+			name: "Should accept the default options",
+			opts: DefaultOptions(),
+		},
+		{
+			name:      "Should reject a negative QPS",
+			opts:      Options{QPS: -1},
+			expectErr: true,
+		},
+		{
+			name:      "Should reject a negative service QPS",
+			opts:      Options{ServiceQPS: map[string]float64{"vpc": -1}},
+			expectErr: true,
+		},
+		{
+			name:      "Should reject a max backoff less than the initial backoff",
+			opts:      Options{InitialBackoff: time.Second, MaxBackoff: time.Millisecond},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Cleanup(func() { _ = Configure(DefaultOptions()) })
+			err := Configure(tc.opts)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	g := NewWithT(t)
+	opts := Options{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		delay := backoff(opts, attempt)
+		g.Expect(delay).To(BeNumerically(">=", expected/2))
+		g.Expect(delay).To(BeNumerically("<=", expected))
+	}
+}
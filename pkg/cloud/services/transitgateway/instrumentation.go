@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the TransitGateway API calls spans and metrics.
+const instrumentationServiceName = "transitgateway"
+
+// instrumentedService rate limits and retries the calls to the wrapped TransitGateway and records their spans and metrics.
+type instrumentedService struct {
+	TransitGateway
+
+	// phases holds the span of the reconciliation phase issuing the API calls, the parent of their spans.
+	phases *tracing.Phases
+}
+
+// newInstrumentedService returns a TransitGateway rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span of the reconciliation phase issuing them.
+func newInstrumentedService(ctx context.Context, service TransitGateway) TransitGateway {
+	return &instrumentedService{TransitGateway: service, phases: tracing.PhasesFrom(ctx)}
+}
+
+// WithContext returns the TransitGateway recording the spans of the API calls as children of the phases of the flow in ctx.
+func (s *instrumentedService) WithContext(ctx context.Context) TransitGateway {
+	return newInstrumentedService(ctx, s.TransitGateway)
+}
+
+// GetTransitGateway calls GetTransitGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetTransitGateway(options *tgapiv1.GetTransitGatewayOptions) (result *tgapiv1.TransitGateway, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetTransitGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetTransitGateway")
+		result, response, err = s.TransitGateway.GetTransitGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetTransitGateway", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetTransitGatewayByName calls GetTransitGatewayByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetTransitGatewayByName(name string) (result *tgapiv1.TransitGateway, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetTransitGatewayByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetTransitGatewayByName")
+		result, err = s.TransitGateway.GetTransitGatewayByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetTransitGatewayByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// ListTransitGatewayConnections calls ListTransitGatewayConnections with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListTransitGatewayConnections(options *tgapiv1.ListTransitGatewayConnectionsOptions) (result *tgapiv1.TransitGatewayConnectionCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListTransitGatewayConnections", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListTransitGatewayConnections")
+		result, response, err = s.TransitGateway.ListTransitGatewayConnections(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListTransitGatewayConnections", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateTransitGateway calls CreateTransitGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateTransitGateway(options *tgapiv1.CreateTransitGatewayOptions) (result *tgapiv1.TransitGateway, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateTransitGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateTransitGateway")
+		result, response, err = s.TransitGateway.CreateTransitGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateTransitGateway", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateTransitGatewayConnection calls CreateTransitGatewayConnection with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateTransitGatewayConnection(options *tgapiv1.CreateTransitGatewayConnectionOptions) (result *tgapiv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateTransitGatewayConnection", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateTransitGatewayConnection")
+		result, response, err = s.TransitGateway.CreateTransitGatewayConnection(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateTransitGatewayConnection", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetTransitGatewayConnection calls GetTransitGatewayConnection with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetTransitGatewayConnection(options *tgapiv1.GetTransitGatewayConnectionOptions) (result *tgapiv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetTransitGatewayConnection", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetTransitGatewayConnection")
+		result, response, err = s.TransitGateway.GetTransitGatewayConnection(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetTransitGatewayConnection", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteTransitGateway calls DeleteTransitGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteTransitGateway(deleteTransitGatewayOptions *tgapiv1.DeleteTransitGatewayOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteTransitGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteTransitGateway")
+		response, err = s.TransitGateway.DeleteTransitGateway(deleteTransitGatewayOptions)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteTransitGateway", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// DeleteTransitGatewayConnection calls DeleteTransitGatewayConnection with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions *tgapiv1.DeleteTransitGatewayConnectionOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteTransitGatewayConnection", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteTransitGatewayConnection")
+		response, err = s.TransitGateway.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteTransitGatewayConnection", start, response, err)
+		return response, err
+	})
+	return response, err
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -37,7 +38,7 @@ type Service struct {
 }
 
 // NewService returns a new service for the IBM Cloud Transit Gateway api client.
-func NewService(options *tgapiv1.TransitGatewayApisV1Options) (TransitGateway, error) {
+func NewService(ctx context.Context, options *tgapiv1.TransitGatewayApisV1Options) (TransitGateway, error) {
 	if options == nil {
 		options = &tgapiv1.TransitGatewayApisV1Options{}
 	}
@@ -55,9 +56,9 @@ func NewService(options *tgapiv1.TransitGatewayApisV1Options) (TransitGateway, e
 		return nil, fmt.Errorf("failed to create transit gateway api client: %w", err)
 	}
 
-	return &Service{
+	return newInstrumentedService(ctx, &Service{
 		tgClient: tgClient,
-	}, nil
+	}), nil
 }
 
 // GetTransitGateway returns the specified transit gateway. If not found, returns error.
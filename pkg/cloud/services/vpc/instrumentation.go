@@ -0,0 +1,1476 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/retry"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/tracing"
+)
+
+// instrumentationServiceName is the service label value of the Vpc API calls spans and metrics.
+const instrumentationServiceName = "vpc"
+
+// instrumentedService rate limits and retries the calls to the wrapped Vpc and records their spans and metrics.
+type instrumentedService struct {
+	Vpc
+
+	// phases holds the span of the reconciliation phase issuing the API calls, the parent of their spans.
+	phases *tracing.Phases
+}
+
+// newInstrumentedService returns a Vpc rate limiting and retrying the calls to the given one and recording their spans and metrics.
+// The API calls spans are recorded as children of the span of the reconciliation phase issuing them.
+func newInstrumentedService(ctx context.Context, service Vpc) Vpc {
+	return &instrumentedService{Vpc: service, phases: tracing.PhasesFrom(ctx)}
+}
+
+// WithContext returns the Vpc recording the spans of the API calls as children of the phases of the flow in ctx.
+func (s *instrumentedService) WithContext(ctx context.Context) Vpc {
+	return newInstrumentedService(ctx, s.Vpc)
+}
+
+// CreateInstance calls CreateInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateInstance(options *vpcv1.CreateInstanceOptions) (result *vpcv1.Instance, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateInstance")
+		result, response, err = s.Vpc.CreateInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateInstance", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteInstance calls DeleteInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteInstance")
+		response, err = s.Vpc.DeleteInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteInstance", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// GetInstance calls GetInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstance(options *vpcv1.GetInstanceOptions) (result *vpcv1.Instance, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstance")
+		result, response, err = s.Vpc.GetInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstance", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// ListInstances calls ListInstances with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListInstances(options *vpcv1.ListInstancesOptions) (result *vpcv1.InstanceCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListInstances", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListInstances")
+		result, response, err = s.Vpc.ListInstances(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListInstances", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// UpdateInstance calls UpdateInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UpdateInstance(options *vpcv1.UpdateInstanceOptions) (result *vpcv1.Instance, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UpdateInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UpdateInstance")
+		result, response, err = s.Vpc.UpdateInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UpdateInstance", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateInstanceAction calls CreateInstanceAction with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (result *vpcv1.InstanceAction, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateInstanceAction", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateInstanceAction")
+		result, response, err = s.Vpc.CreateInstanceAction(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateInstanceAction", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetDedicatedHostByName calls GetDedicatedHostByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetDedicatedHostByName(dHostName string) (result *vpcv1.DedicatedHost, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetDedicatedHostByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetDedicatedHostByName")
+		result, err = s.Vpc.GetDedicatedHostByName(dHostName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetDedicatedHostByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateVPC calls CreateVPC with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateVPC(options *vpcv1.CreateVPCOptions) (result *vpcv1.VPC, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateVPC", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateVPC")
+		result, response, err = s.Vpc.CreateVPC(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateVPC", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteVPC calls DeleteVPC with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteVPC(options *vpcv1.DeleteVPCOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteVPC", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteVPC")
+		response, err = s.Vpc.DeleteVPC(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteVPC", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListVpcs calls ListVpcs with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListVpcs(options *vpcv1.ListVpcsOptions) (result *vpcv1.VPCCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListVpcs", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListVpcs")
+		result, response, err = s.Vpc.ListVpcs(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListVpcs", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateSubnet calls CreateSubnet with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateSubnet(options *vpcv1.CreateSubnetOptions) (result *vpcv1.Subnet, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateSubnet", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateSubnet")
+		result, response, err = s.Vpc.CreateSubnet(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateSubnet", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteSubnet calls DeleteSubnet with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteSubnet(options *vpcv1.DeleteSubnetOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteSubnet", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteSubnet")
+		response, err = s.Vpc.DeleteSubnet(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteSubnet", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListSubnets calls ListSubnets with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListSubnets(options *vpcv1.ListSubnetsOptions) (result *vpcv1.SubnetCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListSubnets", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListSubnets")
+		result, response, err = s.Vpc.ListSubnets(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListSubnets", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetSubnetPublicGateway calls GetSubnetPublicGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOptions) (result *vpcv1.PublicGateway, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSubnetPublicGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSubnetPublicGateway")
+		result, response, err = s.Vpc.GetSubnetPublicGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSubnetPublicGateway", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// SetSubnetPublicGateway calls SetSubnetPublicGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (result *vpcv1.PublicGateway, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "SetSubnetPublicGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "SetSubnetPublicGateway")
+		result, response, err = s.Vpc.SetSubnetPublicGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "SetSubnetPublicGateway", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// UnsetSubnetPublicGateway calls UnsetSubnetPublicGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UnsetSubnetPublicGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UnsetSubnetPublicGateway")
+		response, err = s.Vpc.UnsetSubnetPublicGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UnsetSubnetPublicGateway", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// CreatePublicGateway calls CreatePublicGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (result *vpcv1.PublicGateway, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreatePublicGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreatePublicGateway")
+		result, response, err = s.Vpc.CreatePublicGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreatePublicGateway", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetPublicGateway calls GetPublicGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (result *vpcv1.PublicGateway, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetPublicGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetPublicGateway")
+		result, response, err = s.Vpc.GetPublicGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetPublicGateway", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeletePublicGateway calls DeletePublicGateway with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeletePublicGateway", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeletePublicGateway")
+		response, err = s.Vpc.DeletePublicGateway(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeletePublicGateway", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListVPCAddressPrefixes calls ListVPCAddressPrefixes with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (result *vpcv1.AddressPrefixCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListVPCAddressPrefixes", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListVPCAddressPrefixes")
+		result, response, err = s.Vpc.ListVPCAddressPrefixes(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListVPCAddressPrefixes", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateSecurityGroupRule calls CreateSecurityGroupRule with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (result vpcv1.SecurityGroupRuleIntf, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateSecurityGroupRule", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateSecurityGroupRule")
+		result, response, err = s.Vpc.CreateSecurityGroupRule(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateSecurityGroupRule", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateLoadBalancer calls CreateLoadBalancer with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (result *vpcv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateLoadBalancer", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateLoadBalancer")
+		result, response, err = s.Vpc.CreateLoadBalancer(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateLoadBalancer", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteLoadBalancer calls DeleteLoadBalancer with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteLoadBalancer", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteLoadBalancer")
+		response, err = s.Vpc.DeleteLoadBalancer(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteLoadBalancer", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListLoadBalancers calls ListLoadBalancers with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListLoadBalancers(options *vpcv1.ListLoadBalancersOptions) (result *vpcv1.LoadBalancerCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListLoadBalancers", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListLoadBalancers")
+		result, response, err = s.Vpc.ListLoadBalancers(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListLoadBalancers", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetLoadBalancer calls GetLoadBalancer with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (result *vpcv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetLoadBalancer", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetLoadBalancer")
+		result, response, err = s.Vpc.GetLoadBalancer(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetLoadBalancer", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateLoadBalancerPoolMember calls CreateLoadBalancerPoolMember with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (result *vpcv1.LoadBalancerPoolMember, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateLoadBalancerPoolMember", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateLoadBalancerPoolMember")
+		result, response, err = s.Vpc.CreateLoadBalancerPoolMember(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateLoadBalancerPoolMember", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteLoadBalancerPoolMember calls DeleteLoadBalancerPoolMember with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteLoadBalancerPoolMember(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteLoadBalancerPoolMember", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteLoadBalancerPoolMember")
+		response, err = s.Vpc.DeleteLoadBalancerPoolMember(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteLoadBalancerPoolMember", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListLoadBalancerPoolMembers calls ListLoadBalancerPoolMembers with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListLoadBalancerPoolMembers(options *vpcv1.ListLoadBalancerPoolMembersOptions) (result *vpcv1.LoadBalancerPoolMemberCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListLoadBalancerPoolMembers", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListLoadBalancerPoolMembers")
+		result, response, err = s.Vpc.ListLoadBalancerPoolMembers(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListLoadBalancerPoolMembers", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetLoadBalancerListener calls GetLoadBalancerListener with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetLoadBalancerListener(options *vpcv1.GetLoadBalancerListenerOptions) (result *vpcv1.LoadBalancerListener, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetLoadBalancerListener", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetLoadBalancerListener")
+		result, response, err = s.Vpc.GetLoadBalancerListener(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetLoadBalancerListener", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// ListKeys calls ListKeys with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListKeys(options *vpcv1.ListKeysOptions) (result *vpcv1.KeyCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListKeys", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListKeys")
+		result, response, err = s.Vpc.ListKeys(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListKeys", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateImage calls CreateImage with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateImage(options *vpcv1.CreateImageOptions) (result *vpcv1.Image, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateImage", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateImage")
+		result, response, err = s.Vpc.CreateImage(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateImage", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// ListImages calls ListImages with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListImages(options *vpcv1.ListImagesOptions) (result *vpcv1.ImageCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListImages", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListImages")
+		result, response, err = s.Vpc.ListImages(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListImages", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetImage calls GetImage with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetImage(options *vpcv1.GetImageOptions) (result *vpcv1.Image, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetImage", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetImage")
+		result, response, err = s.Vpc.GetImage(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetImage", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetInstanceProfile calls GetInstanceProfile with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (result *vpcv1.InstanceProfile, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstanceProfile", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstanceProfile")
+		result, response, err = s.Vpc.GetInstanceProfile(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstanceProfile", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetVPC calls GetVPC with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVPC(options *vpcv1.GetVPCOptions) (result *vpcv1.VPC, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVPC", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVPC")
+		result, response, err = s.Vpc.GetVPC(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVPC", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetVPCByName calls GetVPCByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVPCByName(vpcName string) (result *vpcv1.VPC, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVPCByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVPCByName")
+		result, err = s.Vpc.GetVPCByName(vpcName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVPCByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetImageByName calls GetImageByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetImageByName(imageName string) (result *vpcv1.Image, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetImageByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetImageByName")
+		result, err = s.Vpc.GetImageByName(imageName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetImageByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetVPCPublicGatewayByName calls GetVPCPublicGatewayByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVPCPublicGatewayByName(publicGatewayName string, resourceGroupID string) (result *vpcv1.PublicGateway, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVPCPublicGatewayByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVPCPublicGatewayByName")
+		result, err = s.Vpc.GetVPCPublicGatewayByName(publicGatewayName, resourceGroupID)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVPCPublicGatewayByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetSubnet calls GetSubnet with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSubnet(options *vpcv1.GetSubnetOptions) (result *vpcv1.Subnet, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSubnet", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSubnet")
+		result, response, err = s.Vpc.GetSubnet(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSubnet", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetVPCSubnetByName calls GetVPCSubnetByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVPCSubnetByName(subnetName string) (result *vpcv1.Subnet, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVPCSubnetByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVPCSubnetByName")
+		result, err = s.Vpc.GetVPCSubnetByName(subnetName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVPCSubnetByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetLoadBalancerPoolByName calls GetLoadBalancerPoolByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetLoadBalancerPoolByName(loadBalancerID string, poolName string) (result *vpcv1.LoadBalancerPool, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetLoadBalancerPoolByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetLoadBalancerPoolByName")
+		result, err = s.Vpc.GetLoadBalancerPoolByName(loadBalancerID, poolName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetLoadBalancerPoolByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetLoadBalancerByName calls GetLoadBalancerByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetLoadBalancerByName(loadBalancerName string) (result *vpcv1.LoadBalancer, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetLoadBalancerByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetLoadBalancerByName")
+		result, err = s.Vpc.GetLoadBalancerByName(loadBalancerName)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetLoadBalancerByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateSecurityGroup calls CreateSecurityGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateSecurityGroup(options *vpcv1.CreateSecurityGroupOptions) (result *vpcv1.SecurityGroup, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateSecurityGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateSecurityGroup")
+		result, response, err = s.Vpc.CreateSecurityGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateSecurityGroup", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteSecurityGroup calls DeleteSecurityGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteSecurityGroup(options *vpcv1.DeleteSecurityGroupOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteSecurityGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteSecurityGroup")
+		response, err = s.Vpc.DeleteSecurityGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteSecurityGroup", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListSecurityGroups calls ListSecurityGroups with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListSecurityGroups(options *vpcv1.ListSecurityGroupsOptions) (result *vpcv1.SecurityGroupCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListSecurityGroups", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListSecurityGroups")
+		result, response, err = s.Vpc.ListSecurityGroups(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListSecurityGroups", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetSecurityGroup calls GetSecurityGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (result *vpcv1.SecurityGroup, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSecurityGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSecurityGroup")
+		result, response, err = s.Vpc.GetSecurityGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSecurityGroup", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetSecurityGroupByName calls GetSecurityGroupByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSecurityGroupByName(name string) (result *vpcv1.SecurityGroup, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSecurityGroupByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSecurityGroupByName")
+		result, err = s.Vpc.GetSecurityGroupByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSecurityGroupByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// GetSecurityGroupRule calls GetSecurityGroupRule with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSecurityGroupRule(options *vpcv1.GetSecurityGroupRuleOptions) (result vpcv1.SecurityGroupRuleIntf, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSecurityGroupRule", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSecurityGroupRule")
+		result, response, err = s.Vpc.GetSecurityGroupRule(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSecurityGroupRule", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// ListSecurityGroupRules calls ListSecurityGroupRules with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListSecurityGroupRules(options *vpcv1.ListSecurityGroupRulesOptions) (result *vpcv1.SecurityGroupRuleCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListSecurityGroupRules", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListSecurityGroupRules")
+		result, response, err = s.Vpc.ListSecurityGroupRules(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListSecurityGroupRules", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetVPCZonesByRegion calls GetVPCZonesByRegion with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVPCZonesByRegion(region string) (result []string, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVPCZonesByRegion", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVPCZonesByRegion")
+		result, err = s.Vpc.GetVPCZonesByRegion(region)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVPCZonesByRegion", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateVolume calls CreateVolume with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateVolume(options *vpcv1.CreateVolumeOptions) (result *vpcv1.Volume, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateVolume", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateVolume")
+		result, response, err = s.Vpc.CreateVolume(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateVolume", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// AttachVolumeToInstance calls AttachVolumeToInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) AttachVolumeToInstance(options *vpcv1.CreateInstanceVolumeAttachmentOptions) (result *vpcv1.VolumeAttachment, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "AttachVolumeToInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "AttachVolumeToInstance")
+		result, response, err = s.Vpc.AttachVolumeToInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "AttachVolumeToInstance", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetVolumeAttachments calls GetVolumeAttachments with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (result *vpcv1.VolumeAttachmentCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVolumeAttachments", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVolumeAttachments")
+		result, response, err = s.Vpc.GetVolumeAttachments(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVolumeAttachments", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetVolume calls GetVolume with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVolume(options *vpcv1.GetVolumeOptions) (result *vpcv1.Volume, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVolume", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVolume")
+		result, response, err = s.Vpc.GetVolume(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVolume", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// UpdateVolume calls UpdateVolume with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UpdateVolume(options *vpcv1.UpdateVolumeOptions) (result *vpcv1.Volume, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UpdateVolume", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UpdateVolume")
+		result, response, err = s.Vpc.UpdateVolume(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UpdateVolume", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DetachVolumeFromInstance calls DetachVolumeFromInstance with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DetachVolumeFromInstance(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DetachVolumeFromInstance", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DetachVolumeFromInstance")
+		response, err = s.Vpc.DetachVolumeFromInstance(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DetachVolumeFromInstance", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// DeleteVolume calls DeleteVolume with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteVolume(options *vpcv1.DeleteVolumeOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteVolume", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteVolume")
+		response, err = s.Vpc.DeleteVolume(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteVolume", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// CreateInstanceTemplate calls CreateInstanceTemplate with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (result vpcv1.InstanceTemplateIntf, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateInstanceTemplate", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateInstanceTemplate")
+		result, response, err = s.Vpc.CreateInstanceTemplate(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateInstanceTemplate", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteInstanceTemplate calls DeleteInstanceTemplate with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteInstanceTemplate", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteInstanceTemplate")
+		response, err = s.Vpc.DeleteInstanceTemplate(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteInstanceTemplate", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListInstanceTemplates calls ListInstanceTemplates with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (result *vpcv1.InstanceTemplateCollection, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListInstanceTemplates", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListInstanceTemplates")
+		result, response, err = s.Vpc.ListInstanceTemplates(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListInstanceTemplates", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetInstanceTemplateByName calls GetInstanceTemplateByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstanceTemplateByName(name string) (result *vpcv1.InstanceTemplate, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstanceTemplateByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstanceTemplateByName")
+		result, err = s.Vpc.GetInstanceTemplateByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstanceTemplateByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateInstanceGroup calls CreateInstanceGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (result *vpcv1.InstanceGroup, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateInstanceGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateInstanceGroup")
+		result, response, err = s.Vpc.CreateInstanceGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateInstanceGroup", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetInstanceGroup calls GetInstanceGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (result *vpcv1.InstanceGroup, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstanceGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstanceGroup")
+		result, response, err = s.Vpc.GetInstanceGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstanceGroup", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetInstanceGroupByName calls GetInstanceGroupByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetInstanceGroupByName(name string) (result *vpcv1.InstanceGroup, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetInstanceGroupByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetInstanceGroupByName")
+		result, err = s.Vpc.GetInstanceGroupByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetInstanceGroupByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// UpdateInstanceGroup calls UpdateInstanceGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (result *vpcv1.InstanceGroup, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UpdateInstanceGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UpdateInstanceGroup")
+		result, response, err = s.Vpc.UpdateInstanceGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UpdateInstanceGroup", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteInstanceGroup calls DeleteInstanceGroup with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteInstanceGroup", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteInstanceGroup")
+		response, err = s.Vpc.DeleteInstanceGroup(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteInstanceGroup", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// ListInstanceGroupMemberships calls ListInstanceGroupMemberships with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListInstanceGroupMemberships(instanceGroupID string) (result []vpcv1.InstanceGroupMembership, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListInstanceGroupMemberships", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListInstanceGroupMemberships")
+		result, err = s.Vpc.ListInstanceGroupMemberships(instanceGroupID)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListInstanceGroupMemberships", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteInstanceGroupMembership calls DeleteInstanceGroupMembership with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteInstanceGroupMembership", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteInstanceGroupMembership")
+		response, err = s.Vpc.DeleteInstanceGroupMembership(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteInstanceGroupMembership", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// GetVirtualNetworkInterface calls GetVirtualNetworkInterface with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetVirtualNetworkInterface(options *vpcv1.GetVirtualNetworkInterfaceOptions) (result *vpcv1.VirtualNetworkInterface, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetVirtualNetworkInterface", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetVirtualNetworkInterface")
+		result, response, err = s.Vpc.GetVirtualNetworkInterface(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetVirtualNetworkInterface", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// ListVirtualNetworkInterfacesByNamePrefix calls ListVirtualNetworkInterfacesByNamePrefix with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListVirtualNetworkInterfacesByNamePrefix(prefix string) (result []vpcv1.VirtualNetworkInterface, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListVirtualNetworkInterfacesByNamePrefix", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListVirtualNetworkInterfacesByNamePrefix")
+		result, err = s.Vpc.ListVirtualNetworkInterfacesByNamePrefix(prefix)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListVirtualNetworkInterfacesByNamePrefix", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// ListVirtualNetworkInterfacesBySubnet calls ListVirtualNetworkInterfacesBySubnet with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) ListVirtualNetworkInterfacesBySubnet(subnetID string) (result []vpcv1.VirtualNetworkInterface, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "ListVirtualNetworkInterfacesBySubnet", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "ListVirtualNetworkInterfacesBySubnet")
+		result, err = s.Vpc.ListVirtualNetworkInterfacesBySubnet(subnetID)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "ListVirtualNetworkInterfacesBySubnet", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// UpdateVirtualNetworkInterface calls UpdateVirtualNetworkInterface with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UpdateVirtualNetworkInterface(options *vpcv1.UpdateVirtualNetworkInterfaceOptions) (result *vpcv1.VirtualNetworkInterface, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UpdateVirtualNetworkInterface", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UpdateVirtualNetworkInterface")
+		result, response, err = s.Vpc.UpdateVirtualNetworkInterface(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UpdateVirtualNetworkInterface", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteVirtualNetworkInterfaces calls DeleteVirtualNetworkInterfaces with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteVirtualNetworkInterfaces(options *vpcv1.DeleteVirtualNetworkInterfacesOptions) (result *vpcv1.VirtualNetworkInterface, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteVirtualNetworkInterfaces", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteVirtualNetworkInterfaces")
+		result, response, err = s.Vpc.DeleteVirtualNetworkInterfaces(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteVirtualNetworkInterfaces", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// CreateFloatingIP calls CreateFloatingIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (result *vpcv1.FloatingIP, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateFloatingIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateFloatingIP")
+		result, response, err = s.Vpc.CreateFloatingIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateFloatingIP", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetFloatingIP calls GetFloatingIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetFloatingIP(options *vpcv1.GetFloatingIPOptions) (result *vpcv1.FloatingIP, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetFloatingIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetFloatingIP")
+		result, response, err = s.Vpc.GetFloatingIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetFloatingIP", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetFloatingIPByName calls GetFloatingIPByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetFloatingIPByName(name string) (result *vpcv1.FloatingIP, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetFloatingIPByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetFloatingIPByName")
+		result, err = s.Vpc.GetFloatingIPByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetFloatingIPByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// UpdateFloatingIP calls UpdateFloatingIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UpdateFloatingIP(options *vpcv1.UpdateFloatingIPOptions) (result *vpcv1.FloatingIP, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UpdateFloatingIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UpdateFloatingIP")
+		result, response, err = s.Vpc.UpdateFloatingIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UpdateFloatingIP", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteFloatingIP calls DeleteFloatingIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteFloatingIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteFloatingIP")
+		response, err = s.Vpc.DeleteFloatingIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteFloatingIP", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// RemoveInstanceNetworkInterfaceFloatingIP calls RemoveInstanceNetworkInterfaceFloatingIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) RemoveInstanceNetworkInterfaceFloatingIP(options *vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "RemoveInstanceNetworkInterfaceFloatingIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "RemoveInstanceNetworkInterfaceFloatingIP")
+		response, err = s.Vpc.RemoveInstanceNetworkInterfaceFloatingIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "RemoveInstanceNetworkInterfaceFloatingIP", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// RemoveNetworkInterfaceFloatingIP calls RemoveNetworkInterfaceFloatingIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) RemoveNetworkInterfaceFloatingIP(options *vpcv1.RemoveNetworkInterfaceFloatingIPOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "RemoveNetworkInterfaceFloatingIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "RemoveNetworkInterfaceFloatingIP")
+		response, err = s.Vpc.RemoveNetworkInterfaceFloatingIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "RemoveNetworkInterfaceFloatingIP", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// CreateSubnetReservedIP calls CreateSubnetReservedIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (result *vpcv1.ReservedIP, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateSubnetReservedIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateSubnetReservedIP")
+		result, response, err = s.Vpc.CreateSubnetReservedIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateSubnetReservedIP", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetSubnetReservedIPByName calls GetSubnetReservedIPByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSubnetReservedIPByName(subnetID string, name string) (result *vpcv1.ReservedIP, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSubnetReservedIPByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSubnetReservedIPByName")
+		result, err = s.Vpc.GetSubnetReservedIPByName(subnetID, name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSubnetReservedIPByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// UpdateSubnetReservedIP calls UpdateSubnetReservedIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) UpdateSubnetReservedIP(options *vpcv1.UpdateSubnetReservedIPOptions) (result *vpcv1.ReservedIP, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "UpdateSubnetReservedIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "UpdateSubnetReservedIP")
+		result, response, err = s.Vpc.UpdateSubnetReservedIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "UpdateSubnetReservedIP", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// DeleteSubnetReservedIP calls DeleteSubnetReservedIP with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteSubnetReservedIP", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteSubnetReservedIP")
+		response, err = s.Vpc.DeleteSubnetReservedIP(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteSubnetReservedIP", start, response, err)
+		return response, err
+	})
+	return response, err
+}
+
+// GetReservation calls GetReservation with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetReservation(options *vpcv1.GetReservationOptions) (result *vpcv1.Reservation, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetReservation", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetReservation")
+		result, response, err = s.Vpc.GetReservation(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetReservation", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetReservationByName calls GetReservationByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetReservationByName(name string) (result *vpcv1.Reservation, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetReservationByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetReservationByName")
+		result, err = s.Vpc.GetReservationByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetReservationByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateShare calls CreateShare with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateShare(options *vpcv1.CreateShareOptions) (result *vpcv1.Share, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateShare", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateShare")
+		result, response, err = s.Vpc.CreateShare(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateShare", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetShare calls GetShare with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetShare(options *vpcv1.GetShareOptions) (result *vpcv1.Share, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetShare", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetShare")
+		result, response, err = s.Vpc.GetShare(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetShare", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetShareByName calls GetShareByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetShareByName(name string) (result *vpcv1.Share, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetShareByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetShareByName")
+		result, err = s.Vpc.GetShareByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetShareByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// CreateShareMountTarget calls CreateShareMountTarget with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) CreateShareMountTarget(options *vpcv1.CreateShareMountTargetOptions) (result *vpcv1.ShareMountTarget, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "CreateShareMountTarget", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "CreateShareMountTarget")
+		result, response, err = s.Vpc.CreateShareMountTarget(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "CreateShareMountTarget", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetShareMountTarget calls GetShareMountTarget with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetShareMountTarget(options *vpcv1.GetShareMountTargetOptions) (result *vpcv1.ShareMountTarget, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetShareMountTarget", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetShareMountTarget")
+		result, response, err = s.Vpc.GetShareMountTarget(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetShareMountTarget", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetShareMountTargetByName calls GetShareMountTargetByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetShareMountTargetByName(shareID string, name string) (result *vpcv1.ShareMountTarget, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetShareMountTargetByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetShareMountTargetByName")
+		result, err = s.Vpc.GetShareMountTargetByName(shareID, name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetShareMountTargetByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
+
+// DeleteShareMountTarget calls DeleteShareMountTarget with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) DeleteShareMountTarget(options *vpcv1.DeleteShareMountTargetOptions) (result *vpcv1.ShareMountTarget, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "DeleteShareMountTarget", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "DeleteShareMountTarget")
+		result, response, err = s.Vpc.DeleteShareMountTarget(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "DeleteShareMountTarget", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetSnapshot calls GetSnapshot with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSnapshot(options *vpcv1.GetSnapshotOptions) (result *vpcv1.Snapshot, response *core.DetailedResponse, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSnapshot", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSnapshot")
+		result, response, err = s.Vpc.GetSnapshot(options)
+		tracing.EndAPICall(span, response, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSnapshot", start, response, err)
+		return response, err
+	})
+	return result, response, err
+}
+
+// GetSnapshotByName calls GetSnapshotByName with rate limiting and retries and records the span and metrics of each attempt.
+func (s *instrumentedService) GetSnapshotByName(name string) (result *vpcv1.Snapshot, err error) {
+	ctx, cancel := s.phases.Context()
+	defer cancel()
+	err = retry.Do(ctx, instrumentationServiceName, "GetSnapshotByName", func() (*core.DetailedResponse, error) {
+		start := time.Now()
+		span := tracing.StartAPICall(ctx, instrumentationServiceName, "GetSnapshotByName")
+		result, err = s.Vpc.GetSnapshotByName(name)
+		tracing.EndAPICall(span, nil, err)
+		metrics.ObserveAPICall(instrumentationServiceName, "GetSnapshotByName", start, nil, err)
+		return nil, err
+	})
+	return result, err
+}
@@ -72,6 +72,22 @@ func (mr *MockVpcMockRecorder) AttachVolumeToInstance(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeToInstance", reflect.TypeOf((*MockVpc)(nil).AttachVolumeToInstance), options)
 }
 
+// CreateFloatingIP mocks base method.
+func (m *MockVpc) CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloatingIP", options)
+	ret0, _ := ret[0].(*vpcv1.FloatingIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateFloatingIP indicates an expected call of CreateFloatingIP.
+func (mr *MockVpcMockRecorder) CreateFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloatingIP", reflect.TypeOf((*MockVpc)(nil).CreateFloatingIP), options)
+}
+
 // CreateImage mocks base method.
 func (m *MockVpc) CreateImage(options *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +120,54 @@ func (mr *MockVpcMockRecorder) CreateInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockVpc)(nil).CreateInstance), options)
 }
 
+// CreateInstanceAction mocks base method.
+func (m *MockVpc) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceAction", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceAction)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceAction indicates an expected call of CreateInstanceAction.
+func (mr *MockVpcMockRecorder) CreateInstanceAction(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceAction", reflect.TypeOf((*MockVpc)(nil).CreateInstanceAction), options)
+}
+
+// CreateInstanceGroup mocks base method.
+func (m *MockVpc) CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceGroup", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceGroup indicates an expected call of CreateInstanceGroup.
+func (mr *MockVpcMockRecorder) CreateInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceGroup", reflect.TypeOf((*MockVpc)(nil).CreateInstanceGroup), options)
+}
+
+// CreateInstanceTemplate mocks base method.
+func (m *MockVpc) CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceTemplate", options)
+	ret0, _ := ret[0].(vpcv1.InstanceTemplateIntf)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceTemplate indicates an expected call of CreateInstanceTemplate.
+func (mr *MockVpcMockRecorder) CreateInstanceTemplate(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceTemplate", reflect.TypeOf((*MockVpc)(nil).CreateInstanceTemplate), options)
+}
+
 // CreateLoadBalancer mocks base method.
 func (m *MockVpc) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +248,38 @@ func (mr *MockVpcMockRecorder) CreateSecurityGroupRule(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroupRule", reflect.TypeOf((*MockVpc)(nil).CreateSecurityGroupRule), options)
 }
 
+// CreateShare mocks base method.
+func (m *MockVpc) CreateShare(options *vpcv1.CreateShareOptions) (*vpcv1.Share, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShare", options)
+	ret0, _ := ret[0].(*vpcv1.Share)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateShare indicates an expected call of CreateShare.
+func (mr *MockVpcMockRecorder) CreateShare(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShare", reflect.TypeOf((*MockVpc)(nil).CreateShare), options)
+}
+
+// CreateShareMountTarget mocks base method.
+func (m *MockVpc) CreateShareMountTarget(options *vpcv1.CreateShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareMountTarget", options)
+	ret0, _ := ret[0].(*vpcv1.ShareMountTarget)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateShareMountTarget indicates an expected call of CreateShareMountTarget.
+func (mr *MockVpcMockRecorder) CreateShareMountTarget(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareMountTarget", reflect.TypeOf((*MockVpc)(nil).CreateShareMountTarget), options)
+}
+
 // CreateSubnet mocks base method.
 func (m *MockVpc) CreateSubnet(options *vpcv1.CreateSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +296,22 @@ func (mr *MockVpcMockRecorder) CreateSubnet(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockVpc)(nil).CreateSubnet), options)
 }
 
+// CreateSubnetReservedIP mocks base method.
+func (m *MockVpc) CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubnetReservedIP", options)
+	ret0, _ := ret[0].(*vpcv1.ReservedIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSubnetReservedIP indicates an expected call of CreateSubnetReservedIP.
+func (mr *MockVpcMockRecorder) CreateSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).CreateSubnetReservedIP), options)
+}
+
 // CreateVPC mocks base method.
 func (m *MockVpc) CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +344,21 @@ func (mr *MockVpcMockRecorder) CreateVolume(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVpc)(nil).CreateVolume), options)
 }
 
+// DeleteFloatingIP mocks base method.
+func (m *MockVpc) DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFloatingIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFloatingIP indicates an expected call of DeleteFloatingIP.
+func (mr *MockVpcMockRecorder) DeleteFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloatingIP", reflect.TypeOf((*MockVpc)(nil).DeleteFloatingIP), options)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockVpc) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +374,51 @@ func (mr *MockVpcMockRecorder) DeleteInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockVpc)(nil).DeleteInstance), options)
 }
 
+// DeleteInstanceGroup mocks base method.
+func (m *MockVpc) DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceGroup", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceGroup indicates an expected call of DeleteInstanceGroup.
+func (mr *MockVpcMockRecorder) DeleteInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroup", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceGroup), options)
+}
+
+// DeleteInstanceGroupMembership mocks base method.
+func (m *MockVpc) DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceGroupMembership", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceGroupMembership indicates an expected call of DeleteInstanceGroupMembership.
+func (mr *MockVpcMockRecorder) DeleteInstanceGroupMembership(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroupMembership", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceGroupMembership), options)
+}
+
+// DeleteInstanceTemplate mocks base method.
+func (m *MockVpc) DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceTemplate", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceTemplate indicates an expected call of DeleteInstanceTemplate.
+func (mr *MockVpcMockRecorder) DeleteInstanceTemplate(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceTemplate", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceTemplate), options)
+}
+
 // DeleteLoadBalancer mocks base method.
 func (m *MockVpc) DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +479,22 @@ func (mr *MockVpcMockRecorder) DeleteSecurityGroup(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockVpc)(nil).DeleteSecurityGroup), options)
 }
 
+// DeleteShareMountTarget mocks base method.
+func (m *MockVpc) DeleteShareMountTarget(options *vpcv1.DeleteShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShareMountTarget", options)
+	ret0, _ := ret[0].(*vpcv1.ShareMountTarget)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteShareMountTarget indicates an expected call of DeleteShareMountTarget.
+func (mr *MockVpcMockRecorder) DeleteShareMountTarget(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShareMountTarget", reflect.TypeOf((*MockVpc)(nil).DeleteShareMountTarget), options)
+}
+
 // DeleteSubnet mocks base method.
 func (m *MockVpc) DeleteSubnet(options *vpcv1.DeleteSubnetOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +510,21 @@ func (mr *MockVpcMockRecorder) DeleteSubnet(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockVpc)(nil).DeleteSubnet), options)
 }
 
+// DeleteSubnetReservedIP mocks base method.
+func (m *MockVpc) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubnetReservedIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubnetReservedIP indicates an expected call of DeleteSubnetReservedIP.
+func (mr *MockVpcMockRecorder) DeleteSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).DeleteSubnetReservedIP), options)
+}
+
 // DeleteVPC mocks base method.
 func (m *MockVpc) DeleteVPC(options *vpcv1.DeleteVPCOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +540,52 @@ func (mr *MockVpcMockRecorder) DeleteVPC(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockVpc)(nil).DeleteVPC), options)
 }
 
+// DeleteVirtualNetworkInterfaces mocks base method.
+func (m *MockVpc) DeleteVirtualNetworkInterfaces(options *vpcv1.DeleteVirtualNetworkInterfacesOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualNetworkInterfaces", options)
+	ret0, _ := ret[0].(*vpcv1.VirtualNetworkInterface)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteVirtualNetworkInterfaces indicates an expected call of DeleteVirtualNetworkInterfaces.
+func (mr *MockVpcMockRecorder) DeleteVirtualNetworkInterfaces(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualNetworkInterfaces", reflect.TypeOf((*MockVpc)(nil).DeleteVirtualNetworkInterfaces), options)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockVpc) DeleteVolume(options *vpcv1.DeleteVolumeOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockVpcMockRecorder) DeleteVolume(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVpc)(nil).DeleteVolume), options)
+}
+
+// DetachVolumeFromInstance mocks base method.
+func (m *MockVpc) DetachVolumeFromInstance(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolumeFromInstance", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachVolumeFromInstance indicates an expected call of DetachVolumeFromInstance.
+func (mr *MockVpcMockRecorder) DetachVolumeFromInstance(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolumeFromInstance", reflect.TypeOf((*MockVpc)(nil).DetachVolumeFromInstance), options)
+}
+
 // GetDedicatedHostByName mocks base method.
 func (m *MockVpc) GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +601,37 @@ func (mr *MockVpcMockRecorder) GetDedicatedHostByName(dHostName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostByName", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostByName), dHostName)
 }
 
+// GetFloatingIP mocks base method.
+func (m *MockVpc) GetFloatingIP(options *vpcv1.GetFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloatingIP", options)
+	ret0, _ := ret[0].(*vpcv1.FloatingIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFloatingIP indicates an expected call of GetFloatingIP.
+func (mr *MockVpcMockRecorder) GetFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloatingIP", reflect.TypeOf((*MockVpc)(nil).GetFloatingIP), options)
+}
+
+// GetFloatingIPByName mocks base method.
+func (m *MockVpc) GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloatingIPByName", name)
+	ret0, _ := ret[0].(*vpcv1.FloatingIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloatingIPByName indicates an expected call of GetFloatingIPByName.
+func (mr *MockVpcMockRecorder) GetFloatingIPByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloatingIPByName", reflect.TypeOf((*MockVpc)(nil).GetFloatingIPByName), name)
+}
+
 // GetImage mocks base method.
 func (m *MockVpc) GetImage(options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +679,37 @@ func (mr *MockVpcMockRecorder) GetInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockVpc)(nil).GetInstance), options)
 }
 
+// GetInstanceGroup mocks base method.
+func (m *MockVpc) GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceGroup", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceGroup indicates an expected call of GetInstanceGroup.
+func (mr *MockVpcMockRecorder) GetInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroup", reflect.TypeOf((*MockVpc)(nil).GetInstanceGroup), options)
+}
+
+// GetInstanceGroupByName mocks base method.
+func (m *MockVpc) GetInstanceGroupByName(name string) (*vpcv1.InstanceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceGroupByName", name)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceGroupByName indicates an expected call of GetInstanceGroupByName.
+func (mr *MockVpcMockRecorder) GetInstanceGroupByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupByName", reflect.TypeOf((*MockVpc)(nil).GetInstanceGroupByName), name)
+}
+
 // GetInstanceProfile mocks base method.
 func (m *MockVpc) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +726,21 @@ func (mr *MockVpcMockRecorder) GetInstanceProfile(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceProfile", reflect.TypeOf((*MockVpc)(nil).GetInstanceProfile), options)
 }
 
+// GetInstanceTemplateByName mocks base method.
+func (m *MockVpc) GetInstanceTemplateByName(name string) (*vpcv1.InstanceTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTemplateByName", name)
+	ret0, _ := ret[0].(*vpcv1.InstanceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTemplateByName indicates an expected call of GetInstanceTemplateByName.
+func (mr *MockVpcMockRecorder) GetInstanceTemplateByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTemplateByName", reflect.TypeOf((*MockVpc)(nil).GetInstanceTemplateByName), name)
+}
+
 // GetLoadBalancer mocks base method.
 func (m *MockVpc) GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -477,6 +803,53 @@ func (mr *MockVpcMockRecorder) GetLoadBalancerPoolByName(loadBalancerID, poolNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerPoolByName", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerPoolByName), loadBalancerID, poolName)
 }
 
+// GetPublicGateway mocks base method.
+func (m *MockVpc) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicGateway", options)
+	ret0, _ := ret[0].(*vpcv1.PublicGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublicGateway indicates an expected call of GetPublicGateway.
+func (mr *MockVpcMockRecorder) GetPublicGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicGateway", reflect.TypeOf((*MockVpc)(nil).GetPublicGateway), options)
+}
+
+// GetReservation mocks base method.
+func (m *MockVpc) GetReservation(options *vpcv1.GetReservationOptions) (*vpcv1.Reservation, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservation", options)
+	ret0, _ := ret[0].(*vpcv1.Reservation)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReservation indicates an expected call of GetReservation.
+func (mr *MockVpcMockRecorder) GetReservation(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservation", reflect.TypeOf((*MockVpc)(nil).GetReservation), options)
+}
+
+// GetReservationByName mocks base method.
+func (m *MockVpc) GetReservationByName(name string) (*vpcv1.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationByName", name)
+	ret0, _ := ret[0].(*vpcv1.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationByName indicates an expected call of GetReservationByName.
+func (mr *MockVpcMockRecorder) GetReservationByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationByName", reflect.TypeOf((*MockVpc)(nil).GetReservationByName), name)
+}
+
 // GetSecurityGroup mocks base method.
 func (m *MockVpc) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -524,6 +897,99 @@ func (mr *MockVpcMockRecorder) GetSecurityGroupRule(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupRule", reflect.TypeOf((*MockVpc)(nil).GetSecurityGroupRule), options)
 }
 
+// GetShare mocks base method.
+func (m *MockVpc) GetShare(options *vpcv1.GetShareOptions) (*vpcv1.Share, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShare", options)
+	ret0, _ := ret[0].(*vpcv1.Share)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetShare indicates an expected call of GetShare.
+func (mr *MockVpcMockRecorder) GetShare(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShare", reflect.TypeOf((*MockVpc)(nil).GetShare), options)
+}
+
+// GetShareByName mocks base method.
+func (m *MockVpc) GetShareByName(name string) (*vpcv1.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareByName", name)
+	ret0, _ := ret[0].(*vpcv1.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareByName indicates an expected call of GetShareByName.
+func (mr *MockVpcMockRecorder) GetShareByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareByName", reflect.TypeOf((*MockVpc)(nil).GetShareByName), name)
+}
+
+// GetShareMountTarget mocks base method.
+func (m *MockVpc) GetShareMountTarget(options *vpcv1.GetShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareMountTarget", options)
+	ret0, _ := ret[0].(*vpcv1.ShareMountTarget)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetShareMountTarget indicates an expected call of GetShareMountTarget.
+func (mr *MockVpcMockRecorder) GetShareMountTarget(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareMountTarget", reflect.TypeOf((*MockVpc)(nil).GetShareMountTarget), options)
+}
+
+// GetShareMountTargetByName mocks base method.
+func (m *MockVpc) GetShareMountTargetByName(shareID, name string) (*vpcv1.ShareMountTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareMountTargetByName", shareID, name)
+	ret0, _ := ret[0].(*vpcv1.ShareMountTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareMountTargetByName indicates an expected call of GetShareMountTargetByName.
+func (mr *MockVpcMockRecorder) GetShareMountTargetByName(shareID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareMountTargetByName", reflect.TypeOf((*MockVpc)(nil).GetShareMountTargetByName), shareID, name)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockVpc) GetSnapshot(options *vpcv1.GetSnapshotOptions) (*vpcv1.Snapshot, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", options)
+	ret0, _ := ret[0].(*vpcv1.Snapshot)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockVpcMockRecorder) GetSnapshot(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockVpc)(nil).GetSnapshot), options)
+}
+
+// GetSnapshotByName mocks base method.
+func (m *MockVpc) GetSnapshotByName(name string) (*vpcv1.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotByName", name)
+	ret0, _ := ret[0].(*vpcv1.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotByName indicates an expected call of GetSnapshotByName.
+func (mr *MockVpcMockRecorder) GetSnapshotByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotByName", reflect.TypeOf((*MockVpc)(nil).GetSnapshotByName), name)
+}
+
 // GetSubnet mocks base method.
 func (m *MockVpc) GetSubnet(arg0 *vpcv1.GetSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -556,6 +1022,21 @@ func (mr *MockVpcMockRecorder) GetSubnetPublicGateway(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetPublicGateway", reflect.TypeOf((*MockVpc)(nil).GetSubnetPublicGateway), options)
 }
 
+// GetSubnetReservedIPByName mocks base method.
+func (m *MockVpc) GetSubnetReservedIPByName(subnetID, name string) (*vpcv1.ReservedIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetReservedIPByName", subnetID, name)
+	ret0, _ := ret[0].(*vpcv1.ReservedIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetReservedIPByName indicates an expected call of GetSubnetReservedIPByName.
+func (mr *MockVpcMockRecorder) GetSubnetReservedIPByName(subnetID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetReservedIPByName", reflect.TypeOf((*MockVpc)(nil).GetSubnetReservedIPByName), subnetID, name)
+}
+
 // GetVPC mocks base method.
 func (m *MockVpc) GetVPC(arg0 *vpcv1.GetVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -632,6 +1113,22 @@ func (mr *MockVpcMockRecorder) GetVPCZonesByRegion(region any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCZonesByRegion", reflect.TypeOf((*MockVpc)(nil).GetVPCZonesByRegion), region)
 }
 
+// GetVirtualNetworkInterface mocks base method.
+func (m *MockVpc) GetVirtualNetworkInterface(options *vpcv1.GetVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualNetworkInterface", options)
+	ret0, _ := ret[0].(*vpcv1.VirtualNetworkInterface)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualNetworkInterface indicates an expected call of GetVirtualNetworkInterface.
+func (mr *MockVpcMockRecorder) GetVirtualNetworkInterface(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualNetworkInterface", reflect.TypeOf((*MockVpc)(nil).GetVirtualNetworkInterface), options)
+}
+
 // GetVolume mocks base method.
 func (m *MockVpc) GetVolume(options *vpcv1.GetVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -680,6 +1177,37 @@ func (mr *MockVpcMockRecorder) ListImages(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockVpc)(nil).ListImages), options)
 }
 
+// ListInstanceGroupMemberships mocks base method.
+func (m *MockVpc) ListInstanceGroupMemberships(instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceGroupMemberships", instanceGroupID)
+	ret0, _ := ret[0].([]vpcv1.InstanceGroupMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceGroupMemberships indicates an expected call of ListInstanceGroupMemberships.
+func (mr *MockVpcMockRecorder) ListInstanceGroupMemberships(instanceGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupMemberships", reflect.TypeOf((*MockVpc)(nil).ListInstanceGroupMemberships), instanceGroupID)
+}
+
+// ListInstanceTemplates mocks base method.
+func (m *MockVpc) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceTemplates", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceTemplateCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceTemplates indicates an expected call of ListInstanceTemplates.
+func (mr *MockVpcMockRecorder) ListInstanceTemplates(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceTemplates", reflect.TypeOf((*MockVpc)(nil).ListInstanceTemplates), options)
+}
+
 // ListInstances mocks base method.
 func (m *MockVpc) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -808,6 +1336,36 @@ func (mr *MockVpcMockRecorder) ListVPCAddressPrefixes(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCAddressPrefixes", reflect.TypeOf((*MockVpc)(nil).ListVPCAddressPrefixes), options)
 }
 
+// ListVirtualNetworkInterfacesByNamePrefix mocks base method.
+func (m *MockVpc) ListVirtualNetworkInterfacesByNamePrefix(prefix string) ([]vpcv1.VirtualNetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualNetworkInterfacesByNamePrefix", prefix)
+	ret0, _ := ret[0].([]vpcv1.VirtualNetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualNetworkInterfacesByNamePrefix indicates an expected call of ListVirtualNetworkInterfacesByNamePrefix.
+func (mr *MockVpcMockRecorder) ListVirtualNetworkInterfacesByNamePrefix(prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualNetworkInterfacesByNamePrefix", reflect.TypeOf((*MockVpc)(nil).ListVirtualNetworkInterfacesByNamePrefix), prefix)
+}
+
+// ListVirtualNetworkInterfacesBySubnet mocks base method.
+func (m *MockVpc) ListVirtualNetworkInterfacesBySubnet(subnetID string) ([]vpcv1.VirtualNetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualNetworkInterfacesBySubnet", subnetID)
+	ret0, _ := ret[0].([]vpcv1.VirtualNetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualNetworkInterfacesBySubnet indicates an expected call of ListVirtualNetworkInterfacesBySubnet.
+func (mr *MockVpcMockRecorder) ListVirtualNetworkInterfacesBySubnet(subnetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualNetworkInterfacesBySubnet", reflect.TypeOf((*MockVpc)(nil).ListVirtualNetworkInterfacesBySubnet), subnetID)
+}
+
 // ListVpcs mocks base method.
 func (m *MockVpc) ListVpcs(options *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -824,6 +1382,36 @@ func (mr *MockVpcMockRecorder) ListVpcs(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcs", reflect.TypeOf((*MockVpc)(nil).ListVpcs), options)
 }
 
+// RemoveInstanceNetworkInterfaceFloatingIP mocks base method.
+func (m *MockVpc) RemoveInstanceNetworkInterfaceFloatingIP(options *vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveInstanceNetworkInterfaceFloatingIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveInstanceNetworkInterfaceFloatingIP indicates an expected call of RemoveInstanceNetworkInterfaceFloatingIP.
+func (mr *MockVpcMockRecorder) RemoveInstanceNetworkInterfaceFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInstanceNetworkInterfaceFloatingIP", reflect.TypeOf((*MockVpc)(nil).RemoveInstanceNetworkInterfaceFloatingIP), options)
+}
+
+// RemoveNetworkInterfaceFloatingIP mocks base method.
+func (m *MockVpc) RemoveNetworkInterfaceFloatingIP(options *vpcv1.RemoveNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetworkInterfaceFloatingIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNetworkInterfaceFloatingIP indicates an expected call of RemoveNetworkInterfaceFloatingIP.
+func (mr *MockVpcMockRecorder) RemoveNetworkInterfaceFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkInterfaceFloatingIP", reflect.TypeOf((*MockVpc)(nil).RemoveNetworkInterfaceFloatingIP), options)
+}
+
 // SetSubnetPublicGateway mocks base method.
 func (m *MockVpc) SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -854,3 +1442,99 @@ func (mr *MockVpcMockRecorder) UnsetSubnetPublicGateway(options any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetSubnetPublicGateway", reflect.TypeOf((*MockVpc)(nil).UnsetSubnetPublicGateway), options)
 }
+
+// UpdateFloatingIP mocks base method.
+func (m *MockVpc) UpdateFloatingIP(options *vpcv1.UpdateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloatingIP", options)
+	ret0, _ := ret[0].(*vpcv1.FloatingIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateFloatingIP indicates an expected call of UpdateFloatingIP.
+func (mr *MockVpcMockRecorder) UpdateFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloatingIP", reflect.TypeOf((*MockVpc)(nil).UpdateFloatingIP), options)
+}
+
+// UpdateInstance mocks base method.
+func (m *MockVpc) UpdateInstance(options *vpcv1.UpdateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstance", options)
+	ret0, _ := ret[0].(*vpcv1.Instance)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateInstance indicates an expected call of UpdateInstance.
+func (mr *MockVpcMockRecorder) UpdateInstance(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstance", reflect.TypeOf((*MockVpc)(nil).UpdateInstance), options)
+}
+
+// UpdateInstanceGroup mocks base method.
+func (m *MockVpc) UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceGroup", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateInstanceGroup indicates an expected call of UpdateInstanceGroup.
+func (mr *MockVpcMockRecorder) UpdateInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceGroup", reflect.TypeOf((*MockVpc)(nil).UpdateInstanceGroup), options)
+}
+
+// UpdateSubnetReservedIP mocks base method.
+func (m *MockVpc) UpdateSubnetReservedIP(options *vpcv1.UpdateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubnetReservedIP", options)
+	ret0, _ := ret[0].(*vpcv1.ReservedIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSubnetReservedIP indicates an expected call of UpdateSubnetReservedIP.
+func (mr *MockVpcMockRecorder) UpdateSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).UpdateSubnetReservedIP), options)
+}
+
+// UpdateVirtualNetworkInterface mocks base method.
+func (m *MockVpc) UpdateVirtualNetworkInterface(options *vpcv1.UpdateVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualNetworkInterface", options)
+	ret0, _ := ret[0].(*vpcv1.VirtualNetworkInterface)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateVirtualNetworkInterface indicates an expected call of UpdateVirtualNetworkInterface.
+func (mr *MockVpcMockRecorder) UpdateVirtualNetworkInterface(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualNetworkInterface", reflect.TypeOf((*MockVpc)(nil).UpdateVirtualNetworkInterface), options)
+}
+
+// UpdateVolume mocks base method.
+func (m *MockVpc) UpdateVolume(options *vpcv1.UpdateVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolume", options)
+	ret0, _ := ret[0].(*vpcv1.Volume)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockVpcMockRecorder) UpdateVolume(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockVpc)(nil).UpdateVolume), options)
+}
@@ -17,11 +17,15 @@ limitations under the License.
 package vpc
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	"k8s.io/utils/ptr"
+
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/pagingutils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 )
@@ -60,6 +64,16 @@ func (s *Service) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.Ins
 	return s.vpcService.ListInstances(options)
 }
 
+// UpdateInstance updates a virtual server instance with a patch.
+func (s *Service) UpdateInstance(options *vpcv1.UpdateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateInstance(options)
+}
+
+// CreateInstanceAction requests an action, such as stop or start, on a virtual server instance.
+func (s *Service) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	return s.vpcService.CreateInstanceAction(options)
+}
+
 // GetDedicatedHostByName returns Dedicated Host with given name. If not found, returns nil.
 func (s *Service) GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost, error) {
 	var dHost *vpcv1.DedicatedHost
@@ -139,6 +153,11 @@ func (s *Service) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions)
 	return s.vpcService.CreatePublicGateway(options)
 }
 
+// GetPublicGateway returns a public gateway.
+func (s *Service) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	return s.vpcService.GetPublicGateway(options)
+}
+
 // DeletePublicGateway deletes a public gateway.
 func (s *Service) DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error) {
 	return s.vpcService.DeletePublicGateway(options)
@@ -546,18 +565,423 @@ func (s *Service) GetVolume(options *vpcv1.GetVolumeOptions) (result *vpcv1.Volu
 	return s.vpcService.GetVolume(options)
 }
 
-// NewService returns a new VPC Service.
-func NewService(svcEndpoint string) (Vpc, error) {
-	service := &Service{}
-	auth, err := authenticator.GetAuthenticator()
+// UpdateVolume updates a volume, e.g. to expand its capacity or to change its iops or profile.
+func (s *Service) UpdateVolume(options *vpcv1.UpdateVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateVolume(options)
+}
+
+// DetachVolumeFromInstance deletes the given volume attachment of the instance.
+func (s *Service) DetachVolumeFromInstance(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceVolumeAttachment(options)
+}
+
+// DeleteVolume deletes a volume.
+func (s *Service) DeleteVolume(options *vpcv1.DeleteVolumeOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteVolume(options)
+}
+
+// CreateInstanceTemplate creates a new instance template.
+func (s *Service) CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	return s.vpcService.CreateInstanceTemplate(options)
+}
+
+// DeleteInstanceTemplate deletes an instance template.
+func (s *Service) DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceTemplate(options)
+}
+
+// ListInstanceTemplates returns the list of instance templates.
+func (s *Service) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	return s.vpcService.ListInstanceTemplates(options)
+}
+
+// GetInstanceTemplateByName returns the instance template with the given name. If not found, returns nil.
+func (s *Service) GetInstanceTemplateByName(name string) (*vpcv1.InstanceTemplate, error) {
+	templates, _, err := s.ListInstanceTemplates(&vpcv1.ListInstanceTemplatesOptions{})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error listing instance templates: %w", err)
+	}
+	if templates == nil {
+		return nil, fmt.Errorf("instance template list returned is nil")
 	}
 
-	service.vpcService, err = vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
-		Authenticator: auth,
-		URL:           svcEndpoint,
+	for _, t := range templates.Templates {
+		template, ok := t.(*vpcv1.InstanceTemplate)
+		if !ok {
+			continue
+		}
+		if template.Name != nil && *template.Name == name {
+			return template, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateInstanceGroup creates a new instance group.
+func (s *Service) CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	return s.vpcService.CreateInstanceGroup(options)
+}
+
+// GetInstanceGroup returns the instance group.
+func (s *Service) GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	return s.vpcService.GetInstanceGroup(options)
+}
+
+// GetInstanceGroupByName returns the instance group with the given name. If not found, returns nil.
+func (s *Service) GetInstanceGroupByName(name string) (*vpcv1.InstanceGroup, error) {
+	instanceGroupPager, err := s.vpcService.NewInstanceGroupsPager(&vpcv1.ListInstanceGroupsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instance groups: %w", err)
+	}
+
+	for instanceGroupPager.HasNext() {
+		instanceGroups, err := instanceGroupPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of instance groups: %w", err)
+		}
+
+		for i, ig := range instanceGroups {
+			if ig.Name != nil && *ig.Name == name {
+				return &instanceGroups[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// UpdateInstanceGroup updates an instance group.
+func (s *Service) UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateInstanceGroup(options)
+}
+
+// DeleteInstanceGroup deletes an instance group.
+func (s *Service) DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceGroup(options)
+}
+
+// ListInstanceGroupMemberships returns all the memberships of an instance group.
+func (s *Service) ListInstanceGroupMemberships(instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	membershipPager, err := s.vpcService.NewInstanceGroupMembershipsPager(&vpcv1.ListInstanceGroupMembershipsOptions{
+		InstanceGroupID: ptr.To(instanceGroupID),
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instance group memberships: %w", err)
+	}
+	return membershipPager.GetAll()
+}
+
+// DeleteInstanceGroupMembership deletes a membership from an instance group.
+func (s *Service) DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceGroupMembership(options)
+}
+
+// GetVirtualNetworkInterface returns a virtual network interface.
+func (s *Service) GetVirtualNetworkInterface(options *vpcv1.GetVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	return s.vpcService.GetVirtualNetworkInterface(options)
+}
+
+// ListVirtualNetworkInterfacesByNamePrefix returns the virtual network interfaces with a name starting with the given prefix.
+func (s *Service) ListVirtualNetworkInterfacesByNamePrefix(prefix string) ([]vpcv1.VirtualNetworkInterface, error) {
+	vniPager, err := s.vpcService.NewVirtualNetworkInterfacesPager(&vpcv1.ListVirtualNetworkInterfacesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing virtual network interfaces: %w", err)
+	}
+
+	var result []vpcv1.VirtualNetworkInterface
+	for vniPager.HasNext() {
+		vnis, err := vniPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of virtual network interfaces: %w", err)
+		}
+
+		for _, vni := range vnis {
+			if vni.Name != nil && strings.HasPrefix(*vni.Name, prefix) {
+				result = append(result, vni)
+			}
+		}
+	}
+	return result, nil
+}
+
+// ListVirtualNetworkInterfacesBySubnet returns the virtual network interfaces in the subnet.
+func (s *Service) ListVirtualNetworkInterfacesBySubnet(subnetID string) ([]vpcv1.VirtualNetworkInterface, error) {
+	vniPager, err := s.vpcService.NewVirtualNetworkInterfacesPager(&vpcv1.ListVirtualNetworkInterfacesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing virtual network interfaces: %w", err)
+	}
+
+	var result []vpcv1.VirtualNetworkInterface
+	for vniPager.HasNext() {
+		vnis, err := vniPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of virtual network interfaces: %w", err)
+		}
+
+		for _, vni := range vnis {
+			if vni.Subnet != nil && vni.Subnet.ID != nil && *vni.Subnet.ID == subnetID {
+				result = append(result, vni)
+			}
+		}
+	}
+	return result, nil
+}
+
+// UpdateVirtualNetworkInterface updates a virtual network interface.
+func (s *Service) UpdateVirtualNetworkInterface(options *vpcv1.UpdateVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateVirtualNetworkInterface(options)
+}
+
+// DeleteVirtualNetworkInterfaces deletes a virtual network interface.
+func (s *Service) DeleteVirtualNetworkInterfaces(options *vpcv1.DeleteVirtualNetworkInterfacesOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+	return s.vpcService.DeleteVirtualNetworkInterfaces(options)
+}
+
+// CreateFloatingIP creates a floating IP.
+func (s *Service) CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	return s.vpcService.CreateFloatingIP(options)
+}
+
+// GetFloatingIP returns a floating IP.
+func (s *Service) GetFloatingIP(options *vpcv1.GetFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	return s.vpcService.GetFloatingIP(options)
+}
+
+// GetFloatingIPByName returns the floating IP with the given name. If not found, returns nil.
+func (s *Service) GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error) {
+	floatingIPPager, err := s.vpcService.NewFloatingIpsPager(&vpcv1.ListFloatingIpsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing floating IPs: %w", err)
+	}
+
+	for floatingIPPager.HasNext() {
+		floatingIPs, err := floatingIPPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of floating IPs: %w", err)
+		}
+
+		for i, floatingIP := range floatingIPs {
+			if floatingIP.Name != nil && *floatingIP.Name == name {
+				return &floatingIPs[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// UpdateFloatingIP updates a floating IP, e.g. its target.
+func (s *Service) UpdateFloatingIP(options *vpcv1.UpdateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateFloatingIP(options)
+}
+
+// DeleteFloatingIP deletes a floating IP.
+func (s *Service) DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteFloatingIP(options)
+}
 
-	return service, err
+// RemoveInstanceNetworkInterfaceFloatingIP unbinds a floating IP from an instance network interface.
+func (s *Service) RemoveInstanceNetworkInterfaceFloatingIP(options *vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.RemoveInstanceNetworkInterfaceFloatingIP(options)
+}
+
+// RemoveNetworkInterfaceFloatingIP unbinds a floating IP from a virtual network interface.
+func (s *Service) RemoveNetworkInterfaceFloatingIP(options *vpcv1.RemoveNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.RemoveNetworkInterfaceFloatingIP(options)
+}
+
+// CreateSubnetReservedIP reserves an IP address of a subnet.
+func (s *Service) CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	return s.vpcService.CreateSubnetReservedIP(options)
+}
+
+// GetSubnetReservedIPByName returns the reserved IP of the subnet with the given name. If not found, returns nil.
+func (s *Service) GetSubnetReservedIPByName(subnetID string, name string) (*vpcv1.ReservedIP, error) {
+	reservedIPPager, err := s.vpcService.NewSubnetReservedIpsPager(&vpcv1.ListSubnetReservedIpsOptions{
+		SubnetID: ptr.To(subnetID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing reserved IPs of subnet %s: %w", subnetID, err)
+	}
+
+	for reservedIPPager.HasNext() {
+		reservedIPs, err := reservedIPPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of reserved IPs of subnet %s: %w", subnetID, err)
+		}
+
+		for i, reservedIP := range reservedIPs {
+			if reservedIP.Name != nil && *reservedIP.Name == name {
+				return &reservedIPs[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// UpdateSubnetReservedIP updates a reserved IP of a subnet, e.g. whether it is deleted with its target.
+func (s *Service) UpdateSubnetReservedIP(options *vpcv1.UpdateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateSubnetReservedIP(options)
+}
+
+// DeleteSubnetReservedIP releases a reserved IP of a subnet.
+func (s *Service) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteSubnetReservedIP(options)
+}
+
+// GetReservation returns a capacity reservation.
+func (s *Service) GetReservation(options *vpcv1.GetReservationOptions) (*vpcv1.Reservation, *core.DetailedResponse, error) {
+	return s.vpcService.GetReservation(options)
+}
+
+// GetReservationByName returns the capacity reservation with the given name. If not found, returns nil.
+func (s *Service) GetReservationByName(name string) (*vpcv1.Reservation, error) {
+	reservationPager, err := s.vpcService.NewReservationsPager(&vpcv1.ListReservationsOptions{
+		Name: ptr.To(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing reservations: %w", err)
+	}
+
+	for reservationPager.HasNext() {
+		reservations, err := reservationPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of reservations: %w", err)
+		}
+
+		for i, reservation := range reservations {
+			if reservation.Name != nil && *reservation.Name == name {
+				return &reservations[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// CreateShare creates a file share.
+func (s *Service) CreateShare(options *vpcv1.CreateShareOptions) (*vpcv1.Share, *core.DetailedResponse, error) {
+	return s.vpcService.CreateShare(options)
+}
+
+// GetShare returns a file share.
+func (s *Service) GetShare(options *vpcv1.GetShareOptions) (*vpcv1.Share, *core.DetailedResponse, error) {
+	return s.vpcService.GetShare(options)
+}
+
+// GetShareByName returns the file share with the given name. If not found, returns nil.
+func (s *Service) GetShareByName(name string) (*vpcv1.Share, error) {
+	sharePager, err := s.vpcService.NewSharesPager(&vpcv1.ListSharesOptions{
+		Name: ptr.To(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing file shares: %w", err)
+	}
+
+	for sharePager.HasNext() {
+		shares, err := sharePager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of file shares: %w", err)
+		}
+
+		for i, share := range shares {
+			if share.Name != nil && *share.Name == name {
+				return &shares[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// CreateShareMountTarget creates a mount target of a file share.
+func (s *Service) CreateShareMountTarget(options *vpcv1.CreateShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	return s.vpcService.CreateShareMountTarget(options)
+}
+
+// GetShareMountTarget returns a mount target of a file share.
+func (s *Service) GetShareMountTarget(options *vpcv1.GetShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	return s.vpcService.GetShareMountTarget(options)
+}
+
+// GetShareMountTargetByName returns the mount target of the file share with the given name. If not found, returns nil.
+func (s *Service) GetShareMountTargetByName(shareID string, name string) (*vpcv1.ShareMountTarget, error) {
+	mountTargetPager, err := s.vpcService.NewShareMountTargetsPager(&vpcv1.ListShareMountTargetsOptions{
+		ShareID: ptr.To(shareID),
+		Name:    ptr.To(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing mount targets of file share %s: %w", shareID, err)
+	}
+
+	for mountTargetPager.HasNext() {
+		mountTargets, err := mountTargetPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of mount targets of file share %s: %w", shareID, err)
+		}
+
+		for i, mountTarget := range mountTargets {
+			if mountTarget.Name != nil && *mountTarget.Name == name {
+				return &mountTargets[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// DeleteShareMountTarget deletes a mount target of a file share.
+func (s *Service) DeleteShareMountTarget(options *vpcv1.DeleteShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	return s.vpcService.DeleteShareMountTarget(options)
+}
+
+// GetSnapshot returns a block storage snapshot.
+func (s *Service) GetSnapshot(options *vpcv1.GetSnapshotOptions) (*vpcv1.Snapshot, *core.DetailedResponse, error) {
+	return s.vpcService.GetSnapshot(options)
+}
+
+// GetSnapshotByName returns the block storage snapshot with the given name. If not found, returns nil.
+func (s *Service) GetSnapshotByName(name string) (*vpcv1.Snapshot, error) {
+	snapshotPager, err := s.vpcService.NewSnapshotsPager(&vpcv1.ListSnapshotsOptions{
+		Name: ptr.To(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing snapshots: %w", err)
+	}
+
+	for snapshotPager.HasNext() {
+		snapshots, err := snapshotPager.GetNext()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving next page of snapshots: %w", err)
+		}
+
+		for i, snapshot := range snapshots {
+			if snapshot.Name != nil && *snapshot.Name == name {
+				return &snapshots[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// ServiceOptions holds the IBM Cloud VPC Service Options specific information.
+type ServiceOptions struct {
+	*vpcv1.VpcV1Options
+}
+
+// NewService returns a new VPC Service.
+func NewService(ctx context.Context, options ServiceOptions) (Vpc, error) {
+	if options.VpcV1Options == nil {
+		options.VpcV1Options = &vpcv1.VpcV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+
+	vpcService, err := vpcv1.NewVpcV1(options.VpcV1Options)
+	if err != nil {
+		return nil, err
+	}
+	return newInstrumentedService(ctx, &Service{
+		vpcService: vpcService,
+	}), nil
 }
@@ -30,6 +30,8 @@ type Vpc interface {
 	DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error)
 	GetInstance(options *vpcv1.GetInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error)
 	ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error)
+	UpdateInstance(options *vpcv1.UpdateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error)
+	CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error)
 	GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost, error)
 	CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error)
 	DeleteVPC(options *vpcv1.DeleteVPCOptions) (response *core.DetailedResponse, err error)
@@ -41,6 +43,7 @@ type Vpc interface {
 	SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (*core.DetailedResponse, error)
 	CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
+	GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error)
 	ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (*vpcv1.AddressPrefixCollection, *core.DetailedResponse, error)
 	CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error)
@@ -77,4 +80,45 @@ type Vpc interface {
 	AttachVolumeToInstance(options *vpcv1.CreateInstanceVolumeAttachmentOptions) (*vpcv1.VolumeAttachment, *core.DetailedResponse, error)
 	GetVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (result *vpcv1.VolumeAttachmentCollection, response *core.DetailedResponse, err error)
 	GetVolume(options *vpcv1.GetVolumeOptions) (result *vpcv1.Volume, response *core.DetailedResponse, err error)
+	UpdateVolume(options *vpcv1.UpdateVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error)
+	DetachVolumeFromInstance(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error)
+	DeleteVolume(options *vpcv1.DeleteVolumeOptions) (*core.DetailedResponse, error)
+	CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error)
+	DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (*core.DetailedResponse, error)
+	ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error)
+	GetInstanceTemplateByName(name string) (*vpcv1.InstanceTemplate, error)
+	CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error)
+	GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error)
+	GetInstanceGroupByName(name string) (*vpcv1.InstanceGroup, error)
+	UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error)
+	DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (*core.DetailedResponse, error)
+	ListInstanceGroupMemberships(instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error)
+	DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error)
+	GetVirtualNetworkInterface(options *vpcv1.GetVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error)
+	ListVirtualNetworkInterfacesByNamePrefix(prefix string) ([]vpcv1.VirtualNetworkInterface, error)
+	ListVirtualNetworkInterfacesBySubnet(subnetID string) ([]vpcv1.VirtualNetworkInterface, error)
+	UpdateVirtualNetworkInterface(options *vpcv1.UpdateVirtualNetworkInterfaceOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error)
+	DeleteVirtualNetworkInterfaces(options *vpcv1.DeleteVirtualNetworkInterfacesOptions) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error)
+	CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error)
+	GetFloatingIP(options *vpcv1.GetFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error)
+	GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error)
+	UpdateFloatingIP(options *vpcv1.UpdateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error)
+	DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error)
+	RemoveInstanceNetworkInterfaceFloatingIP(options *vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error)
+	RemoveNetworkInterfaceFloatingIP(options *vpcv1.RemoveNetworkInterfaceFloatingIPOptions) (*core.DetailedResponse, error)
+	CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error)
+	GetSubnetReservedIPByName(subnetID string, name string) (*vpcv1.ReservedIP, error)
+	UpdateSubnetReservedIP(options *vpcv1.UpdateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error)
+	DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error)
+	GetReservation(options *vpcv1.GetReservationOptions) (*vpcv1.Reservation, *core.DetailedResponse, error)
+	GetReservationByName(name string) (*vpcv1.Reservation, error)
+	CreateShare(options *vpcv1.CreateShareOptions) (*vpcv1.Share, *core.DetailedResponse, error)
+	GetShare(options *vpcv1.GetShareOptions) (*vpcv1.Share, *core.DetailedResponse, error)
+	GetShareByName(name string) (*vpcv1.Share, error)
+	CreateShareMountTarget(options *vpcv1.CreateShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error)
+	GetShareMountTarget(options *vpcv1.GetShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error)
+	GetShareMountTargetByName(shareID string, name string) (*vpcv1.ShareMountTarget, error)
+	DeleteShareMountTarget(options *vpcv1.DeleteShareMountTargetOptions) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error)
+	GetSnapshot(options *vpcv1.GetSnapshotOptions) (*vpcv1.Snapshot, *core.DetailedResponse, error)
+	GetSnapshotByName(name string) (*vpcv1.Snapshot, error)
 }
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	"go.opentelemetry.io/otel/trace"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// phasesKey is the context key of the Phases of a reconciliation.
+type phasesKey struct{}
+
+// Phases tracks the reconciliation phase being run in a flow of phases run one after the other, so that the IBM Cloud
+// API calls issued by the clients built for the flow are recorded as children of the span of the phase issuing them.
+// The phases run concurrently, e.g. in goroutines, are run in their own flow started with Fork, with their clients
+// bound to it with Bind, so that the API calls of a flow are never recorded under the phase of another.
+type Phases struct {
+	mu sync.Mutex
+
+	// span is the span of the current phase.
+	span trace.Span
+
+	// logger is the logger of the reconciliation.
+	logger logr.Logger
+
+	// done is closed when the reconciliation is cancelled.
+	done <-chan struct{}
+}
+
+// newPhases returns the Phases of the reconciliation in ctx, starting with the span in ctx.
+func newPhases(ctx context.Context) *Phases {
+	return &Phases{
+		span:   trace.SpanFromContext(ctx),
+		logger: ctrl.LoggerFrom(ctx),
+		done:   ctx.Done(),
+	}
+}
+
+// PhasesFrom returns the Phases of the flow of phases in ctx, started with StartSpan or Fork.
+// Without flow in ctx, the API calls are recorded as children of the span in ctx, if any.
+func PhasesFrom(ctx context.Context) *Phases {
+	if phases, ok := ctx.Value(phasesKey{}).(*Phases); ok {
+		return phases
+	}
+	return newPhases(ctx)
+}
+
+// Fork returns a context holding a new flow of phases starting with the span in ctx, to run phases concurrently with
+// the ones of ctx, e.g. in a goroutine. The clients issuing the API calls of these phases must be bound to the
+// returned context with Bind.
+func Fork(ctx context.Context) context.Context {
+	return context.WithValue(ctx, phasesKey{}, newPhases(ctx))
+}
+
+// Bind returns the client recording the spans of its API calls as children of the phases of the flow in ctx, if the
+// client supports it, or the client itself otherwise.
+func Bind[T any](ctx context.Context, client T) T {
+	if bindable, ok := any(client).(interface{ WithContext(context.Context) T }); ok {
+		return bindable.WithContext(ctx)
+	}
+	return client
+}
+
+// Context returns a context holding the span of the current phase and the logger of the reconciliation, cancelled
+// when the reconciliation is. The returned cancel function must be called once the context is no longer used.
+func (p *Phases) Context() (context.Context, context.CancelFunc) {
+	p.mu.Lock()
+	span := p.span
+	p.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(trace.ContextWithSpan(ctrl.LoggerInto(context.Background(), p.logger), span))
+	if p.done != nil {
+		go func() {
+			select {
+			case <-p.done:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return ctx, cancel
+}
+
+// enter sets span as the span of the current phase and returns the span of the enclosing phase.
+func (p *Phases) enter(span trace.Span) trace.Span {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	parent := p.span
+	p.span = span
+	return parent
+}
+
+// exit sets parent back as the span of the current phase once the phase entered with it is done.
+func (p *Phases) exit(parent trace.Span) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.span = parent
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/version"
+)
+
+const (
+	// tracerName is the name of the tracer used for all the spans of the provider.
+	tracerName = "sigs.k8s.io/cluster-api-provider-ibmcloud"
+
+	// serviceName is the name of the service the spans are reported for.
+	serviceName = "capibm-controller-manager"
+)
+
+// Span attribute keys.
+const (
+	// ClusterNameKey is the span attribute holding the name of the reconciled cluster.
+	ClusterNameKey = attribute.Key("capibm.cluster.name")
+
+	// NamespaceKey is the span attribute holding the namespace of the reconciled object.
+	NamespaceKey = attribute.Key("capibm.namespace")
+
+	// NameKey is the span attribute holding the name of the reconciled object.
+	NameKey = attribute.Key("capibm.name")
+
+	// ResourceIDKey is the span attribute holding the ID of the IBM Cloud resource reconciled in a phase.
+	ResourceIDKey = attribute.Key("capibm.resource.id")
+
+	// RequeueKey is the span attribute set to true when the reconciliation of a phase is pending and requeued.
+	RequeueKey = attribute.Key("capibm.requeue")
+
+	// ServiceKey is the span attribute holding the IBM Cloud service of an API call.
+	ServiceKey = attribute.Key("ibmcloud.service")
+
+	// OperationKey is the span attribute holding the operation of an API call.
+	OperationKey = attribute.Key("ibmcloud.operation")
+
+	// StatusCodeKey is the span attribute holding the HTTP status code of an API call.
+	StatusCodeKey = attribute.Key("http.response.status_code")
+)
+
+// Options are the options of the OTLP trace exporter.
+type Options struct {
+	// Endpoint is the host:port of the OTLP gRPC collector, tracing is disabled if empty.
+	Endpoint string
+
+	// Insecure disables the transport security of the connection to the collector.
+	Insecure bool
+
+	// SamplingRatio is the ratio of the reconciliations that are traced, between 0 and 1.
+	SamplingRatio float64
+}
+
+// Setup configures the global tracer provider to export the spans to the OTLP collector set in the options and
+// returns a function flushing and shutting down the exporter. The spans are not recorded if no endpoint is set.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if opts.SamplingRatio < 0 || opts.SamplingRatio > 1 {
+		return nil, fmt.Errorf("invalid tracing sampling ratio %v, must be between 0 and 1", opts.SamplingRatio)
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Get().GitVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts the span of a reconciliation with the attributes as a child of the span in ctx, if any.
+// The returned context tracks the phases of the reconciliation run with TracePhase.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+	return context.WithValue(ctx, phasesKey{}, newPhases(ctx)), span
+}
+
+// EndSpan records the outcome of the operation on the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	span.End()
+}
+
+// TracePhase runs a reconciliation phase in a child span of the span in ctx, named after the phase.
+// The span records whether the phase is requeued, its outcome and the ID returned by resourceID, if set and not empty.
+// The API calls issued while the phase runs are recorded as children of its span.
+func TracePhase(ctx context.Context, name string, phase func(context.Context) (bool, error), resourceID func() string) (bool, error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, name)
+	phases := PhasesFrom(ctx)
+	parent := phases.enter(span)
+	requeue, err := phase(ctx)
+	phases.exit(parent)
+	if resourceID != nil {
+		if id := resourceID(); id != "" {
+			span.SetAttributes(ResourceIDKey.String(id))
+		}
+	}
+	span.SetAttributes(RequeueKey.Bool(requeue))
+	EndSpan(span, err)
+	return requeue, err
+}
+
+// NoRequeue adapts a reconciliation phase which is never requeued to be traced with TracePhase.
+func NoRequeue(phase func(context.Context) error) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		return false, phase(ctx)
+	}
+}
+
+// StartAPICall starts the span of a call to the operation of an IBM Cloud service as a child of the span in ctx, if any.
+func StartAPICall(ctx context.Context, service, operation string) trace.Span {
+	_, span := otel.Tracer(tracerName).Start(ctx, service+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(ServiceKey.String(service), OperationKey.String(operation)),
+	)
+	return span
+}
+
+// EndAPICall records the HTTP status code and the outcome of an IBM Cloud API call on its span and ends it.
+func EndAPICall(span trace.Span, response *core.DetailedResponse, err error) {
+	span.SetAttributes(StatusCodeKey.String(metrics.StatusCode(response, err)))
+	EndSpan(span, err)
+}
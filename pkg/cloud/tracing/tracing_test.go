@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	. "github.com/onsi/gomega"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+// phasesClient is a client bound to a flow of phases in the Bind tests.
+type phasesClient any
+
+type boundClient struct {
+	phases *Phases
+}
+
+func (c *boundClient) WithContext(ctx context.Context) phasesClient {
+	return &boundClient{phases: PhasesFrom(ctx)}
+}
+
+type plainClient struct{}
+
+func TestSetup(t *testing.T) {
+	t.Run("Should be a no-op if no endpoint is set", func(t *testing.T) {
+		g := NewWithT(t)
+		previous := otel.GetTracerProvider()
+
+		shutdown, err := Setup(context.Background(), Options{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(shutdown(context.Background())).To(Succeed())
+		g.Expect(otel.GetTracerProvider()).To(BeIdenticalTo(previous))
+	})
+	t.Run("Should fail if the sampling ratio is invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := Setup(context.Background(), Options{Endpoint: "localhost:4317", SamplingRatio: 2})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestTracePhase(t *testing.T) {
+	testCases := []struct {
+		name            string
+		phase           func(context.Context) (bool, error)
+		resourceID      func() string
+		expectedStatus  codes.Code
+		expectedRequeue bool
+		expectedID      string
+	}{
+		{
+			name:           "Should record a successful phase with the resource ID",
+			phase:          NoRequeue(func(context.Context) error { return nil }),
+			resourceID:     func() string { return "r006-vpc" },
+			expectedStatus: codes.Ok,
+			expectedID:     "r006-vpc",
+		},
+		{
+			name:            "Should record a requeued phase without resource ID",
+			phase:           func(context.Context) (bool, error) { return true, nil },
+			resourceID:      func() string { return "" },
+			expectedStatus:  codes.Ok,
+			expectedRequeue: true,
+		},
+		{
+			name:           "Should record a failed phase",
+			phase:          func(context.Context) (bool, error) { return false, errors.New("failed to create VPC") },
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			recorder := setupRecorder(t)
+
+			ctx, parent := StartSpan(context.Background(), "Reconcile")
+			_, _ = TracePhase(ctx, "ReconcileVPC", tc.phase, tc.resourceID)
+			EndSpan(parent, nil)
+
+			spans := recorder.Ended()
+			g.Expect(spans).To(HaveLen(2))
+			phase := spans[0]
+			g.Expect(phase.Name()).To(Equal("ReconcileVPC"))
+			g.Expect(phase.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+			g.Expect(phase.Status().Code).To(Equal(tc.expectedStatus))
+
+			attrs := spanAttributes(phase)
+			g.Expect(attrs[RequeueKey].AsBool()).To(Equal(tc.expectedRequeue))
+			if tc.expectedID == "" {
+				g.Expect(attrs).ToNot(HaveKey(ResourceIDKey))
+			} else {
+				g.Expect(attrs[ResourceIDKey].AsString()).To(Equal(tc.expectedID))
+			}
+		})
+	}
+}
+
+func TestAPICall(t *testing.T) {
+	t.Run("Should record the status code of a successful call", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder := setupRecorder(t)
+
+		span := StartAPICall(context.Background(), "vpc", "GetInstance")
+		EndAPICall(span, &core.DetailedResponse{StatusCode: 200}, nil)
+
+		spans := recorder.Ended()
+		g.Expect(spans).To(HaveLen(1))
+		g.Expect(spans[0].Name()).To(Equal("vpc.GetInstance"))
+		g.Expect(spans[0].Status().Code).To(Equal(codes.Ok))
+		attrs := spanAttributes(spans[0])
+		g.Expect(attrs[ServiceKey].AsString()).To(Equal("vpc"))
+		g.Expect(attrs[OperationKey].AsString()).To(Equal("GetInstance"))
+		g.Expect(attrs[StatusCodeKey].AsString()).To(Equal("200"))
+	})
+	t.Run("Should record the error of a failed call", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder := setupRecorder(t)
+
+		span := StartAPICall(context.Background(), "vpc", "DeleteInstance")
+		EndAPICall(span, &core.DetailedResponse{StatusCode: 404}, errors.New("instance not found"))
+
+		spans := recorder.Ended()
+		g.Expect(spans).To(HaveLen(1))
+		g.Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		g.Expect(spans[0].Status().Description).To(Equal("instance not found"))
+		g.Expect(spanAttributes(spans[0])[StatusCodeKey].AsString()).To(Equal("404"))
+	})
+}
+
+func TestPhases(t *testing.T) {
+	t.Run("Should record the API calls as children of the running phase", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder := setupRecorder(t)
+
+		ctx, parent := StartSpan(context.Background(), "Reconcile")
+		phases := PhasesFrom(ctx)
+		apiCall := func(operation string) {
+			callCtx, cancel := phases.Context()
+			defer cancel()
+			EndAPICall(StartAPICall(callCtx, "vpc", operation), nil, nil)
+		}
+		_, _ = TracePhase(ctx, "ReconcileVPC", NoRequeue(func(context.Context) error {
+			apiCall("GetVPC")
+			return nil
+		}), nil)
+		apiCall("GetInstance")
+		EndSpan(parent, nil)
+
+		spans := recorder.Ended()
+		g.Expect(spans).To(HaveLen(4))
+		g.Expect(spans[0].Name()).To(Equal("vpc.GetVPC"))
+		g.Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+		g.Expect(spans[1].Name()).To(Equal("ReconcileVPC"))
+		g.Expect(spans[2].Name()).To(Equal("vpc.GetInstance"))
+		g.Expect(spans[2].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	})
+	t.Run("Should record the API calls of concurrent flows as children of their own phases", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder := setupRecorder(t)
+
+		ctx, parent := StartSpan(context.Background(), "Reconcile")
+		powerVSCtx, vpcCtx := Fork(ctx), Fork(ctx)
+		powerVSStarted, vpcCalled := make(chan struct{}), make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			_, _ = TracePhase(powerVSCtx, "ReconcilePowerVSServiceInstance", NoRequeue(func(context.Context) error {
+				close(powerVSStarted)
+				<-vpcCalled
+				callCtx, cancel := PhasesFrom(powerVSCtx).Context()
+				defer cancel()
+				EndAPICall(StartAPICall(callCtx, "powervs", "GetServiceInstance"), nil, nil)
+				return nil
+			}), nil)
+		})
+		wg.Go(func() {
+			<-powerVSStarted
+			_, _ = TracePhase(vpcCtx, "ReconcileVPC", NoRequeue(func(context.Context) error {
+				callCtx, cancel := PhasesFrom(vpcCtx).Context()
+				defer cancel()
+				EndAPICall(StartAPICall(callCtx, "vpc", "GetVPC"), nil, nil)
+				close(vpcCalled)
+				return nil
+			}), nil)
+		})
+		wg.Wait()
+		EndSpan(parent, nil)
+
+		spans := map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		g.Expect(spans).To(HaveLen(5))
+		g.Expect(spans["vpc.GetVPC"].Parent().SpanID()).To(Equal(spans["ReconcileVPC"].SpanContext().SpanID()))
+		g.Expect(spans["powervs.GetServiceInstance"].Parent().SpanID()).To(Equal(spans["ReconcilePowerVSServiceInstance"].SpanContext().SpanID()))
+		g.Expect(spans["ReconcileVPC"].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		g.Expect(spans["ReconcilePowerVSServiceInstance"].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	})
+	t.Run("Should bind the clients supporting it to the flow of phases", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx := Fork(context.Background())
+		g.Expect(Bind[phasesClient](ctx, &boundClient{}).(*boundClient).phases).To(BeIdenticalTo(PhasesFrom(ctx)))
+		g.Expect(Bind[phasesClient](ctx, plainClient{})).To(Equal(plainClient{}))
+		g.Expect(Bind[phasesClient](ctx, nil)).To(BeNil())
+	})
+	t.Run("Should cancel the API calls context with the reconciliation", func(t *testing.T) {
+		g := NewWithT(t)
+		setupRecorder(t)
+
+		reconcileCtx, cancelReconcile := context.WithCancel(context.Background())
+		ctx, span := StartSpan(reconcileCtx, "Reconcile")
+		defer EndSpan(span, nil)
+		callCtx, cancel := PhasesFrom(ctx).Context()
+		defer cancel()
+
+		g.Expect(callCtx.Err()).ToNot(HaveOccurred())
+		cancelReconcile()
+		g.Eventually(callCtx.Done()).Should(BeClosed())
+	})
+}
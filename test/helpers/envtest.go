@@ -48,6 +48,8 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/fake"
 )
 
 const (
@@ -87,6 +89,10 @@ type TestEnvironment struct {
 	manager.Manager
 	client.Client
 	Config *rest.Config
+
+	// Backend is an in-memory IBM Cloud backend the controllers under test can be run against.
+	Backend *fake.Backend
+
 	env    *envtest.Environment
 	cancel context.CancelFunc
 }
@@ -183,6 +189,7 @@ func (t *TestEnvironmentConfiguration) Build() (*TestEnvironment, error) {
 		Manager: mgr,
 		Client:  mgr.GetClient(),
 		Config:  mgr.GetConfig(),
+		Backend: fake.NewBackend(),
 		env:     t.env,
 	}, nil
 }